@@ -4,6 +4,8 @@ go 1.14
 
 require (
 	github.com/Masterminds/sprig/v3 v3.1.0
+	github.com/dop251/goja v0.0.0-20230605162241-28ee0ee714f3
+	github.com/evanphx/json-patch v4.12.0+incompatible
 	github.com/fsnotify/fsnotify v1.4.9 // indirect
 	github.com/imdario/mergo v0.3.9 // indirect
 	github.com/liggitt/tabwriter v0.0.0-20181228230101-89fcab3d43de
@@ -17,9 +19,8 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/spf13/viper v1.6.3
 	github.com/xlab/treeprint v1.0.0
-	golang.org/x/crypto v0.0.0-20200422194213-44a606286825
-	golang.org/x/sys v0.0.0-20200420163511-1957bb5e6d1f // indirect
-	golang.org/x/text v0.3.2 // indirect
+	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
 	gopkg.in/ini.v1 v1.55.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 	k8s.io/client-go v11.0.0+incompatible
 )
@@ -0,0 +1,95 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/codegen"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/spf13/cobra"
+)
+
+var (
+	codegenRequest     string
+	codegenLang        string
+	codegenEnvironment string
+)
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "codegen <collection-id|file>",
+		Short: "Generate a command or client code for a request in a collection.",
+		Long: `Generate a command or client code for a request in a collection, written
+to stdout.
+
+The request is given by its path, made of folder names followed by the
+request name and separated by "/", such as "Orders/Create order". Variables
+are resolved against the collection and the environment given with
+--environment, and auth is applied as "run collection" applies it.
+Multipart files are read from their paths when the snippet runs.`,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := generateCode(serviceFor(cmd), args[0]); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&codegenRequest, "request", "", "path of the request, such as \"Folder/Request\" (required)")
+	cmd.MarkFlagRequired("request")
+	cmd.Flags().StringVar(&codegenLang, "lang", "curl", fmt.Sprintf("language to generate, one of: %s", strings.Join(codegen.Languages(), "|")))
+	cmd.Flags().StringVarP(&codegenEnvironment, "environment", "e", "", "environment ID or file")
+
+	rootCmd.AddCommand(cmd)
+}
+
+func generateCode(s sdk.Interface, arg string) error {
+	ctx := context.Background()
+
+	c, err := loadCollection(ctx, s, arg)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	var env *resources.Environment
+	if codegenEnvironment != "" {
+		env, err = loadEnvironment(ctx, s, codegenEnvironment)
+		if err != nil {
+			return handleResponseError(err)
+		}
+	}
+
+	r, err := codegen.Build(c, env, codegenRequest)
+	if err != nil {
+		return err
+	}
+
+	if len(r.Unresolved) > 0 {
+		fmt.Fprintf(os.Stderr, "warning: variables without a value: %s\n", strings.Join(r.Unresolved, ", "))
+	}
+
+	return codegen.Generate(os.Stdout, r, codegenLang)
+}
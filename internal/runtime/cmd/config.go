@@ -19,6 +19,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kevinswiber/postmanctl/internal/runtime/config"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/printers"
@@ -28,7 +29,10 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 )
 
-var setContextAPIRoot string
+var (
+	setContextAPIRoot       string
+	setContextEncryptionKey bool
+)
 
 func init() {
 	var cmd = &cobra.Command{
@@ -61,13 +65,27 @@ func init() {
 				cfg.Contexts = make(map[string]config.Context)
 			}
 
-			newContext := config.Context{}
-			if setContextAPIRoot != "" {
+			// Settings that aren't given, such as the encryption key, are
+			// kept when the context exists. viper lowercases the names.
+			newContext, ok := cfg.Contexts[strings.ToLower(args[0])]
+			if !ok || cmd.Flags().Changed("api-root") {
 				newContext.APIRoot = setContextAPIRoot
 			}
 
 			newContext.APIKey = string(apiKey)
 
+			if setContextEncryptionKey {
+				fmt.Print("Encryption Passphrase: ")
+				key, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+				fmt.Printf("\n")
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "error: %s\n", err)
+					os.Exit(1)
+				}
+
+				newContext.EncryptionKey = string(key)
+			}
+
 			cfg.Contexts[args[0]] = newContext
 			cfg.CurrentContext = args[0]
 
@@ -76,6 +94,7 @@ func init() {
 	}
 
 	setContextCmd.Flags().StringVar(&setContextAPIRoot, "api-root", "https://api.postman.com", "API root URL for accessing the Postman API.")
+	setContextCmd.Flags().BoolVar(&setContextEncryptionKey, "encryption-key", false, "Prompt for a passphrase to encrypt and decrypt environment files, otherwise the passphrase of an existing context is kept.")
 
 	useContextCmd := &cobra.Command{
 		Use:   "use-context",
@@ -0,0 +1,177 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/convert"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/spf13/cobra"
+)
+
+var (
+	convertTo      string
+	convertFormat  string
+	convertFolders string
+	convertEnv     string
+)
+
+// collectionTargets are the formats collections can be converted to.
+var collectionTargets = append([]string{"openapi3"}, convert.LoadTestTools()...)
+
+// openAPITargets are the formats OpenAPI documents can be converted to.
+var openAPITargets = []string{"collection"}
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert Postman resources to and from other formats.",
+	}
+
+	convertCollectionCmd := &cobra.Command{
+		Use:     "collection <id|file>",
+		Aliases: []string{"co"},
+		Short:   "Convert a collection to another format.",
+		Long: `Convert a collection to another format, written to stdout.
+
+openapi3: an OpenAPI 3 document. Folders become tags and requests become
+operations. Path variables, query parameters and headers become parameters,
+and JSON bodies and saved examples get schemas inferred from their values.
+Auth becomes security schemes, except for awsv4, hawk, ntlm, oauth1 and
+edgegrid auth, which OpenAPI can't express.
+
+jmeter, k6, locust: a load-test script. Each folder becomes a thread group,
+scenario or user class that sends the folder's requests in order, with a
+single user. Variables are resolved against the collection and the
+environment given with --environment, and auth is applied as "run
+collection" applies it.`,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := convertCollection(serviceFor(cmd), args[0]); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	convertCollectionCmd.Flags().StringVar(&convertTo, "to", "", fmt.Sprintf("the format to convert to, one of: %s (required)", strings.Join(collectionTargets, "|")))
+	convertCollectionCmd.MarkFlagRequired("to")
+	convertCollectionCmd.Flags().StringVar(&convertFormat, "format", "yaml",
+		fmt.Sprintf("output format of documents, one of: %s", strings.Join(convert.Formats(), "|")))
+	convertCollectionCmd.Flags().StringVarP(&convertEnv, "environment", "e", "", "environment ID or file, to resolve variables in load tests")
+
+	convertOpenAPICmd := &cobra.Command{
+		Use:   "openapi <file>",
+		Short: "Convert an OpenAPI 2 or 3 document, in JSON or YAML, to another format.",
+		Long: `Convert an OpenAPI 2 or 3 document, in JSON or YAML, to another format,
+written to stdout.
+
+collection: a collection, ready for "create collection". Requests use a
+{{baseUrl}} collection variable set to the first server. Bodies and saved
+examples are generated from schemas when the document has no examples,
+and security schemes become auth with their secrets as collection
+variables.`,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := convertOpenAPI(args[0]); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	convertOpenAPICmd.Flags().StringVar(&convertTo, "to", "", fmt.Sprintf("the format to convert to, one of: %s (required)", strings.Join(openAPITargets, "|")))
+	convertOpenAPICmd.MarkFlagRequired("to")
+	convertOpenAPICmd.Flags().StringVar(&convertFolders, "folders", convert.FoldersByTag,
+		fmt.Sprintf("how requests are put in folders, one of: %s", strings.Join(convert.FolderLayouts(), "|")))
+
+	cmd.AddCommand(convertCollectionCmd, convertOpenAPICmd)
+	rootCmd.AddCommand(cmd)
+}
+
+func convertCollection(s sdk.Interface, arg string) error {
+	c, err := loadCollection(context.Background(), s, arg)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	switch convertTo {
+	case "openapi3":
+		doc, err := convert.ToOpenAPI3(c)
+		if err != nil {
+			return err
+		}
+
+		return convert.Write(os.Stdout, doc, convertFormat)
+	case "jmeter", "k6", "locust":
+		var env *resources.Environment
+		if convertEnv != "" {
+			env, err = loadEnvironment(context.Background(), s, convertEnv)
+			if err != nil {
+				return handleResponseError(err)
+			}
+		}
+
+		t, err := convert.ToLoadTest(c, env)
+		if err != nil {
+			return err
+		}
+
+		if len(t.Unresolved) > 0 {
+			fmt.Fprintf(os.Stderr, "warning: variables without a value: %s\n", strings.Join(t.Unresolved, ", "))
+		}
+
+		return convert.WriteLoadTest(os.Stdout, t, convertTo)
+	}
+
+	return fmt.Errorf("unknown format %q, expected one of: %s", convertTo, strings.Join(collectionTargets, ", "))
+}
+
+func convertOpenAPI(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	doc, err := openapi.Parse(b)
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+
+	switch convertTo {
+	case "collection":
+		c, err := convert.FromOpenAPI(doc, convert.CollectionOptions{Folders: convertFolders})
+		if err != nil {
+			return err
+		}
+
+		return convert.Write(os.Stdout, c, "json")
+	}
+
+	return fmt.Errorf("unknown format %q, expected one of: %s", convertTo, strings.Join(openAPITargets, ", "))
+}
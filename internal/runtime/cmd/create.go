@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"github.com/spf13/cobra"
 )
@@ -44,6 +45,7 @@ func init() {
 		},
 	}
 	createCmd.PersistentFlags().StringVarP(&inputFile, "filename", "f", "", "the filename used to create the resource (required when not using data from stdin)")
+	createCmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
 
 	createCmd.AddCommand(
 		generateCreateSubcommand(resources.CollectionType, "collection", []string{"co"}),
@@ -64,12 +66,17 @@ func generateCreateSubcommand(t resources.ResourceType, use string, aliases []st
 		Use:     use,
 		Aliases: aliases,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return createResource(t)
+			return createResource(serviceFor(cmd), t)
 		},
 	}
 
 	cmd.Flags().StringVarP(&usingWorkspace, "workspace", "w", "", "workspace for create operation")
 
+	if t == resources.EnvironmentType {
+		cmd.Long = environmentHelp
+		cmd.Flags().BoolVar(&allowExec, "allow-exec", false, "resolve ${exec:...} references by running their commands")
+	}
+
 	if t == resources.APIVersionType || t == resources.SchemaType {
 		cmd.Flags().StringVar(&forAPI, "for-api", "", "the associated API ID (required)")
 		cmd.MarkFlagRequired("for-api")
@@ -83,7 +90,7 @@ func generateCreateSubcommand(t resources.ResourceType, use string, aliases []st
 	return &cmd
 }
 
-func createResource(t resources.ResourceType) error {
+func createResource(s sdk.Interface, t resources.ResourceType) error {
 	if inputReader == nil {
 		r, err := os.Open(inputFile)
 
@@ -97,28 +104,30 @@ func createResource(t resources.ResourceType) error {
 	}
 
 	var (
-		id  string
-		err error
+		result *resources.Result
+		err    error
 	)
 
 	ctx := context.Background()
 	switch t {
 	case resources.CollectionType:
-		id, err = service.CreateCollectionFromReader(ctx, inputReader, usingWorkspace)
+		result, err = s.CreateCollectionFromReader(ctx, inputReader, usingWorkspace)
 	case resources.EnvironmentType:
-		id, err = service.CreateEnvironmentFromReader(ctx, inputReader, usingWorkspace)
+		if err = resolveEnvironmentInput(ctx); err == nil {
+			result, err = s.CreateEnvironmentFromReader(ctx, inputReader, usingWorkspace)
+		}
 	case resources.MockType:
-		id, err = service.CreateMockFromReader(ctx, inputReader, usingWorkspace)
+		result, err = s.CreateMockFromReader(ctx, inputReader, usingWorkspace)
 	case resources.MonitorType:
-		id, err = service.CreateMonitorFromReader(ctx, inputReader, usingWorkspace)
+		result, err = s.CreateMonitorFromReader(ctx, inputReader, usingWorkspace)
 	case resources.WorkspaceType:
-		id, err = service.CreateWorkspaceFromReader(ctx, inputReader, usingWorkspace)
+		result, err = s.CreateWorkspaceFromReader(ctx, inputReader, usingWorkspace)
 	case resources.APIType:
-		id, err = service.CreateAPIFromReader(ctx, inputReader, usingWorkspace)
+		result, err = s.CreateAPIFromReader(ctx, inputReader, usingWorkspace)
 	case resources.APIVersionType:
-		id, err = service.CreateAPIVersionFromReader(ctx, inputReader, usingWorkspace, forAPI)
+		result, err = s.CreateAPIVersionFromReader(ctx, inputReader, usingWorkspace, forAPI)
 	case resources.SchemaType:
-		id, err = service.CreateSchemaFromReader(ctx, inputReader, usingWorkspace, forAPI, forAPIVersion)
+		result, err = s.CreateSchemaFromReader(ctx, inputReader, usingWorkspace, forAPI, forAPIVersion)
 	}
 
 	if err != nil {
@@ -126,7 +135,7 @@ func createResource(t resources.ResourceType) error {
 		os.Exit(1)
 	}
 
-	fmt.Println(id)
+	printResult(result)
 
 	return nil
 }
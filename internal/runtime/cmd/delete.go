@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +31,7 @@ func init() {
 		Use:   "delete",
 		Short: "Delete existing Postman resources.",
 	}
+	deleteCmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
 
 	deleteCmd.AddCommand(
 		generateDeleteSubcommand(resources.CollectionType, "collection", []string{"co"}),
@@ -51,7 +53,7 @@ func generateDeleteSubcommand(t resources.ResourceType, use string, aliases []st
 		Aliases: aliases,
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return deleteResource(t, args[0])
+			return deleteResource(serviceFor(cmd), t, args[0])
 		},
 	}
 
@@ -68,28 +70,30 @@ func generateDeleteSubcommand(t resources.ResourceType, use string, aliases []st
 	return &cmd
 }
 
-func deleteResource(t resources.ResourceType, resourceID string) error {
+func deleteResource(s sdk.Interface, t resources.ResourceType, resourceID string) error {
 	var (
-		id  string
-		err error
+		result *resources.Result
+		err    error
 	)
 
 	ctx := context.Background()
 	switch t {
 	case resources.CollectionType:
-		id, err = service.DeleteCollection(ctx, resourceID)
+		result, err = s.DeleteCollection(ctx, resourceID)
 	case resources.EnvironmentType:
-		id, err = service.DeleteEnvironment(ctx, resourceID)
+		result, err = s.DeleteEnvironment(ctx, resourceID)
 	case resources.MockType:
-		id, err = service.DeleteMock(ctx, resourceID)
+		result, err = s.DeleteMock(ctx, resourceID)
 	case resources.MonitorType:
-		id, err = service.DeleteMonitor(ctx, resourceID)
+		result, err = s.DeleteMonitor(ctx, resourceID)
+	case resources.WorkspaceType:
+		result, err = s.DeleteWorkspace(ctx, resourceID)
 	case resources.APIType:
-		id, err = service.DeleteAPI(ctx, resourceID)
+		result, err = s.DeleteAPI(ctx, resourceID)
 	case resources.APIVersionType:
-		id, err = service.DeleteAPIVersion(ctx, forAPI, resourceID)
+		result, err = s.DeleteAPIVersion(ctx, resourceID, forAPI)
 	case resources.SchemaType:
-		id, err = service.DeleteSchema(ctx, forAPI, forAPIVersion, resourceID)
+		result, err = s.DeleteSchema(ctx, resourceID, forAPI, forAPIVersion)
 	}
 
 	if err != nil {
@@ -97,7 +101,7 @@ func deleteResource(t resources.ResourceType, resourceID string) error {
 		os.Exit(1)
 	}
 
-	fmt.Println(id)
+	printResult(result)
 
 	return nil
 }
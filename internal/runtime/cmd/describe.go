@@ -22,9 +22,11 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"github.com/spf13/cobra"
 	"github.com/xlab/treeprint"
@@ -39,7 +41,7 @@ func init() {
 	userCmd := &cobra.Command{
 		Use: "user",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			resource, err := service.User(context.Background())
+			resource, err := serviceFor(cmd).User(context.Background())
 
 			if err != nil {
 				return handleResponseError(err)
@@ -59,7 +61,7 @@ func init() {
 		Use:     "api-versions",
 		Aliases: []string{"api-version"},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fetchAPIVersions(args)
+			return fetchAPIVersions(serviceFor(cmd), args)
 		},
 	}
 
@@ -69,7 +71,7 @@ func init() {
 	apiRelationsCmd := &cobra.Command{
 		Use: "api-relations",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fetchAPIRelations(args)
+			return fetchAPIRelations(serviceFor(cmd), args)
 		},
 	}
 
@@ -82,7 +84,7 @@ func init() {
 	schemaCmd := &cobra.Command{
 		Use: "schema",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fetchSchema(args)
+			return fetchSchema(serviceFor(cmd), args)
 		},
 	}
 
@@ -93,13 +95,25 @@ func init() {
 	schemaCmd.MarkFlagRequired("for-api-version")
 
 	describeCmd.AddCommand(
-		generateDescribeSubcommand("collections", []string{"collection", "co"}, fetchCollections),
-		generateDescribeSubcommand("environments", []string{"environment", "env"}, fetchEnvironments),
-		generateDescribeSubcommand("monitors", []string{"monitor", "mon"}, fetchMonitors),
-		generateDescribeSubcommand("mocks", []string{"mock"}, fetchMocks),
-		generateDescribeSubcommand("workspaces", []string{"workspace", "ws"}, fetchWorkspaces),
+		generateDescribeSubcommand("collections", []string{"collection", "co"}, func(s sdk.Interface, args []string) error {
+			return fetchCollections(s, args)
+		}),
+		generateDescribeSubcommand("environments", []string{"environment", "env"}, func(s sdk.Interface, args []string) error {
+			return fetchEnvironments(s, args)
+		}),
+		generateDescribeSubcommand("monitors", []string{"monitor", "mon"}, func(s sdk.Interface, args []string) error {
+			return fetchMonitors(s, args)
+		}),
+		generateDescribeSubcommand("mocks", []string{"mock"}, func(s sdk.Interface, args []string) error {
+			return fetchMocks(s, args)
+		}),
+		generateDescribeSubcommand("workspaces", []string{"workspace", "ws"}, func(s sdk.Interface, args []string) error {
+			return fetchWorkspaces(s, args)
+		}),
 		userCmd,
-		generateDescribeSubcommand("apis", []string{"api"}, fetchAPIs),
+		generateDescribeSubcommand("apis", []string{"api"}, func(s sdk.Interface, args []string) error {
+			return fetchAPIs(s, args)
+		}),
 		apiVersionsCmd,
 		apiRelationsCmd,
 		schemaCmd,
@@ -107,21 +121,21 @@ func init() {
 	rootCmd.AddCommand(describeCmd)
 }
 
-func generateDescribeSubcommand(use string, aliases []string, fn func(args []string) error) *cobra.Command {
+func generateDescribeSubcommand(use string, aliases []string, fn func(s sdk.Interface, args []string) error) *cobra.Command {
 	return &cobra.Command{
 		Use:     use,
 		Aliases: aliases,
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fn(args)
+			return fn(serviceFor(cmd), args)
 		},
 	}
 }
 
-func fetchCollections(args []string) error {
+func fetchCollections(s sdk.CollectionsService, args []string) error {
 	r := make(resources.CollectionSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Collection(context.Background(), id)
+		resource, err := s.Collection(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -140,10 +154,10 @@ func fetchCollections(args []string) error {
 	return nil
 }
 
-func fetchEnvironments(args []string) error {
+func fetchEnvironments(s sdk.EnvironmentsService, args []string) error {
 	r := make(resources.EnvironmentSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Environment(context.Background(), id)
+		resource, err := s.Environment(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -152,7 +166,7 @@ func fetchEnvironments(args []string) error {
 		r[i] = resource
 	}
 
-	out, err := describeEnvironments(r)
+	out, err := describeEnvironments(redactEnvironments(r))
 	if err != nil {
 		return err
 	}
@@ -162,10 +176,10 @@ func fetchEnvironments(args []string) error {
 	return nil
 }
 
-func fetchMocks(args []string) error {
+func fetchMocks(s sdk.MocksService, args []string) error {
 	r := make(resources.MockSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Mock(context.Background(), id)
+		resource, err := s.Mock(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -183,10 +197,10 @@ func fetchMocks(args []string) error {
 	return nil
 }
 
-func fetchMonitors(args []string) error {
+func fetchMonitors(s sdk.MonitorsService, args []string) error {
 	r := make(resources.MonitorSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Monitor(context.Background(), id)
+		resource, err := s.Monitor(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -204,10 +218,10 @@ func fetchMonitors(args []string) error {
 	return nil
 }
 
-func fetchWorkspaces(args []string) error {
+func fetchWorkspaces(s sdk.WorkspacesService, args []string) error {
 	r := make(resources.WorkspaceSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Workspace(context.Background(), id)
+		resource, err := s.Workspace(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -225,10 +239,10 @@ func fetchWorkspaces(args []string) error {
 	return nil
 }
 
-func fetchAPIs(args []string) error {
+func fetchAPIs(s sdk.APIsService, args []string) error {
 	r := make(resources.APISlice, len(args))
 	for i, id := range args {
-		resource, err := service.API(context.Background(), id)
+		resource, err := s.API(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -246,12 +260,12 @@ func fetchAPIs(args []string) error {
 	return nil
 }
 
-func fetchAPIVersions(args []string) error {
+func fetchAPIVersions(s sdk.APIVersionsService, args []string) error {
 	ids := args[0:]
 
 	r := make(resources.APIVersionSlice, len(ids))
 	for i, id := range ids {
-		resource, err := service.APIVersion(context.Background(), forAPI, id)
+		resource, err := s.APIVersion(context.Background(), forAPI, id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -269,8 +283,8 @@ func fetchAPIVersions(args []string) error {
 	return nil
 }
 
-func fetchAPIRelations(args []string) error {
-	resource, err := service.APIRelations(context.Background(), forAPI, forAPIVersion)
+func fetchAPIRelations(s sdk.APIVersionsService, args []string) error {
+	resource, err := s.APIRelations(context.Background(), forAPI, forAPIVersion)
 
 	if err != nil {
 		return handleResponseError(err)
@@ -285,10 +299,10 @@ func fetchAPIRelations(args []string) error {
 	return nil
 }
 
-func fetchSchema(args []string) error {
+func fetchSchema(s sdk.Interface, args []string) error {
 	var id string
 	if len(args) == 0 {
-		version, err := service.APIVersion(context.Background(), forAPI, forAPIVersion)
+		version, err := s.APIVersion(context.Background(), forAPI, forAPIVersion)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -301,7 +315,7 @@ func fetchSchema(args []string) error {
 		id = args[0]
 	}
 
-	resource, err := service.Schema(context.Background(), forAPI, forAPIVersion, id)
+	resource, err := s.Schema(context.Background(), forAPI, forAPIVersion, id)
 
 	if err != nil {
 		return handleResponseError(err)
@@ -374,7 +388,11 @@ func describeEnvironments(r resources.EnvironmentSlice) (string, error) {
 			buf.WriteString(fmt.Sprintf("Name:\t%s\n", e.Name))
 			buf.WriteString(fmt.Sprintln("Variables:"))
 			for _, v := range e.Values {
-				buf.WriteString(fmt.Sprintf("  %s\n", v.Key))
+				if showSecrets {
+					buf.WriteString(fmt.Sprintf("  %s:\t%s\n", v.Key, v.Value))
+				} else {
+					buf.WriteString(fmt.Sprintf("  %s\n", v.Key))
+				}
 			}
 			if _, err := buf.WriteTo(out); err != nil {
 				return err
@@ -398,7 +416,7 @@ func describeMonitors(r resources.MonitorSlice) (string, error) {
 			buf.WriteString(fmt.Sprintf("  Follow Redirects:\t%t\n", m.Options.FollowRedirects))
 			requestTimeout := "<default>"
 			if m.Options.RequestTimeout != nil {
-				requestTimeout = string(*m.Options.RequestTimeout)
+				requestTimeout = strconv.Itoa(*m.Options.RequestTimeout)
 			}
 			buf.WriteString(fmt.Sprintf("  Request Timeout:\t%s\n", requestTimeout))
 			buf.WriteString(fmt.Sprintf("  Request Delay:\t%d\n", m.Options.RequestDelay))
@@ -0,0 +1,34 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func TestDescribeEnvironmentsHidesValues(t *testing.T) {
+	out, err := execute(t, secretEnvironmentService(), "describe", "environment", "abcdef", "--show-secrets=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "staging.example.com") || strings.Contains(out, resources.SecretMask) || !strings.Contains(out, "  TOKEN\n") {
+		t.Errorf("have output %q, want the variable names only", out)
+	}
+}
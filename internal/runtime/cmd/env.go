@@ -0,0 +1,455 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/envcrypt"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/envfile"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envDisabled   bool
+	envSecret     bool
+	envFormat     string
+	envImportID   string
+	envImportName string
+	envSecretKeys []string
+	envInPlace    bool
+)
+
+// encryptionKeyEnv names the environment variable that overrides the
+// encryption key of the current context.
+const encryptionKeyEnv = "POSTMANCTL_ENCRYPTION_KEY"
+
+func init() {
+	envCmd := &cobra.Command{
+		Use:     "env",
+		Aliases: []string{"environment"},
+		Short:   "Work with the variables of an environment.",
+	}
+
+	envSetCmd := &cobra.Command{
+		Use:   "set <environment-id> KEY=VALUE...",
+		Short: "Set variables in an environment.",
+		Long: `Set variables in an environment.
+
+Variables that are already set keep their position in the environment, new
+ones are added at the end. All other variables are left as they are.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envSet(serviceFor(cmd), args[0], args[1:])
+		},
+	}
+	envSetCmd.Flags().BoolVar(&envDisabled, "disabled", false, "set the variables as disabled")
+	envSetCmd.Flags().BoolVar(&envSecret, "secret", false, "mark the variables as secret")
+
+	envUnsetCmd := &cobra.Command{
+		Use:   "unset <environment-id> KEY...",
+		Short: "Remove variables from an environment.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envUnset(serviceFor(cmd), args[0], args[1:])
+		},
+	}
+
+	envGetCmd := &cobra.Command{
+		Use:   "get <environment-id> KEY",
+		Short: "Print the value of a variable in an environment.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envGet(serviceFor(cmd), args[0], args[1])
+		},
+	}
+
+	envListVarsCmd := &cobra.Command{
+		Use:   "list-vars <environment-id>",
+		Short: "List the variables in an environment.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envListVars(serviceFor(cmd), args[0])
+		},
+	}
+	envListVarsCmd.Flags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
+
+	envExportCmd := &cobra.Command{
+		Use:   "export <environment-id>",
+		Short: "Write the variables of an environment to stdout.",
+		Long: `Write the variables of an environment to stdout as a dotenv file, shell
+export statements, a Postman environment file or a Kubernetes Secret.
+
+In dotenv files, disabled variables are commented out and secrets are marked
+with a "` + envfile.SecretMarker + `" line, so the file can be imported
+again without losing either.
+
+The values of secrets are masked unless "--show-secrets" is given. Importing
+the file again keeps the current value of secrets that are still masked.
+Formats that can't be imported, such as shell and k8s-secret, require
+"--show-secrets" when the environment has secrets.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envExport(serviceFor(cmd), args[0])
+		},
+	}
+	envExportCmd.Flags().StringVar(&envFormat, "format", "dotenv",
+		fmt.Sprintf("output format, one of: %s", strings.Join(envfile.Formats(), "|")))
+
+	envImportCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Create or update an environment from a file.",
+		Long: `Create or update an environment from a file.
+
+The environment to update is given with --id or found by --name, which
+defaults to the name of the file without its extension. When no environment
+has that name, a new one is created. The variables of the environment are
+replaced with those in the file, in the same order. Variables that are
+secret in the environment stay secret, and keep their value when the file
+has them masked.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envImport(serviceFor(cmd), args[0])
+		},
+	}
+	envImportCmd.Flags().StringVar(&envFormat, "format", "dotenv",
+		fmt.Sprintf("input format, one of: %s", strings.Join(envfile.ReadFormats(), "|")))
+	envImportCmd.Flags().StringVar(&envImportID, "id", "", "the environment to update")
+	envImportCmd.Flags().StringVar(&envImportName, "name", "", "the name of the environment to create or update")
+	envImportCmd.Flags().StringArrayVar(&envSecretKeys, "secret-key", nil, "mark a variable as secret, can be repeated")
+	envImportCmd.Flags().StringVarP(&usingWorkspace, "workspace", "w", "", "workspace for a new environment")
+
+	envEncryptCmd := &cobra.Command{
+		Use:   "encrypt <file>",
+		Short: "Encrypt the values of variables in an environment file.",
+		Long: `Encrypt the values of variables in a Postman environment file, so it can be
+kept in version control. Names and the structure of the file stay readable,
+and values that are already encrypted are left as they are.
+
+The passphrase is the encryption key of the current context, set with
+"postmanctl config set-context --encryption-key", or ` + encryptionKeyEnv + `.
+"create environment" and "replace environment" decrypt files with it.`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{annotationOffline: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envCrypt(args[0], (*envcrypt.Cipher).Encrypt)
+		},
+	}
+	envEncryptCmd.Flags().BoolVarP(&envInPlace, "in-place", "i", false, "write the result to the file instead of stdout")
+
+	envDecryptCmd := &cobra.Command{
+		Use:         "decrypt <file>",
+		Short:       "Decrypt the values of variables in an environment file.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{annotationOffline: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envCrypt(args[0], (*envcrypt.Cipher).Decrypt)
+		},
+	}
+	envDecryptCmd.Flags().BoolVarP(&envInPlace, "in-place", "i", false, "write the result to the file instead of stdout")
+
+	envCmd.AddCommand(envSetCmd, envUnsetCmd, envGetCmd, envListVarsCmd, envExportCmd, envImportCmd, envEncryptCmd, envDecryptCmd)
+	rootCmd.AddCommand(envCmd)
+}
+
+func envSet(s sdk.EnvironmentsService, id string, assignments []string) error {
+	values := make([]resources.KeyValuePair, len(assignments))
+	for i, a := range assignments {
+		eq := strings.Index(a, "=")
+		if eq <= 0 {
+			return fmt.Errorf("invalid variable %q, want KEY=VALUE", a)
+		}
+
+		values[i] = resources.KeyValuePair{
+			Key:     a[:eq],
+			Value:   a[eq+1:],
+			Enabled: !envDisabled,
+		}
+	}
+
+	result, err := s.UpdateEnvironment(context.Background(), id, func(env *resources.Environment) error {
+		for _, v := range values {
+			// Setting a secret again keeps it secret.
+			if current, ok := env.Value(v.Key); ok {
+				v.Type = current.Type
+			}
+			if envSecret {
+				v.Type = resources.SecretValueType
+			}
+
+			env.SetValue(v)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	fmt.Println(result.PreferredID())
+
+	return nil
+}
+
+func envUnset(s sdk.EnvironmentsService, id string, keys []string) error {
+	result, err := s.UpdateEnvironment(context.Background(), id, func(env *resources.Environment) error {
+		for _, key := range keys {
+			if !env.UnsetValue(key) {
+				return fmt.Errorf("variable %q is not set in the environment", key)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	fmt.Println(result.PreferredID())
+
+	return nil
+}
+
+func envGet(s sdk.EnvironmentsService, id, key string) error {
+	env, err := s.Environment(context.Background(), id)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	v, ok := env.Value(key)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error: variable %q is not set in the environment\n", key)
+		os.Exit(1)
+	}
+
+	if v.Secret() && !showSecrets {
+		v.Value = resources.SecretMask
+	}
+
+	fmt.Println(v.Value)
+
+	return nil
+}
+
+func envListVars(s sdk.EnvironmentsService, id string) error {
+	env, err := s.Environment(context.Background(), id)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	values := resources.KeyValuePairs(env.Values)
+	if !showSecrets {
+		values = values.Redacted()
+	}
+
+	printGetOutput(values)
+
+	return nil
+}
+
+func envExport(s sdk.EnvironmentsService, id string) error {
+	env, err := s.Environment(context.Background(), id)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	if !showSecrets {
+		if err := checkMaskedExport(env, envFormat); err != nil {
+			return err
+		}
+		env = env.Redacted()
+	}
+
+	return envfile.Write(os.Stdout, env, envFormat)
+}
+
+// checkMaskedExport refuses to export an environment with secrets masked in
+// a format that can't be imported again, where the mask would end up being
+// used as the value.
+func checkMaskedExport(env *resources.Environment, format string) error {
+	for _, f := range envfile.ReadFormats() {
+		if f == format {
+			return nil
+		}
+	}
+
+	for _, v := range env.Values {
+		if v.Secret() {
+			return fmt.Errorf("variable %q is secret, use --show-secrets to export its value as %s", v.Key, format)
+		}
+	}
+
+	return nil
+}
+
+func envImport(s sdk.EnvironmentsService, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	values, err := envfile.Read(f, envFormat)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %s", file, err)
+	}
+
+	for _, key := range envSecretKeys {
+		found := false
+		for i := range values {
+			if values[i].Key == key {
+				values[i].Type = resources.SecretValueType
+				found = true
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("variable %q is not set in %s", key, file)
+		}
+	}
+
+	ctx := context.Background()
+	id := envImportID
+	if id == "" {
+		name := envImportName
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		}
+		if name == "" {
+			return fmt.Errorf("unable to name the environment after %s, use \"--name\"", file)
+		}
+
+		id, err = findEnvironment(ctx, s, name)
+		if err != nil {
+			return err
+		}
+
+		if id == "" {
+			b, err := json.Marshal(&resources.Environment{Name: name, Values: values})
+			if err != nil {
+				return err
+			}
+
+			result, err := s.CreateEnvironmentFromReader(ctx, bytes.NewReader(b), usingWorkspace)
+			if err != nil {
+				return handleResponseError(err)
+			}
+
+			fmt.Println(result.PreferredID())
+			return nil
+		}
+	}
+
+	result, err := s.UpdateEnvironment(ctx, id, func(env *resources.Environment) error {
+		for i, v := range values {
+			if current, ok := env.Value(v.Key); ok && current.Secret() {
+				values[i].Type = resources.SecretValueType
+				if v.Value == resources.SecretMask {
+					values[i].Value = current.Value
+				}
+			}
+		}
+
+		env.Values = values
+		return nil
+	})
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	fmt.Println(result.PreferredID())
+
+	return nil
+}
+
+// envCrypt encrypts or decrypts file with fn, writing the result to stdout,
+// or back to the file with --in-place.
+func envCrypt(file string, fn func(*envcrypt.Cipher, []byte) ([]byte, error)) error {
+	c, err := envCipher()
+	if err != nil {
+		return err
+	}
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	out, err := fn(c, b)
+	if err != nil {
+		return fmt.Errorf("%s: %s", file, err)
+	}
+
+	if !envInPlace {
+		_, err := os.Stdout.Write(out)
+		return err
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, out, info.Mode())
+}
+
+// envCipher returns the cipher for the encryption key of the current
+// context, or of POSTMANCTL_ENCRYPTION_KEY when it's set.
+func envCipher() (*envcrypt.Cipher, error) {
+	key := os.Getenv(encryptionKeyEnv)
+	if key == "" {
+		key = configContext.EncryptionKey
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("no encryption key is configured, run: postmanctl config set-context --help, or set %s", encryptionKeyEnv)
+	}
+
+	return envcrypt.New(key)
+}
+
+// findEnvironment returns the UID of the environment with the given name,
+// or "" when there's none.
+func findEnvironment(ctx context.Context, s sdk.EnvironmentsService, name string) (string, error) {
+	envs, err := s.Environments(ctx)
+	if err != nil {
+		return "", handleResponseError(err)
+	}
+
+	var uid string
+	for _, e := range *envs {
+		if e.Name != name {
+			continue
+		}
+
+		if uid != "" {
+			return "", fmt.Errorf("more than one environment is named %q, use \"--id\"", name)
+		}
+		uid = e.UID
+	}
+
+	return uid, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/sdkmock"
+)
+
+func secretEnvironmentService() *sdkmock.ServiceMock {
+	return &sdkmock.ServiceMock{
+		EnvironmentFunc: func(ctx context.Context, id string) (*resources.Environment, error) {
+			return &resources.Environment{
+				ID:   id,
+				Name: "Staging",
+				Values: []resources.KeyValuePair{
+					{Key: "HOST", Value: "staging.example.com", Enabled: true},
+					{Key: "TOKEN", Value: "s3cr3t", Enabled: true, Type: resources.SecretValueType},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestEnvExportMaskedSecrets(t *testing.T) {
+	for _, format := range []string{"shell", "k8s-secret"} {
+		out, err := execute(t, secretEnvironmentService(), "env", "export", "abcdef", "--format", format, "--show-secrets=false")
+		if err == nil {
+			t.Errorf("%s: have no error, want one", format)
+		}
+		if out != "" {
+			t.Errorf("%s: have output %q, want none", format, out)
+		}
+	}
+
+	out, err := execute(t, secretEnvironmentService(), "env", "export", "abcdef", "--format", "shell", "--show-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "s3cr3t") {
+		t.Errorf("have output %q, want the secret value", out)
+	}
+
+	out, err = execute(t, secretEnvironmentService(), "env", "export", "abcdef", "--format", "dotenv", "--show-secrets=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "s3cr3t") || !strings.Contains(out, resources.SecretMask) {
+		t.Errorf("have output %q, want the secret masked", out)
+	}
+}
+
+func TestEnvImportPrintsResultID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "postmanctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "local.env")
+	if err := ioutil.WriteFile(file, []byte("HOST=localhost\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &sdkmock.ServiceMock{
+		EnvironmentsFunc: func(ctx context.Context) (*resources.EnvironmentListItems, error) {
+			return &resources.EnvironmentListItems{{ID: "existing", UID: "1-existing", Name: "Staging"}}, nil
+		},
+		CreateEnvironmentFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+			return &resources.Result{ID: "created", UID: "1-created"}, nil
+		},
+		UpdateEnvironmentFunc: func(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error) {
+			return &resources.Result{ID: "existing", UID: "1-existing"}, nil
+		},
+	}
+
+	out, err := execute(t, s, "env", "import", file, "--id=", "--name", "Local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "1-created\n" {
+		t.Errorf("have output %q after creating, want 1-created", out)
+	}
+
+	out, err = execute(t, s, "env", "import", file, "--id=", "--name", "Staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "1-existing\n" {
+		t.Errorf("have output %q after updating, want 1-existing", out)
+	}
+}
@@ -35,13 +35,13 @@ func init() {
 		Aliases: []string{"co"},
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := service.ForkCollection(context.Background(), args[0], usingWorkspace, forkLabel)
+			result, err := serviceFor(cmd).ForkCollection(context.Background(), args[0], usingWorkspace, forkLabel)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "error: %s\n", err)
 				os.Exit(1)
 			}
 
-			fmt.Println(id)
+			printResult(result)
 		},
 	}
 
@@ -49,6 +49,8 @@ func init() {
 	forkCollectionCmd.Flags().StringVarP(&forkLabel, "label", "l", "", "label to associate with the forked collection (required)")
 	forkCollectionCmd.MarkFlagRequired("label")
 
+	cmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
+
 	cmd.AddCommand(forkCollectionCmd)
 	rootCmd.AddCommand(cmd)
 }
@@ -23,6 +23,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"github.com/spf13/cobra"
 )
@@ -67,10 +68,10 @@ func init() {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				params := append([]string{forAPI}, args...)
-				return getIndividualAPIVersions(params)
+				return getIndividualAPIVersions(serviceFor(cmd), params)
 			}
 
-			return getAllResources(resources.APIVersionType, forAPI)
+			return getAllResources(serviceFor(cmd), resources.APIVersionType, forAPI)
 		},
 	}
 
@@ -82,7 +83,7 @@ func init() {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			params := []string{forAPI, forAPIVersion}
 			if len(args) == 0 {
-				version, err := service.APIVersion(context.Background(), forAPI, forAPIVersion)
+				version, err := serviceFor(cmd).APIVersion(context.Background(), forAPI, forAPIVersion)
 
 				if err != nil {
 					return handleResponseError(err)
@@ -96,7 +97,7 @@ func init() {
 				}
 			}
 			params = append(params, args...)
-			return getIndividualSchema(params)
+			return getIndividualSchema(serviceFor(cmd), params)
 		},
 	}
 
@@ -110,9 +111,9 @@ func init() {
 		Use: "api-relations",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if outputFormat.value == "" {
-				return getFormattedAPIRelations(forAPI, forAPIVersion)
+				return getFormattedAPIRelations(serviceFor(cmd), forAPI, forAPIVersion)
 			}
-			return getAPIRelations(forAPI, forAPIVersion)
+			return getAPIRelations(serviceFor(cmd), forAPI, forAPIVersion)
 		},
 	}
 
@@ -125,19 +126,31 @@ func init() {
 	userCmd := &cobra.Command{
 		Use: "user",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return getIndividualUser(args)
+			return getIndividualUser(serviceFor(cmd), args)
 		},
 	}
 
-	apisCmd := generateGetSubcommand(resources.APIType, "apis", []string{"api"}, getIndividualAPIs)
+	apisCmd := generateGetSubcommand(resources.APIType, "apis", []string{"api"}, func(s sdk.Interface, args []string) error {
+		return getIndividualAPIs(s, args)
+	})
 	apisCmd.Flags().StringVar(&usingWorkspace, "workspace", "", "the associated workspace ID")
 
 	getCmd.AddCommand(
-		generateGetSubcommand(resources.CollectionType, "collections", []string{"collection", "co"}, getIndividualCollections),
-		generateGetSubcommand(resources.EnvironmentType, "environments", []string{"environment", "env"}, getIndividualEnvironments),
-		generateGetSubcommand(resources.MonitorType, "monitors", []string{"monitor", "mon"}, getIndividualMonitors),
-		generateGetSubcommand(resources.MockType, "mocks", []string{"mock"}, getIndividualMocks),
-		generateGetSubcommand(resources.WorkspaceType, "workspaces", []string{"workspace", "ws"}, getIndividualWorkspaces),
+		generateGetSubcommand(resources.CollectionType, "collections", []string{"collection", "co"}, func(s sdk.Interface, args []string) error {
+			return getIndividualCollections(s, args)
+		}),
+		generateGetSubcommand(resources.EnvironmentType, "environments", []string{"environment", "env"}, func(s sdk.Interface, args []string) error {
+			return getIndividualEnvironments(s, args)
+		}),
+		generateGetSubcommand(resources.MonitorType, "monitors", []string{"monitor", "mon"}, func(s sdk.Interface, args []string) error {
+			return getIndividualMonitors(s, args)
+		}),
+		generateGetSubcommand(resources.MockType, "mocks", []string{"mock"}, func(s sdk.Interface, args []string) error {
+			return getIndividualMocks(s, args)
+		}),
+		generateGetSubcommand(resources.WorkspaceType, "workspaces", []string{"workspace", "ws"}, func(s sdk.Interface, args []string) error {
+			return getIndividualWorkspaces(s, args)
+		}),
 		userCmd,
 		apisCmd,
 		apiVersionsCmd,
@@ -149,21 +162,21 @@ func init() {
 	rootCmd.AddCommand(getCmd)
 }
 
-func generateGetSubcommand(t resources.ResourceType, use string, aliases []string, fn func(args []string) error) *cobra.Command {
+func generateGetSubcommand(t resources.ResourceType, use string, aliases []string, fn func(s sdk.Interface, args []string) error) *cobra.Command {
 	return &cobra.Command{
 		Use:     use,
 		Aliases: aliases,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
-				return fn(args)
+				return fn(serviceFor(cmd), args)
 			}
 
-			return getAllResources(t)
+			return getAllResources(serviceFor(cmd), t)
 		},
 	}
 }
 
-func getAllResources(resourceType resources.ResourceType, args ...string) error {
+func getAllResources(s sdk.Interface, resourceType resources.ResourceType, args ...string) error {
 	ctx := context.Background()
 
 	var resource interface{}
@@ -171,19 +184,19 @@ func getAllResources(resourceType resources.ResourceType, args ...string) error
 
 	switch resourceType {
 	case resources.CollectionType:
-		resource, err = service.Collections(ctx)
+		resource, err = s.Collections(ctx)
 	case resources.EnvironmentType:
-		resource, err = service.Environments(ctx)
+		resource, err = s.Environments(ctx)
 	case resources.MockType:
-		resource, err = service.Mocks(ctx)
+		resource, err = s.Mocks(ctx)
 	case resources.MonitorType:
-		resource, err = service.Monitors(ctx)
+		resource, err = s.Monitors(ctx)
 	case resources.APIType:
-		resource, err = service.APIs(ctx, usingWorkspace)
+		resource, err = s.APIs(ctx, usingWorkspace)
 	case resources.APIVersionType:
-		resource, err = service.APIVersions(ctx, args[0])
+		resource, err = s.APIVersions(ctx, args[0])
 	case resources.WorkspaceType:
-		resource, err = service.Workspaces(ctx)
+		resource, err = s.Workspaces(ctx)
 	default:
 		return fmt.Errorf("invalid resource type: %s", resourceType.String())
 	}
@@ -197,10 +210,10 @@ func getAllResources(resourceType resources.ResourceType, args ...string) error
 	return nil
 }
 
-func getIndividualCollections(args []string) error {
+func getIndividualCollections(s sdk.CollectionsService, args []string) error {
 	r := make(resources.CollectionSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Collection(context.Background(), id)
+		resource, err := s.Collection(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -214,10 +227,10 @@ func getIndividualCollections(args []string) error {
 	return nil
 }
 
-func getIndividualEnvironments(args []string) error {
+func getIndividualEnvironments(s sdk.EnvironmentsService, args []string) error {
 	r := make(resources.EnvironmentSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Environment(context.Background(), id)
+		resource, err := s.Environment(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -226,15 +239,15 @@ func getIndividualEnvironments(args []string) error {
 		r[i] = resource
 	}
 
-	printGetOutput(r)
+	printGetOutput(redactEnvironments(r))
 
 	return nil
 }
 
-func getIndividualMocks(args []string) error {
+func getIndividualMocks(s sdk.MocksService, args []string) error {
 	r := make(resources.MockSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Mock(context.Background(), id)
+		resource, err := s.Mock(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -248,10 +261,10 @@ func getIndividualMocks(args []string) error {
 	return nil
 }
 
-func getIndividualMonitors(args []string) error {
+func getIndividualMonitors(s sdk.MonitorsService, args []string) error {
 	r := make(resources.MonitorSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Monitor(context.Background(), id)
+		resource, err := s.Monitor(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -265,10 +278,10 @@ func getIndividualMonitors(args []string) error {
 	return nil
 }
 
-func getIndividualAPIs(args []string) error {
+func getIndividualAPIs(s sdk.APIsService, args []string) error {
 	r := make(resources.APISlice, len(args))
 	for i, id := range args {
-		resource, err := service.API(context.Background(), id)
+		resource, err := s.API(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -282,13 +295,13 @@ func getIndividualAPIs(args []string) error {
 	return nil
 }
 
-func getIndividualAPIVersions(args []string) error {
+func getIndividualAPIVersions(s sdk.APIVersionsService, args []string) error {
 	apiID := args[0]
 	ids := args[1:]
 
 	r := make(resources.APIVersionSlice, len(ids))
 	for i, id := range ids {
-		resource, err := service.APIVersion(context.Background(), apiID, id)
+		resource, err := s.APIVersion(context.Background(), apiID, id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -301,10 +314,10 @@ func getIndividualAPIVersions(args []string) error {
 
 	return nil
 }
-func getIndividualWorkspaces(args []string) error {
+func getIndividualWorkspaces(s sdk.WorkspacesService, args []string) error {
 	r := make(resources.WorkspaceSlice, len(args))
 	for i, id := range args {
-		resource, err := service.Workspace(context.Background(), id)
+		resource, err := s.Workspace(context.Background(), id)
 
 		if err != nil {
 			return handleResponseError(err)
@@ -318,8 +331,8 @@ func getIndividualWorkspaces(args []string) error {
 	return nil
 }
 
-func getIndividualUser(args []string) error {
-	resource, err := service.User(context.Background())
+func getIndividualUser(s sdk.UserService, args []string) error {
+	resource, err := s.User(context.Background())
 
 	if err != nil {
 		return handleResponseError(err)
@@ -330,12 +343,12 @@ func getIndividualUser(args []string) error {
 	return nil
 }
 
-func getIndividualSchema(args []string) error {
+func getIndividualSchema(s sdk.SchemasService, args []string) error {
 	apiID := args[0]
 	apiVersionID := args[1]
 	id := args[2]
 
-	resource, err := service.Schema(context.Background(), apiID, apiVersionID, id)
+	resource, err := s.Schema(context.Background(), apiID, apiVersionID, id)
 
 	if err != nil {
 		return handleResponseError(err)
@@ -346,8 +359,8 @@ func getIndividualSchema(args []string) error {
 	return nil
 }
 
-func getAPIRelations(apiID, apiVersionID string) error {
-	resource, err := service.APIRelations(context.Background(), apiID, apiVersionID)
+func getAPIRelations(s sdk.APIVersionsService, apiID, apiVersionID string) error {
+	resource, err := s.APIRelations(context.Background(), apiID, apiVersionID)
 
 	if err != nil {
 		return handleResponseError(err)
@@ -358,8 +371,8 @@ func getAPIRelations(apiID, apiVersionID string) error {
 	return nil
 }
 
-func getFormattedAPIRelations(apiID, apiVersionID string) error {
-	resource, err := service.FormattedAPIRelationItems(context.Background(), apiID, apiVersionID)
+func getFormattedAPIRelations(s sdk.APIVersionsService, apiID, apiVersionID string) error {
+	resource, err := s.FormattedAPIRelationItems(context.Background(), apiID, apiVersionID)
 
 	if err != nil {
 		return handleResponseError(err)
@@ -0,0 +1,222 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/lint"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintFormat      string
+	lintConfigFile  string
+	lintEnvironment string
+)
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check Postman resources against rules of good practice.",
+		Long: `Check Postman resources against rules of good practice.
+
+The severity of each rule can be changed, or the rule turned off, in a
+` + lint.ConfigFile + ` file, which is read from the current directory
+unless "--rules" is given:
+
+  rules:
+    request-examples: off
+    description: error
+
+Custom rules for schemas select values with a path and require a field to
+be present or to match a regular expression:
+
+  custom:
+    - id: operation-summary
+      description: Operations have a summary
+      severity: warning
+      given: $.paths.*.get
+      field: summary
+      pattern: ^[A-Z]
+
+The command exits with status 1 when a problem with the severity "error" is
+found.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&lintFormat, "format", "text",
+		fmt.Sprintf("output format, one of: %s", strings.Join(lint.Formats(), "|")))
+	cmd.PersistentFlags().StringVar(&lintConfigFile, "rules", "", fmt.Sprintf("file configuring the rules (default is %s)", lint.ConfigFile))
+
+	lintCollectionCmd := &cobra.Command{
+		Use:     "collection <id|file>...",
+		Aliases: []string{"co"},
+		Short:   "Check collections.",
+		Long:    "Check collections.\n\nRules:\n" + describeLintRules(lint.CollectionRules()),
+		Args:    cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := lintCollections(serviceFor(cmd), args); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	lintCollectionCmd.Flags().StringVarP(&lintEnvironment, "environment", "e", "", "environment ID or file defining variables used by the collections")
+
+	lintSchemaCmd := &cobra.Command{
+		Use:   "schema [id|file]",
+		Short: "Check an OpenAPI schema.",
+		Long: `Check an OpenAPI schema, read from a file or fetched from an API version.
+Without an ID, the API version's schema is checked.
+
+Rules:
+` + describeLintRules(lint.SchemaRules()),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := lintSchema(serviceFor(cmd), args); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	lintSchemaCmd.Flags().StringVar(&forAPI, "for-api", "", "the associated API ID")
+	lintSchemaCmd.Flags().StringVar(&forAPIVersion, "for-api-version", "", "the associated API Version ID")
+
+	cmd.AddCommand(lintCollectionCmd, lintSchemaCmd)
+	rootCmd.AddCommand(cmd)
+}
+
+// describeLintRules lists rules with their default severity for help text.
+func describeLintRules(rules []lint.Rule) string {
+	var b strings.Builder
+	for _, r := range rules {
+		fmt.Fprintf(&b, "  %-28s %-8s %s\n", r.ID, r.Severity, r.Description)
+	}
+
+	return b.String()
+}
+
+// loadLintConfig reads the file given with "--rules", or the default
+// config file when there is one.
+func loadLintConfig() (*lint.Config, error) {
+	if lintConfigFile != "" {
+		return lint.LoadConfig(lintConfigFile)
+	}
+
+	return lint.FindConfig()
+}
+
+// exitOnLint writes the problems and exits non-zero when any is an error.
+func exitOnLint(problems []lint.Problem, rules []lint.Rule) error {
+	if err := lint.Write(os.Stdout, problems, rules, lintFormat); err != nil {
+		return err
+	}
+
+	if lint.HasErrors(problems) {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+func lintCollections(s sdk.Interface, args []string) error {
+	cfg, err := loadLintConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	l := &lint.Linter{Config: cfg}
+
+	if lintEnvironment != "" {
+		env, err := loadEnvironment(ctx, s, lintEnvironment)
+		if err != nil {
+			return handleResponseError(err)
+		}
+
+		for _, v := range env.Values {
+			l.Variables = append(l.Variables, v.Key)
+		}
+	}
+
+	var problems []lint.Problem
+	for _, arg := range args {
+		c, err := loadCollection(ctx, s, arg)
+		if err != nil {
+			return handleResponseError(err)
+		}
+
+		uri := ""
+		if isLocalFile(arg) {
+			uri = arg
+		}
+
+		p, err := l.Collection(c, uri)
+		if err != nil {
+			return fmt.Errorf("%s: %s", arg, err)
+		}
+		problems = append(problems, p...)
+	}
+
+	return exitOnLint(problems, lint.CollectionRules())
+}
+
+func lintSchema(s sdk.Interface, args []string) error {
+	cfg, err := loadLintConfig()
+	if err != nil {
+		return err
+	}
+
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+
+	schema, err := loadSchema(context.Background(), s, arg)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	if schema.Type != "" && schema.Type != "openapi2" && schema.Type != "openapi3" {
+		return fmt.Errorf("schema type %q is not supported, expected openapi2 or openapi3", schema.Type)
+	}
+
+	doc, err := openapi.Parse([]byte(schema.Schema))
+	if err != nil {
+		return err
+	}
+
+	name, uri := schema.ID, ""
+	if arg != "" && isLocalFile(arg) {
+		name, uri = arg, arg
+	}
+
+	l := &lint.Linter{Config: cfg}
+
+	return exitOnLint(l.Schema(doc, name, uri), cfg.SchemaRules())
+}
@@ -35,13 +35,13 @@ func init() {
 		Aliases: []string{"co"},
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := service.MergeCollection(context.Background(), args[0], mergeCollection, mergeStrategy)
+			result, err := serviceFor(cmd).MergeCollection(context.Background(), args[0], mergeCollection, mergeStrategy)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "error: %s\n", err)
 				os.Exit(1)
 			}
 
-			fmt.Println(id)
+			printResult(result)
 		},
 	}
 
@@ -50,6 +50,8 @@ func init() {
 
 	mergeCollectionCmd.Flags().StringVarP(&mergeStrategy, "strategy", "s", "", "strategy for merging fork (optional, values: deleteSource, updateSourceWithDestination)")
 
+	cmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
+
 	cmd.AddCommand(mergeCollectionCmd)
 	rootCmd.AddCommand(cmd)
 }
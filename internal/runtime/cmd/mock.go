@@ -0,0 +1,152 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/mock"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mockAddr             string
+	mockEnvironment      string
+	mockMatchBody        bool
+	mockMatchQueryParams bool
+	mockMatchWildcards   bool
+	mockMatchHeaders     []string
+)
+
+func init() {
+	var cmd = &cobra.Command{
+		Use:   "mock",
+		Short: "Work with mock servers locally.",
+	}
+
+	var mockServeCmd = &cobra.Command{
+		Use:   "serve <collection-id|file>",
+		Short: "Serve the examples saved in a collection from a local mock server.",
+		Long: `Serve the examples saved in a collection from a local mock server.
+
+Requests are matched to examples the way Postman mock servers match them. The
+x-mock-response-name, x-mock-response-id and x-mock-response-code request
+headers select an example directly, and x-mock-match-request-body and
+x-mock-match-request-headers override the matching flags per request.`,
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mockServe(serviceFor(cmd), args[0]); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	defaults := mock.DefaultConfig()
+	mockServeCmd.Flags().StringVar(&mockAddr, "addr", "localhost:8080", "address to listen on")
+	mockServeCmd.Flags().StringVarP(&mockEnvironment, "environment", "e", "", "environment ID or file")
+	mockServeCmd.Flags().BoolVar(&mockMatchBody, "match-body", defaults.MatchBody, "match request bodies")
+	mockServeCmd.Flags().BoolVar(&mockMatchQueryParams, "match-query-params", defaults.MatchQueryParams, "match query parameters")
+	mockServeCmd.Flags().BoolVar(&mockMatchWildcards, "match-wildcards", defaults.MatchWildcards, "match :variable and {{variable}} path segments to any value")
+	mockServeCmd.Flags().StringArrayVar(&mockMatchHeaders, "match-header", nil, "match the value of this request header, can be repeated")
+
+	cmd.AddCommand(mockServeCmd)
+	rootCmd.AddCommand(cmd)
+}
+
+func mockServe(s sdk.Interface, arg string) error {
+	ctx := context.Background()
+
+	c, err := loadCollection(ctx, s, arg)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	opts := mock.Options{
+		Config: mock.DefaultConfig(),
+		OnRequest: func(r *http.Request, e *mock.Example) {
+			if e == nil {
+				fmt.Fprintf(os.Stderr, "%s %s 404 no matching example\n", r.Method, r.URL.RequestURI())
+				return
+			}
+			fmt.Fprintf(os.Stderr, "%s %s %d %q from %s\n", r.Method, r.URL.RequestURI(), e.Code(), e.Name, e.Item)
+		},
+	}
+
+	opts.Config.MatchBody = mockMatchBody
+	opts.Config.MatchQueryParams = mockMatchQueryParams
+	opts.Config.MatchWildcards = mockMatchWildcards
+	for _, h := range mockMatchHeaders {
+		opts.Config.Headers = append(opts.Config.Headers, h)
+	}
+
+	if mockEnvironment != "" {
+		env, err := loadEnvironment(ctx, s, mockEnvironment)
+		if err != nil {
+			return handleResponseError(err)
+		}
+		opts.Environment = env
+	}
+
+	server, err := mock.New(c, opts)
+	if err != nil {
+		return err
+	}
+
+	l, err := net.Listen("tcp", mockAddr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "serving %d examples on http://%s\n", len(server.Examples()), l.Addr())
+
+	srv := &http.Server{
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		IdleTimeout:       2 * time.Minute,
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt)
+		<-sig
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+	<-done
+
+	return nil
+}
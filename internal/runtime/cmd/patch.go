@@ -0,0 +1,159 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/spf13/cobra"
+)
+
+var (
+	patchType     string
+	patchDocument string
+	patchSet      []string
+)
+
+func init() {
+	patchCmd := &cobra.Command{
+		Use:   "patch",
+		Short: "Update fields of existing Postman resources.",
+		Long: `Update fields of existing Postman resources.
+
+The resource is fetched, the patch is applied to it and the result replaces
+the resource. A patch is either a JSON merge patch (RFC 7386), a JSON patch
+(RFC 6902) with --type json, or one or more --set key.path=value
+assignments.`,
+		Example: `  postmanctl patch environment 1234-abcdef --set values.0.value=staging.example.com
+  postmanctl patch monitor 1234-abcdef -p '{"schedule": {"cron": "0 6 * * *"}}'
+  postmanctl patch collection 1234-abcdef --type json -p '[{"op": "replace", "path": "/info/name", "value": "Echo"}]'`,
+	}
+	patchCmd.PersistentFlags().StringVar(&patchType, "type", "merge", "the type of patch, one of: merge|json")
+	patchCmd.PersistentFlags().StringVarP(&patchDocument, "patch", "p", "", "the patch document")
+	patchCmd.PersistentFlags().StringVarP(&inputFile, "filename", "f", "", "a file containing the patch document")
+	patchCmd.PersistentFlags().StringArrayVar(&patchSet, "set", nil, "set a value by path, e.g. schedule.cron=\"0 6 * * *\", can be repeated")
+	patchCmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
+
+	patchCmd.AddCommand(
+		generatePatchSubcommand(resources.CollectionType, "collection", []string{"co"}),
+		generatePatchSubcommand(resources.EnvironmentType, "environment", []string{"env"}),
+		generatePatchSubcommand(resources.MonitorType, "monitor", []string{"mon"}),
+		generatePatchSubcommand(resources.MockType, "mock", []string{}),
+		generatePatchSubcommand(resources.WorkspaceType, "workspace", []string{"ws"}),
+		generatePatchSubcommand(resources.APIType, "api", []string{}),
+		generatePatchSubcommand(resources.APIVersionType, "api-version", []string{}),
+		generatePatchSubcommand(resources.SchemaType, "schema", []string{}),
+	)
+
+	rootCmd.AddCommand(patchCmd)
+}
+
+func generatePatchSubcommand(t resources.ResourceType, use string, aliases []string) *cobra.Command {
+	cmd := cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return patchResource(serviceFor(cmd), t, args[0])
+		},
+	}
+
+	if t == resources.APIVersionType || t == resources.SchemaType {
+		cmd.Flags().StringVar(&forAPI, "for-api", "", "the associated API ID (required)")
+		cmd.MarkFlagRequired("for-api")
+	}
+
+	if t == resources.SchemaType {
+		cmd.Flags().StringVar(&forAPIVersion, "for-api-version", "", "the associated API Version ID (required)")
+		cmd.MarkFlagRequired("for-api-version")
+	}
+
+	return &cmd
+}
+
+func patchResource(s sdk.Interface, t resources.ResourceType, resourceID string) error {
+	fn, err := patchFunc()
+	if err != nil {
+		return err
+	}
+
+	urlParams := map[string]string{
+		"ID":           resourceID,
+		"apiID":        forAPI,
+		"apiVersionID": forAPIVersion,
+	}
+
+	result, err := s.Patch(context.Background(), t, urlParams, fn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	printResult(result)
+
+	return nil
+}
+
+func patchFunc() (patch.Func, error) {
+	if len(patchSet) > 0 {
+		if patchDocument != "" || inputFile != "" {
+			return nil, errors.New("flag \"set\" can't be used with a patch document")
+		}
+
+		return patch.Set(patchSet)
+	}
+
+	var (
+		doc []byte
+		err error
+	)
+
+	switch {
+	case patchDocument != "" && inputFile != "":
+		return nil, errors.New("flags \"patch\" and \"filename\" can't be used together")
+	case patchDocument != "":
+		doc = []byte(patchDocument)
+	case inputFile != "":
+		doc, err = ioutil.ReadFile(inputFile)
+	default:
+		stat, _ := os.Stdin.Stat()
+		if (stat.Mode() & os.ModeCharDevice) != 0 {
+			return nil, errors.New("no patch given, use \"--patch\", \"--filename\", \"--set\" or stdin")
+		}
+		doc, err = ioutil.ReadAll(os.Stdin)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch patchType {
+	case "merge":
+		return patch.MergePatch(doc)
+	case "json":
+		return patch.JSONPatch(doc)
+	}
+
+	return nil, fmt.Errorf("unknown patch type %q, want merge or json", patchType)
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"github.com/spf13/cobra"
 )
@@ -44,6 +45,7 @@ func init() {
 		},
 	}
 	replaceCmd.PersistentFlags().StringVarP(&inputFile, "filename", "f", "", "the filename used to replace the resource (required when not using data from stdin)")
+	replaceCmd.PersistentFlags().VarP(&outputFormat, "output", "o", "output format (json, jsonpath, go-template-file)")
 
 	replaceCmd.AddCommand(
 		generateReplaceSubcommand(resources.CollectionType, "collection", []string{"co"}),
@@ -65,10 +67,15 @@ func generateReplaceSubcommand(t resources.ResourceType, use string, aliases []s
 		Aliases: aliases,
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return replaceResource(t, args[0])
+			return replaceResource(serviceFor(cmd), t, args[0])
 		},
 	}
 
+	if t == resources.EnvironmentType {
+		cmd.Long = environmentHelp
+		cmd.Flags().BoolVar(&allowExec, "allow-exec", false, "resolve ${exec:...} references by running their commands")
+	}
+
 	if t == resources.APIVersionType || t == resources.SchemaType {
 		cmd.Flags().StringVar(&forAPI, "for-api", "", "the associated API ID (required)")
 		cmd.MarkFlagRequired("for-api")
@@ -82,7 +89,7 @@ func generateReplaceSubcommand(t resources.ResourceType, use string, aliases []s
 	return &cmd
 }
 
-func replaceResource(t resources.ResourceType, resourceID string) error {
+func replaceResource(s sdk.Interface, t resources.ResourceType, resourceID string) error {
 	if inputReader == nil {
 		r, err := os.Open(inputFile)
 
@@ -96,28 +103,30 @@ func replaceResource(t resources.ResourceType, resourceID string) error {
 	}
 
 	var (
-		id  string
-		err error
+		result *resources.Result
+		err    error
 	)
 
 	ctx := context.Background()
 	switch t {
 	case resources.CollectionType:
-		id, err = service.ReplaceCollectionFromReader(ctx, inputReader, resourceID)
+		result, err = s.ReplaceCollectionFromReader(ctx, inputReader, resourceID)
 	case resources.EnvironmentType:
-		id, err = service.ReplaceEnvironmentFromReader(ctx, inputReader, resourceID)
+		if err = resolveEnvironmentInput(ctx); err == nil {
+			result, err = s.ReplaceEnvironmentFromReader(ctx, inputReader, resourceID)
+		}
 	case resources.MockType:
-		id, err = service.ReplaceMockFromReader(ctx, inputReader, resourceID)
+		result, err = s.ReplaceMockFromReader(ctx, inputReader, resourceID)
 	case resources.MonitorType:
-		id, err = service.ReplaceMonitorFromReader(ctx, inputReader, resourceID)
+		result, err = s.ReplaceMonitorFromReader(ctx, inputReader, resourceID)
 	case resources.WorkspaceType:
-		id, err = service.ReplaceWorkspaceFromReader(ctx, inputReader, resourceID)
+		result, err = s.ReplaceWorkspaceFromReader(ctx, inputReader, resourceID)
 	case resources.APIType:
-		id, err = service.ReplaceAPIFromReader(ctx, inputReader, resourceID)
+		result, err = s.ReplaceAPIFromReader(ctx, inputReader, resourceID)
 	case resources.APIVersionType:
-		id, err = service.ReplaceAPIVersionFromReader(ctx, inputReader, forAPI, resourceID)
+		result, err = s.ReplaceAPIVersionFromReader(ctx, inputReader, resourceID, forAPI)
 	case resources.SchemaType:
-		id, err = service.ReplaceSchemaFromReader(ctx, inputReader, resourceID, forAPI, forAPIVersion)
+		result, err = s.ReplaceSchemaFromReader(ctx, inputReader, resourceID, forAPI, forAPIVersion)
 	}
 
 	if err != nil {
@@ -125,7 +134,7 @@ func replaceResource(t resources.ResourceType, resourceID string) error {
 		os.Exit(1)
 	}
 
-	fmt.Println(id)
+	printResult(result)
 
 	return nil
 }
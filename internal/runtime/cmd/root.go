@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -40,7 +41,6 @@ var (
 	configContext    config.Context
 	configContextKey string
 	options          *client.Options
-	service          *sdk.Service
 	forAPI           string
 	forAPIVersion    string
 	inputFile        string
@@ -49,8 +49,32 @@ var (
 	forkLabel        string
 	mergeStrategy    string
 	mergeCollection  string
+	showSecrets      bool
+	allowExec        bool
 )
 
+// annotationOffline marks commands that can run without a configured
+// context, such as those working on local files only.
+const annotationOffline = "postmanctl/offline"
+
+type serviceKey struct{}
+
+// withService returns a copy of ctx that makes the commands executed with it
+// use s instead of a client for the configured context.
+func withService(ctx context.Context, s sdk.Interface) context.Context {
+	return context.WithValue(ctx, serviceKey{}, s)
+}
+
+// serviceFor returns the Postman API service for cmd: the one it was executed
+// with, if any, or a client for the configured context.
+func serviceFor(cmd *cobra.Command) sdk.Interface {
+	if s, ok := cmd.Context().Value(serviceKey{}).(sdk.Interface); ok {
+		return s
+	}
+
+	return sdk.NewService(options)
+}
+
 var configContextFound = true
 var configFileFound = true
 var configContextSet = true
@@ -60,6 +84,10 @@ var rootCmd = &cobra.Command{
 	Short: "Controls the Postman API",
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
 		if !configFileFound || !configContextFound {
+			if _, ok := cmd.Annotations[annotationOffline]; ok {
+				return
+			}
+
 			processArgs := os.Args
 			if len(processArgs) > 2 {
 				command := processArgs[1]
@@ -92,6 +120,13 @@ func Execute() {
 	}
 }
 
+// ExecuteWithService runs postmanctl with args, using s as the Postman API
+// service instead of a client for the configured context.
+func ExecuteWithService(ctx context.Context, s sdk.Interface, args []string) error {
+	rootCmd.SetArgs(args)
+	return rootCmd.ExecuteContext(withService(ctx, s))
+}
+
 // GenMarkdownTree generates Markdown documentation for postmanctl.
 func GenMarkdownTree(path string) error {
 	return doc.GenMarkdownTree(rootCmd, path)
@@ -102,6 +137,7 @@ func init() {
 	cobra.OnInitialize(initAPIClientConfig)
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.postmanctl.yaml)")
 	rootCmd.PersistentFlags().StringVar(&configContextKey, "context", "", "context to use, overrides the current context in the config file")
+	rootCmd.PersistentFlags().BoolVar(&showSecrets, "show-secrets", false, "show the values of secret environment variables instead of masking them")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -174,5 +210,4 @@ func initAPIClientConfig() {
 	}
 
 	options = client.NewOptions(u, configContext.APIKey, http.DefaultClient)
-	service = sdk.NewService(options)
 }
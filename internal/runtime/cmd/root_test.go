@@ -0,0 +1,97 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/internal/runtime/cmd"
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/sdkmock"
+)
+
+// execute runs postmanctl with args against s and a config file with a
+// single context, returning what was written to stdout.
+func execute(t *testing.T, s sdk.Interface, args ...string) (string, error) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "postmanctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(config, []byte("currentContext: test\ncontexts:\n  test:\n    apiKey: key\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := os.Create(filepath.Join(dir, "stdout"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer null.Close()
+
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = out, null
+	defer func() { os.Stdout, os.Stderr = stdout, stderr }()
+
+	runErr := cmd.ExecuteWithService(context.Background(), s, append(args, "--config", config))
+
+	b, err := ioutil.ReadFile(out.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b), runErr
+}
+
+func TestForkCollection(t *testing.T) {
+	s := &sdkmock.ServiceMock{
+		ForkCollectionFunc: func(ctx context.Context, id string, workspace string, label string) (*resources.Result, error) {
+			return &resources.Result{ID: "forked"}, nil
+		},
+	}
+
+	out, err := execute(t, s, "fork", "collection", "abcdef", "--workspace", "ws", "--label", "mine")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := s.ForkCollectionCalls()
+	if len(calls) != 1 {
+		t.Fatalf("ForkCollection calls, have: %d, want: 1", len(calls))
+	}
+	if c := calls[0]; c.ID != "abcdef" || c.Workspace != "ws" || c.Label != "mine" {
+		t.Errorf("ForkCollection arguments, have: %s %s %s, want: abcdef ws mine", c.ID, c.Workspace, c.Label)
+	}
+	if !strings.Contains(out, "forked") {
+		t.Errorf("output, have: %q, want the forked ID", out)
+	}
+}
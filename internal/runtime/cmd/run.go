@@ -18,40 +18,246 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/reporters"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
 	"github.com/spf13/cobra"
 )
 
+var (
+	runEnvironment    string
+	runFolders        []string
+	runIterationData  string
+	runIterationCount int
+	runBail           bool
+	runRequestTimeout time.Duration
+	runScriptTimeout  time.Duration
+	runInsecure       bool
+	runReporter       string
+	runReporterOut    string
+	runMaxFailures    int
+	runFailOnError    bool
+	runTimeout        time.Duration
+)
+
 func init() {
 	var cmd = &cobra.Command{
 		Use:   "run",
 		Short: "Execute runnable Postman resources.",
 	}
 
+	cmd.PersistentFlags().StringVar(&runReporter, "reporter", "cli",
+		fmt.Sprintf("report format, one of: %s", strings.Join(reporters.Names(), "|")))
+	cmd.PersistentFlags().StringVar(&runReporterOut, "reporter-out", "", "write the report to a file instead of stdout")
+
 	var runMonitorCmd = &cobra.Command{
 		Use:     "monitor",
 		Aliases: []string{"mon"},
+		Short:   "Run a monitor and exit non-zero when it fails.",
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			msg, err := service.RunMonitor(context.Background(), args[0])
-			if err != nil {
+			if err := runMonitor(serviceFor(cmd), args[0]); err != nil {
 				fmt.Fprintf(os.Stderr, "error: %s\n", err)
 				os.Exit(1)
 			}
+		},
+	}
+
+	runMonitorCmd.Flags().IntVar(&runMaxFailures, "max-failures", 0, "number of failed assertions to tolerate")
+	runMonitorCmd.Flags().BoolVar(&runFailOnError, "fail-on-error", true, "fail when a request errors or the run errors")
+	runMonitorCmd.Flags().DurationVar(&runTimeout, "timeout", 0, "maximum time to wait for the run to finish, e.g. 5m")
 
-			b, err := json.MarshalIndent(&msg, "", "  ")
-			if err != nil {
+	var runCollectionCmd = &cobra.Command{
+		Use:     "collection <id|file>",
+		Aliases: []string{"co"},
+		Short:   "Run the requests in a collection locally.",
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runCollection(serviceFor(cmd), args[0]); err != nil {
 				fmt.Fprintf(os.Stderr, "error: %s\n", err)
 				os.Exit(1)
 			}
-
-			fmt.Println(string(b))
 		},
 	}
 
+	runCollectionCmd.Flags().StringVarP(&runEnvironment, "environment", "e", "", "environment ID or file")
+	runCollectionCmd.Flags().StringArrayVar(&runFolders, "folder", nil, "run only the requests in this folder, can be repeated")
+	runCollectionCmd.Flags().StringVarP(&runIterationData, "iteration-data", "d", "", "CSV or JSON file with a row of data variables per iteration")
+	runCollectionCmd.Flags().IntVarP(&runIterationCount, "iteration-count", "n", 0, "number of iterations")
+	runCollectionCmd.Flags().BoolVar(&runBail, "bail", false, "stop the run after the first failed request")
+	runCollectionCmd.Flags().DurationVar(&runRequestTimeout, "timeout-request", 0, "timeout for each request, e.g. 30s")
+	runCollectionCmd.Flags().DurationVar(&runScriptTimeout, "timeout-script", time.Minute, "timeout for each script, 0 for none")
+	runCollectionCmd.Flags().BoolVarP(&runInsecure, "insecure", "k", false, "skip TLS certificate verification")
+
+	cmd.AddCommand(runCollectionCmd)
 	cmd.AddCommand(runMonitorCmd)
 	rootCmd.AddCommand(cmd)
 }
+
+func runCollection(s sdk.Interface, arg string) error {
+	ctx := context.Background()
+
+	c, err := loadCollection(ctx, s, arg)
+	if err != nil {
+		return handleResponseError(err)
+	}
+
+	opts := runner.Options{
+		Folders:        runFolders,
+		IterationCount: runIterationCount,
+		Bail:           runBail,
+		ScriptTimeout:  runScriptTimeout,
+		Client:         &http.Client{Timeout: runRequestTimeout},
+	}
+
+	if runInsecure {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		opts.Client.Transport = transport
+	}
+
+	if runEnvironment != "" {
+		env, err := loadEnvironment(ctx, s, runEnvironment)
+		if err != nil {
+			return handleResponseError(err)
+		}
+		opts.Environment = env
+	}
+
+	if runIterationData != "" {
+		data, err := runner.ReadIterationDataFile(runIterationData)
+		if err != nil {
+			return err
+		}
+		opts.IterationData = data
+	}
+
+	reporter, err := reporters.New(runReporter)
+	if err != nil {
+		return err
+	}
+
+	var secrets []string
+	if opts.Environment != nil && !showSecrets {
+		secrets = opts.Environment.Secrets()
+	}
+
+	// The cli report on stdout shows each request as it completes.
+	var stream *reporters.CLIStream
+	if runReporter == "cli" && runReporterOut == "" {
+		opts.OnExecution = func(e *runner.Execution) {
+			x := reporters.FromExecution(e)
+			x.Redact(secrets)
+			stream.Execution(x)
+		}
+	}
+
+	r := runner.New(c, opts)
+	if opts.OnExecution != nil {
+		var name string
+		if c.Collection != nil && c.Info != nil {
+			name = c.Info.Name
+		}
+		stream = reporters.NewCLIStream(os.Stdout, name, r.Iterations())
+	}
+
+	summary, err := r.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	run := reporters.FromSummary(summary)
+	run.Redact(secrets)
+
+	if stream != nil {
+		err = reporters.WriteSummary(os.Stdout, run)
+	} else {
+		err = writeReport(reporter, run)
+	}
+	if err != nil {
+		return err
+	}
+
+	if run.Stats.Failed() {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+func runMonitor(s sdk.MonitorsService, id string) error {
+	reporter, err := reporters.New(runReporter)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if runTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, runTimeout)
+		defer cancel()
+	}
+
+	result, err := s.RunMonitor(ctx, id)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("monitor run did not finish within %s", runTimeout)
+		}
+		return handleResponseError(err)
+	}
+
+	run := reporters.FromMonitorRun(result)
+	if err := writeReport(reporter, run); err != nil {
+		return err
+	}
+
+	// The cli report already ends with the summary. Other reports may be
+	// parsed from stdout, so the summary goes to stderr unless the report
+	// was written to a file.
+	if runReporter != "cli" {
+		var w io.Writer = os.Stderr
+		if runReporterOut != "" {
+			w = os.Stdout
+		}
+
+		if err := reporters.WriteSummary(w, run); err != nil {
+			return err
+		}
+	}
+
+	thresholds := reporters.Thresholds{
+		MaxFailures: runMaxFailures,
+		FailOnError: runFailOnError,
+	}
+
+	if err := thresholds.Check(run); err != nil {
+		return fmt.Errorf("monitor run failed: %s", err)
+	}
+
+	return nil
+}
+
+func writeReport(reporter reporters.Reporter, run *reporters.Run) error {
+	var w io.Writer = os.Stdout
+	if runReporterOut != "" {
+		f, err := os.Create(runReporterOut)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return reporter.Report(w, run)
+}
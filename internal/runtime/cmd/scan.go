@@ -0,0 +1,198 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/scan"
+	"github.com/spf13/cobra"
+)
+
+var scanFormat string
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "scan",
+		Short: "Look for secrets left in Postman resources.",
+		Long: `Look for secrets left in collections and environments, such as tokens in
+headers, auth settings, URLs, bodies, variables and scripts.
+
+Values are matched against patterns for AWS keys, JSON Web Tokens, bearer
+tokens, private keys and GitHub and Slack tokens. Where a secret is expected,
+such as the token of a bearer auth or a variable named "apiKey", values that
+look random are reported too. References to variables are never reported.
+
+The command exits with status 1 when anything is found.`,
+	}
+
+	cmd.PersistentFlags().StringVar(&scanFormat, "format", "text",
+		fmt.Sprintf("output format, one of: %s", strings.Join(scan.Formats(), "|")))
+
+	scanCollectionCmd := &cobra.Command{
+		Use:     "collection <id|file>...",
+		Aliases: []string{"co"},
+		Short:   "Look for secrets in collections.",
+		Args:    cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			exitOnScan(scanCollections(serviceFor(cmd), args))
+		},
+	}
+
+	scanEnvironmentCmd := &cobra.Command{
+		Use:     "environment <id|file>...",
+		Aliases: []string{"env"},
+		Short:   "Look for secrets in environments.",
+		Args:    cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			exitOnScan(scanEnvironments(serviceFor(cmd), args))
+		},
+	}
+
+	scanWorkspaceCmd := &cobra.Command{
+		Use:     "workspace <id>...",
+		Aliases: []string{"ws"},
+		Short:   "Look for secrets in the collections and environments of workspaces.",
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exitOnScan(scanWorkspaces(serviceFor(cmd), args))
+		},
+	}
+
+	cmd.AddCommand(scanCollectionCmd, scanEnvironmentCmd, scanWorkspaceCmd)
+	rootCmd.AddCommand(cmd)
+}
+
+// exitOnScan writes the findings and exits non-zero when there are any.
+func exitOnScan(findings []scan.Finding, err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := scan.Write(os.Stdout, findings, scan.DefaultRules(), scanFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func scanCollections(s sdk.CollectionsService, args []string) ([]scan.Finding, error) {
+	ctx := context.Background()
+	scanner := scan.New()
+
+	var findings []scan.Finding
+	for _, arg := range args {
+		c, err := loadCollection(ctx, s, arg)
+		if err != nil {
+			return nil, handleResponseError(err)
+		}
+
+		uri := ""
+		if isLocalFile(arg) {
+			uri = arg
+		}
+
+		f, err := scanner.Collection(c, uri)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", arg, err)
+		}
+		findings = append(findings, f...)
+	}
+
+	return findings, nil
+}
+
+func scanEnvironments(s sdk.EnvironmentsService, args []string) ([]scan.Finding, error) {
+	ctx := context.Background()
+	scanner := scan.New()
+
+	var findings []scan.Finding
+	for _, arg := range args {
+		env, err := loadEnvironment(ctx, s, arg)
+		if err != nil {
+			return nil, handleResponseError(err)
+		}
+
+		uri := ""
+		if isLocalFile(arg) {
+			uri = arg
+		}
+
+		findings = append(findings, scanner.Environment(env, uri)...)
+	}
+
+	return findings, nil
+}
+
+func scanWorkspaces(s sdk.Interface, args []string) ([]scan.Finding, error) {
+	ctx := context.Background()
+	scanner := scan.New()
+
+	var findings []scan.Finding
+	for _, id := range args {
+		w, err := s.Workspace(ctx, id)
+		if err != nil {
+			return nil, handleResponseError(err)
+		}
+
+		for _, item := range w.Collections {
+			c, err := s.Collection(ctx, preferUID(item.UID, item.ID))
+			if err != nil {
+				return nil, handleResponseError(err)
+			}
+
+			f, err := scanner.Collection(c, "")
+			if err != nil {
+				return nil, fmt.Errorf("collection %s: %s", item.Name, err)
+			}
+			findings = append(findings, f...)
+		}
+
+		for _, item := range w.Environments {
+			env, err := s.Environment(ctx, preferUID(item.UID, item.ID))
+			if err != nil {
+				return nil, handleResponseError(err)
+			}
+
+			findings = append(findings, scanner.Environment(env, "")...)
+		}
+	}
+
+	return findings, nil
+}
+
+func preferUID(uid, id string) string {
+	if uid != "" {
+		return uid
+	}
+
+	return id
+}
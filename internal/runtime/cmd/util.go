@@ -18,7 +18,9 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -26,12 +28,75 @@ import (
 	"text/template"
 
 	sprig "github.com/Masterminds/sprig/v3"
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/client"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/envcrypt"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/printers"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resolver"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 	"k8s.io/client-go/util/jsonpath"
 )
 
+// secretResolvers resolve references to secrets, such as "${env:TOKEN}", in
+// environments before they are sent to the Postman API. Further kinds of
+// references are added with secretResolvers.Register.
+var secretResolvers = resolver.Default()
+
+// environmentHelp describes references to secrets for the commands sending
+// environments.
+const environmentHelp = `Variable values may reference secrets kept outside of the environment file,
+which are resolved before the environment is sent:
+
+  ${env:NAME}     the environment variable NAME
+  ${file:PATH}    the contents of the file at PATH
+  ${exec:COMMAND} the output of COMMAND, run with the shell
+
+References to commands are only resolved with --allow-exec, as they run
+whatever the file contains. Only give it for files you trust.
+
+Write "$${" for a literal "${".
+
+Files encrypted with "postmanctl env encrypt" are decrypted with the
+encryption key of the current context.`
+
+// resolveEnvironmentInput decrypts the environment read from inputReader
+// and replaces its references to secrets.
+func resolveEnvironmentInput(ctx context.Context) error {
+	b, err := ioutil.ReadAll(inputReader)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := envcrypt.Encrypted(b)
+	if err != nil {
+		return err
+	}
+
+	if encrypted {
+		c, err := envCipher()
+		if err != nil {
+			return fmt.Errorf("the environment is encrypted: %s", err)
+		}
+
+		if b, err = c.Decrypt(b); err != nil {
+			return err
+		}
+	}
+
+	if allowExec {
+		secretResolvers.Register("exec", resolver.Func(resolver.Exec))
+	}
+
+	b, err = secretResolvers.EnvironmentJSON(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	inputReader = bytes.NewReader(b)
+
+	return nil
+}
+
 func handleResponseError(err error) error {
 	if err, ok := err.(*client.RequestError); ok {
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -119,8 +184,148 @@ func printGetOutput(r interface{}) {
 	}
 }
 
+// redactEnvironments masks the values of secret variables unless
+// "--show-secrets" is set.
+func redactEnvironments(r resources.EnvironmentSlice) resources.EnvironmentSlice {
+	if showSecrets {
+		return r
+	}
+
+	return r.Redacted()
+}
+
+// printResult prints the ID of a resource that was created, replaced,
+// deleted, forked or merged, or the whole result when an output format is
+// set.
+func printResult(r *resources.Result) {
+	if outputFormat.value == "" {
+		fmt.Println(r.PreferredID())
+		return
+	}
+
+	printGetOutput(r)
+}
+
 func printTable(f resources.Formatter) {
 	w := printers.GetNewTabWriter(os.Stdout)
 	printer := printers.NewTablePrinter(printers.PrintOptions{})
 	printer.PrintResource(f, w)
 }
+
+// isLocalFile reports whether arg names an existing file rather than a
+// resource ID.
+func isLocalFile(arg string) bool {
+	fi, err := os.Stat(arg)
+	return err == nil && !fi.IsDir()
+}
+
+// loadCollection reads a collection from a file, either as exported or
+// wrapped in a "collection" member, or fetches it from the API by ID.
+func loadCollection(ctx context.Context, s sdk.CollectionsService, arg string) (*resources.Collection, error) {
+	if !isLocalFile(arg) {
+		if err := requireContext(); err != nil {
+			return nil, err
+		}
+
+		return s.Collection(ctx, arg)
+	}
+
+	b, err := ioutil.ReadFile(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(unwrapResource(b, "collection"), &c); err != nil {
+		return nil, fmt.Errorf("%s: %s", arg, err)
+	}
+
+	return &c, nil
+}
+
+// loadEnvironment reads an environment from a file, either as exported or
+// wrapped in an "environment" member, or fetches it from the API by ID.
+func loadEnvironment(ctx context.Context, s sdk.EnvironmentsService, arg string) (*resources.Environment, error) {
+	if !isLocalFile(arg) {
+		if err := requireContext(); err != nil {
+			return nil, err
+		}
+
+		return s.Environment(ctx, arg)
+	}
+
+	b, err := ioutil.ReadFile(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var env resources.Environment
+	if err := json.Unmarshal(unwrapResource(b, "environment"), &env); err != nil {
+		return nil, fmt.Errorf("%s: %s", arg, err)
+	}
+
+	return &env, nil
+}
+
+// loadSchema reads a schema from a file or fetches it from the API version
+// given with "--for-api" and "--for-api-version". Without an ID, the
+// version's first schema is fetched.
+func loadSchema(ctx context.Context, s sdk.Interface, arg string) (*resources.Schema, error) {
+	if arg != "" && isLocalFile(arg) {
+		b, err := ioutil.ReadFile(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		return &resources.Schema{Schema: string(b)}, nil
+	}
+
+	if err := requireContext(); err != nil {
+		return nil, err
+	}
+
+	if forAPI == "" || forAPIVersion == "" {
+		return nil, errors.New("a schema file or the flags --for-api and --for-api-version are required")
+	}
+
+	if arg == "" {
+		version, err := s.APIVersion(ctx, forAPI, forAPIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(version.Schema) == 0 {
+			return nil, errors.New("no schema has been associated with this API version")
+		}
+		arg = version.Schema[0]
+	}
+
+	return s.Schema(ctx, forAPI, forAPIVersion, arg)
+}
+
+// requireContext returns an error when an offline command needs the API but
+// no context is configured.
+func requireContext() error {
+	if !configContextSet {
+		return errors.New("context is not set, run: postmanctl config use-context --help")
+	} else if !configContextFound {
+		return fmt.Errorf("context '%s' is not configured, run: postmanctl config set-context --help", configContextKey)
+	} else if !configFileFound {
+		return errors.New("config file not found at $HOME/.postmanctl.yaml, run: postmanctl config set-context --help")
+	}
+
+	return nil
+}
+
+func unwrapResource(b []byte, key string) []byte {
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(b, &wrapper); err != nil {
+		return b
+	}
+
+	if inner, ok := wrapper[key]; ok && len(wrapper) == 1 {
+		return inner
+	}
+
+	return b
+}
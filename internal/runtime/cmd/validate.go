@@ -0,0 +1,78 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/spf13/cobra"
+)
+
+var validateFile string
+
+func init() {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check a collection file against the collection schema.",
+		Long: `Check a collection file against the collection schema (v2.1.0), the way
+"create collection", "replace collection" and "patch collection" do before
+sending it.
+
+Each problem is reported with the path to the failing value, such as
+item[3].item[0].request.url. The command exits with status 1 when the
+collection is invalid.`,
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			annotationOffline: "true",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := validateCollection(validateFile); err != nil {
+				fmt.Fprintf(os.Stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&validateFile, "filename", "f", "", "the collection file to check (required)")
+	cmd.MarkFlagRequired("filename")
+
+	rootCmd.AddCommand(cmd)
+}
+
+func validateCollection(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(unwrapResource(b, "collection"), &v); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+
+	if err := resources.ValidateCollection(v); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is a valid collection\n", path)
+
+	return nil
+}
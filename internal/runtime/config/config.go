@@ -26,4 +26,7 @@ type Config struct {
 type Context struct {
 	APIKey  string `mapstructure:"apiKey"`
 	APIRoot string `mapstructure:"apiRoot"`
+
+	// EncryptionKey is the passphrase for encrypted environment files.
+	EncryptionKey string `mapstructure:"encryptionKey"`
 }
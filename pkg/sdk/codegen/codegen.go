@@ -0,0 +1,462 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package codegen renders the requests of a collection as curl and HTTPie
+// commands and as client code.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+// Languages returns the languages Generate writes.
+func Languages() []string {
+	return []string{"curl", "go", "python-requests", "node-fetch", "httpie"}
+}
+
+// Request is a request with its variables resolved and its auth applied.
+type Request struct {
+	Method string
+	URL    string
+	Header []resources.Header
+
+	// Body holds a raw, urlencoded or GraphQL body. Multipart bodies are
+	// kept as fields in Form instead, so snippets can read files when run.
+	Body string
+	Form []FormField
+
+	// Unresolved holds the variables left in the URL, headers and bodies
+	// because they have no value, sorted by name.
+	Unresolved []string
+}
+
+// FormField is a multipart form field with either a value or the path of a
+// file.
+type FormField struct {
+	Name  string
+	Value string
+	File  string
+}
+
+// Build finds the request at path in a collection, such as
+// "Folder/Request", and resolves its variables against the collection and an
+// optional environment. Auth is applied the way "run collection" applies
+// it, inherited from folders and the collection. Dynamic variables, such as
+// {{$guid}}, are generated once.
+func Build(c *resources.Collection, env *resources.Environment, path string) (*Request, error) {
+	if c.Items == nil {
+		return nil, fmt.Errorf("the collection has no request %q", path)
+	}
+
+	ref, ok := c.Items.FindByPath(path)
+	if !ok {
+		return nil, fmt.Errorf("the collection has no request %q", path)
+	}
+
+	if ref.IsFolder() {
+		return nil, fmt.Errorf("%q is a folder, not a request", path)
+	}
+
+	r := runner.New(c, runner.Options{Environment: env})
+	steps, err := r.Plan()
+	if err != nil {
+		return nil, err
+	}
+
+	var auth *gen.Auth
+	for _, s := range steps {
+		if s.Item.Item == ref.Item {
+			auth = s.Auth
+			break
+		}
+	}
+
+	def, err := ref.Item.ParseRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	def, form := splitForm(def)
+
+	req, err := runner.ResolveRequest(def, auth, r.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &Request{Method: req.Method, URL: runner.UnescapeVariables(req.URL.String()), Body: req.Body}
+	unresolved := make(map[string]bool)
+	for _, name := range req.Unresolved {
+		unresolved[name] = true
+	}
+
+	for _, h := range req.Header {
+		// The tools set the content type of multipart bodies, along with
+		// their boundary.
+		if form != nil && h.Key == "Content-Type" {
+			continue
+		}
+		out.Header = append(out.Header, h)
+	}
+
+	out.Form = formFields(form, r.Variables, unresolved)
+
+	for name := range unresolved {
+		out.Unresolved = append(out.Unresolved, name)
+	}
+	sort.Strings(out.Unresolved)
+
+	return out, nil
+}
+
+// splitForm takes the fields of a multipart body out of a request, so they
+// are written as fields rather than an encoded body.
+func splitForm(def *resources.Request) (*resources.Request, []resources.FormParameter) {
+	if def.Body == nil || def.Body.Disabled || def.Body.Mode != "formdata" {
+		return def, nil
+	}
+
+	withoutBody := *def
+	withoutBody.Body = nil
+
+	return &withoutBody, def.Body.FormData
+}
+
+// formFields resolves the enabled fields of a multipart body, adding the
+// variables without a value to unresolved.
+func formFields(form []resources.FormParameter, vars *runner.Variables, unresolved map[string]bool) []FormField {
+	var fields []FormField
+	for _, p := range form {
+		if p.Disabled {
+			continue
+		}
+
+		name := vars.Replace(p.Key)
+		if p.Type != "file" {
+			f := FormField{Name: name, Value: vars.Replace(p.Value)}
+			for _, n := range vars.Unresolved(f.Value) {
+				unresolved[n] = true
+			}
+			fields = append(fields, f)
+			continue
+		}
+
+		for _, src := range p.Files() {
+			fields = append(fields, FormField{Name: name, File: src})
+		}
+	}
+
+	return fields
+}
+
+// Files reports whether the request uploads files.
+func (r *Request) Files() bool {
+	for _, f := range r.Form {
+		if f.File != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Generate writes a request as a command or as client code in one of
+// Languages.
+func Generate(w io.Writer, r *Request, lang string) error {
+	var tmpl *template.Template
+	switch lang {
+	case "curl":
+		tmpl = curlTemplate
+	case "go":
+		tmpl = goTemplate
+	case "python-requests":
+		tmpl = pythonTemplate
+	case "node-fetch":
+		tmpl = nodeTemplate
+	case "httpie":
+		tmpl = httpieTemplate
+	default:
+		return fmt.Errorf("unknown language %q, expected one of: %s", lang, strings.Join(Languages(), ", "))
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, r); err != nil {
+		return err
+	}
+
+	if lang != "go" {
+		_, err := buf.WriteTo(w)
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+// goImports returns the packages the Go snippet for a request imports.
+func goImports(r *Request) []string {
+	imports := []string{"fmt", "io/ioutil", "net/http"}
+	switch {
+	case r.Files():
+		imports = append(imports, "bytes", "io", "mime/multipart", "os", "path/filepath")
+	case r.Form != nil:
+		imports = append(imports, "bytes", "mime/multipart")
+	case r.Body != "":
+		imports = append(imports, "strings")
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+// Quote writes a string literal that is valid in both JavaScript and
+// Python.
+func Quote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	// Encoding a string never fails.
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+var funcs = template.FuncMap{
+	"quote": Quote,
+	// sh quotes a shell word.
+	"sh": func(s string) string {
+		return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+	},
+	// goString writes a Go string literal, raw when that keeps a multiline
+	// string readable.
+	"goString": func(s string) string {
+		if strings.Contains(s, "\n") && !strings.ContainsAny(s, "`\r") {
+			return "`" + s + "`"
+		}
+		return strconv.Quote(s)
+	},
+	"goQuote":   strconv.Quote,
+	"goImports": goImports,
+	"header": func(h resources.Header, sep string) string {
+		return h.Key + sep + h.Value
+	},
+	// httpieHeader writes a header item; HTTPie sends headers without a
+	// value when they end in ";".
+	"httpieHeader": func(h resources.Header) string {
+		if h.Value == "" {
+			return h.Key + ";"
+		}
+		return h.Key + ":" + h.Value
+	},
+	// httpieField writes a form field item, escaping a leading "@" that
+	// HTTPie would otherwise read as a file to embed.
+	"httpieField": func(f FormField) string {
+		if strings.HasPrefix(f.Value, "@") {
+			return f.Name + `=\` + f.Value
+		}
+		return f.Name + "=" + f.Value
+	},
+}
+
+var curlTemplate = template.Must(template.New("curl").Funcs(funcs).Parse(`curl
+{{- if or (ne .Method "GET") .Body .Form}} -X {{.Method}}{{end}} {{sh .URL}}
+{{- range .Header}} \
+  -H {{sh (header . ": ")}}
+{{- end}}
+{{- range .Form}} \
+{{- if .File}}
+  -F {{sh (printf "%s=@%s" .Name .File)}}
+{{- else}}
+  --form-string {{sh (printf "%s=%s" .Name .Value)}}
+{{- end}}
+{{- end}}
+{{- if .Body}} \
+  --data-raw {{sh .Body}}
+{{- end}}
+`))
+
+var httpieTemplate = template.Must(template.New("httpie").Funcs(funcs).Parse(`http
+{{- if .Form}} --multipart{{end}} {{.Method}} {{sh .URL}}
+{{- range .Header}} \
+  {{sh (httpieHeader .)}}
+{{- end}}
+{{- range .Form}} \
+{{- if .File}}
+  {{sh (printf "%s@%s" .Name .File)}}
+{{- else}}
+  {{sh (httpieField .)}}
+{{- end}}
+{{- end}}
+{{- if .Body}} \
+  --raw {{sh .Body}}
+{{- end}}
+`))
+
+var goTemplate = template.Must(template.New("go").Funcs(funcs).Parse(`package main
+
+import (
+{{- range goImports .}}
+	{{goQuote .}}
+{{- end}}
+)
+
+func main() {
+{{- if .Form}}
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+{{- range .Form}}
+{{- if .File}}
+	if err := addFile(w, {{goQuote .Name}}, {{goQuote .File}}); err != nil {
+		panic(err)
+	}
+{{- else}}
+	if err := w.WriteField({{goQuote .Name}}, {{goQuote .Value}}); err != nil {
+		panic(err)
+	}
+{{- end}}
+{{- end}}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+{{else if .Body}}
+	body := strings.NewReader({{goString .Body}})
+{{end}}
+	req, err := http.NewRequest({{goQuote .Method}}, {{goQuote .URL}}, {{if or .Form .Body}}body{{else}}nil{{end}})
+	if err != nil {
+		panic(err)
+	}
+{{- range .Header}}
+{{- if eq .Key "Host"}}
+	req.Host = {{goQuote .Value}}
+{{- else}}
+	req.Header.Add({{goQuote .Key}}, {{goQuote .Value}})
+{{- end}}
+{{- end}}
+{{- if .Form}}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+{{- end}}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(res.Status)
+	fmt.Println(string(b))
+}
+{{- if .Files}}
+
+func addFile(w *multipart.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	part, err := w.CreateFormFile(name, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, f)
+	return err
+}
+{{- end}}
+`))
+
+var pythonTemplate = template.Must(template.New("python-requests").Funcs(funcs).Parse(`import requests
+
+url = {{quote .URL}}
+headers = {
+{{- range .Header}}
+    {{quote .Key}}: {{quote .Value}},
+{{- end}}
+}
+{{- if .Form}}
+files = [
+{{- range .Form}}
+{{- if .File}}
+    ({{quote .Name}}, open({{quote .File}}, "rb")),
+{{- else}}
+    ({{quote .Name}}, (None, {{quote .Value}})),
+{{- end}}
+{{- end}}
+]
+{{- else if .Body}}
+data = {{quote .Body}}.encode()
+{{- end}}
+
+response = requests.request({{quote .Method}}, url, headers=headers
+{{- if .Form}}, files=files{{else if .Body}}, data=data{{end}})
+
+print(response.status_code)
+print(response.text)
+`))
+
+var nodeTemplate = template.Must(template.New("node-fetch").Funcs(funcs).Parse(`const fetch = require('node-fetch');
+{{- if .Files}}
+const fs = require('fs');
+{{- end}}
+{{- if .Form}}
+const FormData = require('form-data');
+
+const form = new FormData();
+{{- range .Form}}
+{{- if .File}}
+form.append({{quote .Name}}, fs.createReadStream({{quote .File}}));
+{{- else}}
+form.append({{quote .Name}}, {{quote .Value}});
+{{- end}}
+{{- end}}
+{{- end}}
+
+fetch({{quote .URL}}, {
+  method: {{quote .Method}},
+  headers: {
+{{- range .Header}}
+    {{quote .Key}}: {{quote .Value}},
+{{- end}}
+  },
+{{- if .Form}}
+  body: form,
+{{- else if .Body}}
+  body: {{quote .Body}},
+{{- end}}
+})
+  .then((res) => res.text())
+  .then((text) => console.log(text));
+`))
@@ -0,0 +1,121 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codegen_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/codegen"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var requests = []string{"Health", "Orders/Create order", "Orders/Upload receipt", "Orders/Search orders"}
+
+func readCollection(t *testing.T) *resources.Collection {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return &c
+}
+
+func TestGenerate(t *testing.T) {
+	c := readCollection(t)
+	env := &resources.Environment{
+		Name: "staging",
+		Values: []resources.KeyValuePair{
+			{Key: "baseUrl", Value: "https://staging.example.com", Enabled: true},
+			{Key: "token", Value: "staging-token", Enabled: true},
+			{Key: "sku", Value: "A-1", Enabled: true},
+		},
+	}
+
+	for _, lang := range codegen.Languages() {
+		var buf bytes.Buffer
+		for _, path := range requests {
+			r, err := codegen.Build(c, env, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf.WriteString("### " + path + "\n")
+			if err := codegen.Generate(&buf, r, lang); err != nil {
+				t.Fatalf("%s: %s", lang, err)
+			}
+		}
+
+		path := "testdata/" + lang + ".golden"
+		if *update {
+			if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%s output differs from %s:\n%s", lang, path, buf.String())
+		}
+	}
+
+	if err := codegen.Generate(ioutil.Discard, &codegen.Request{}, "cobol"); err == nil {
+		t.Error("have no error for an unknown language")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	c := readCollection(t)
+
+	r, err := codegen.Build(c, nil, "Health")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"note"}; !reflect.DeepEqual(r.Unresolved, want) {
+		t.Errorf("have unresolved variables %v, want %v", r.Unresolved, want)
+	}
+
+	for path, want := range map[string]string{
+		"Missing":      "no request",
+		"Orders":       "is a folder",
+		"Orders/Nope":  "no request",
+		"Health/Child": "no request",
+	} {
+		if _, err := codegen.Build(c, nil, path); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: have error %v, want one containing %q", path, err, want)
+		}
+	}
+}
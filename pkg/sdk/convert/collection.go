@@ -0,0 +1,1142 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// CollectionSchema is the schema URL of the collections FromOpenAPI
+// writes.
+const CollectionSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// Folder layouts of collections converted from OpenAPI documents.
+const (
+	// FoldersByTag puts requests in a folder named after the first tag of
+	// their operation. Untagged requests stay at the top.
+	FoldersByTag = "tags"
+
+	// FoldersByPath nests requests in a folder for each segment of their
+	// path.
+	FoldersByPath = "paths"
+)
+
+// FolderLayouts returns the folder layouts FromOpenAPI supports.
+func FolderLayouts() []string {
+	return []string{FoldersByTag, FoldersByPath}
+}
+
+// CollectionOptions configures FromOpenAPI.
+type CollectionOptions struct {
+	// Folders is the folder layout, FoldersByTag when empty.
+	Folders string
+}
+
+// FromOpenAPI converts an OpenAPI 2 or 3 document to a collection.
+//
+// Every operation becomes a request to {{baseUrl}}, a collection variable
+// set to the first server. Parameters without examples are written as
+// placeholders, such as <integer>, and bodies are generated from their
+// schemas when the document has no example. Responses become saved
+// examples. Security schemes become auth, with the secrets as collection
+// variables; openIdConnect and mutualTLS schemes have no equivalent and are
+// left out.
+func FromOpenAPI(d *openapi.Document, opts CollectionOptions) (*resources.Collection, error) {
+	layout := opts.Folders
+	if layout == "" {
+		layout = FoldersByTag
+	}
+	if layout != FoldersByTag && layout != FoldersByPath {
+		return nil, fmt.Errorf("unknown folder layout %q, expected one of: %s", layout, strings.Join(FolderLayouts(), ", "))
+	}
+
+	info, _ := d.Root["info"].(map[string]interface{})
+	title, _ := info["title"].(string)
+	if title == "" {
+		title = "API"
+	}
+
+	cv := &collectionConverter{
+		doc: d,
+		collection: &gen.Collection{
+			Info: &gen.Info{
+				Name:   title,
+				Schema: CollectionSchema,
+			},
+			Item: []interface{}{},
+		},
+		layout:    layout,
+		folders:   make(map[string]*gen.ItemGroup),
+		variables: make(map[string]bool),
+	}
+
+	if desc, ok := info["description"].(string); ok && desc != "" {
+		cv.collection.Info.Description = desc
+	}
+
+	cv.addVariable("baseUrl", cv.baseURL())
+
+	if auth, ok := cv.auth(d.Root["security"]); ok {
+		cv.collection.Auth = auth
+		cv.globalAuth = true
+	}
+
+	if layout == FoldersByTag {
+		cv.tagFolders()
+	}
+
+	for _, op := range d.Operations() {
+		item, err := cv.request(op)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", strings.ToUpper(op.Method), op.Path, err)
+		}
+
+		cv.add(op, item)
+	}
+
+	cv.dropEmptyFolders()
+
+	b, err := json.Marshal(resources.Collection{Collection: cv.collection})
+	if err != nil {
+		return nil, err
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+type collectionConverter struct {
+	doc        *openapi.Document
+	collection *gen.Collection
+	layout     string
+	globalAuth bool
+
+	// folders holds the folders by tag or by path.
+	folders   map[string]*gen.ItemGroup
+	variables map[string]bool
+}
+
+func (cv *collectionConverter) addVariable(key, value string) {
+	if cv.variables[key] {
+		return
+	}
+	cv.variables[key] = true
+
+	cv.collection.Variable = append(cv.collection.Variable, &gen.Variable{Key: key, Value: value, Type: "string"})
+}
+
+// baseURL returns the URL of the first server, with the defaults of its
+// variables, or the host and base path of an OpenAPI 2 document.
+func (cv *collectionConverter) baseURL() string {
+	if cv.doc.IsV2() {
+		host, _ := cv.doc.Root["host"].(string)
+		basePath, _ := cv.doc.Root["basePath"].(string)
+		if host == "" {
+			return strings.TrimSuffix(basePath, "/")
+		}
+
+		scheme := "https"
+		if schemes, ok := cv.doc.Root["schemes"].([]interface{}); ok && len(schemes) > 0 {
+			if s, ok := schemes[0].(string); ok {
+				scheme = s
+			}
+		}
+
+		return strings.TrimSuffix(scheme+"://"+host+basePath, "/")
+	}
+
+	servers, _ := cv.doc.Root["servers"].([]interface{})
+	if len(servers) == 0 {
+		return ""
+	}
+
+	server, _ := servers[0].(map[string]interface{})
+	u, _ := server["url"].(string)
+	vars, _ := server["variables"].(map[string]interface{})
+	for name, v := range vars {
+		if v, ok := v.(map[string]interface{}); ok {
+			u = strings.Replace(u, "{"+name+"}", text(v["default"]), -1)
+		}
+	}
+
+	return strings.TrimSuffix(u, "/")
+}
+
+// deref follows the local references of a value.
+func (cv *collectionConverter) deref(v interface{}) map[string]interface{} {
+	for i := 0; i < 16; i++ {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+
+		if v, _ = cv.doc.Resolve(ref); v == nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// tagFolders creates a folder for every tag of the document, in order.
+func (cv *collectionConverter) tagFolders() {
+	tags, _ := cv.doc.Root["tags"].([]interface{})
+	for _, t := range tags {
+		tag, _ := t.(map[string]interface{})
+		name, _ := tag["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		folder := cv.folder(name, name, &cv.collection.Item)
+		if desc, ok := tag["description"].(string); ok && desc != "" {
+			folder.Description = desc
+		}
+	}
+}
+
+// folder returns the folder with the given key, adding it to items when
+// it doesn't exist.
+func (cv *collectionConverter) folder(key, name string, items *[]interface{}) *gen.ItemGroup {
+	if f, ok := cv.folders[key]; ok {
+		return f
+	}
+
+	f := &gen.ItemGroup{Name: name, Item: []interface{}{}}
+	cv.folders[key] = f
+	*items = append(*items, f)
+
+	return f
+}
+
+// add puts a request in its folder.
+func (cv *collectionConverter) add(op openapi.Operation, item *collectionItem) {
+	items := &cv.collection.Item
+
+	switch cv.layout {
+	case FoldersByTag:
+		if tags, ok := op.Value["tags"].([]interface{}); ok && len(tags) > 0 {
+			if tag, ok := tags[0].(string); ok && tag != "" {
+				items = &cv.folder(tag, tag, items).Item
+			}
+		}
+	case FoldersByPath:
+		key := ""
+		for _, segment := range strings.Split(strings.Trim(op.Path, "/"), "/") {
+			if segment == "" {
+				continue
+			}
+			key += "/" + segment
+			items = &cv.folder(key, segment, items).Item
+		}
+	}
+
+	*items = append(*items, item)
+}
+
+// dropEmptyFolders removes folders for tags no operation uses.
+func (cv *collectionConverter) dropEmptyFolders() {
+	items := cv.collection.Item[:0]
+	for _, item := range cv.collection.Item {
+		if f, ok := item.(*gen.ItemGroup); ok && len(f.Item) == 0 {
+			continue
+		}
+		items = append(items, item)
+	}
+	cv.collection.Item = items
+}
+
+// parameters returns the parameters of an operation, including those of
+// its path. Operation parameters override path parameters of the same name
+// and location.
+func (cv *collectionConverter) parameters(op openapi.Operation) []map[string]interface{} {
+	var all []interface{}
+	if paths, ok := cv.doc.Root["paths"].(map[string]interface{}); ok {
+		if item, ok := paths[op.Path].(map[string]interface{}); ok {
+			all, _ = item["parameters"].([]interface{})
+		}
+	}
+	own, _ := op.Value["parameters"].([]interface{})
+	all = append(append([]interface{}{}, all...), own...)
+
+	var params []map[string]interface{}
+	index := make(map[string]int)
+	for _, p := range all {
+		param := cv.deref(p)
+		if param == nil {
+			continue
+		}
+
+		name, _ := param["name"].(string)
+		in, _ := param["in"].(string)
+		key := in + ":" + name
+		if i, ok := index[key]; ok {
+			params[i] = param
+			continue
+		}
+
+		index[key] = len(params)
+		params = append(params, param)
+	}
+
+	return params
+}
+
+// collectionItem is a request of a converted collection. Unlike gen.Item,
+// it keeps the names of saved responses.
+type collectionItem struct {
+	Name     string             `json:"name"`
+	Request  *resources.Request `json:"request"`
+	Response []savedResponse    `json:"response,omitempty"`
+}
+
+// savedResponse is a saved example of a converted collection.
+type savedResponse struct {
+	Name            string               `json:"name"`
+	OriginalRequest *resources.Request   `json:"originalRequest,omitempty"`
+	Status          string               `json:"status,omitempty"`
+	Code            int                  `json:"code,omitempty"`
+	Header          resources.HeaderList `json:"header,omitempty"`
+	Body            string               `json:"body,omitempty"`
+}
+
+func (cv *collectionConverter) request(op openapi.Operation) (*collectionItem, error) {
+	r := &resources.Request{
+		Method:      strings.ToUpper(op.Method),
+		Description: op.Value["description"],
+	}
+
+	segments := pathSegments(op.Path)
+	r.URL = resources.URL{Host: []string{"{{baseUrl}}"}, Path: segments}
+
+	if err := cv.addParameters(r, op); err != nil {
+		return nil, err
+	}
+	declarePathVariables(r, segments)
+
+	if !cv.doc.IsV2() {
+		if body := cv.deref(op.Value["requestBody"]); body != nil {
+			if err := cv.v3Body(r, body); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	r.URL.Raw = rawURL(r.URL)
+	cv.addAuth(r, op)
+
+	responses, accept := cv.responses(op, r)
+	if accept != "" {
+		if _, ok := r.Header.Get("Accept"); !ok {
+			r.Header = append(r.Header, resources.Header{Key: "Accept", Value: accept})
+		}
+	}
+
+	return &collectionItem{Name: requestName(op), Request: r, Response: responses}, nil
+}
+
+// requestName names the request of an operation after its summary, its ID
+// or else its method and path.
+func requestName(op openapi.Operation) string {
+	if name, _ := op.Value["summary"].(string); name != "" {
+		return name
+	}
+
+	if name, _ := op.Value["operationId"].(string); name != "" {
+		return name
+	}
+
+	return strings.ToUpper(op.Method) + " " + op.Path
+}
+
+// pathSegments returns the segments of an OpenAPI path, turning its
+// templated segments into Postman path variables.
+func pathSegments(path string) []string {
+	var segments []string
+	for _, s := range strings.Split(strings.Trim(path, "/"), "/") {
+		if s == "" {
+			continue
+		}
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			s = ":" + s[1:len(s)-1]
+		}
+		segments = append(segments, s)
+	}
+
+	return segments
+}
+
+// addParameters adds the parameters of an operation to its request, and
+// the body of OpenAPI 2 operations, which is a parameter too.
+func (cv *collectionConverter) addParameters(r *resources.Request, op openapi.Operation) error {
+	var formParams []map[string]interface{}
+	for _, p := range cv.parameters(op) {
+		name, _ := p["name"].(string)
+		desc, _ := p["description"].(string)
+		value := cv.parameterExample(p)
+
+		switch p["in"] {
+		case "path":
+			r.URL.Variable = append(r.URL.Variable, resources.URLVariable{Key: name, Value: value, Description: desc})
+		case "query":
+			r.URL.Query = append(r.URL.Query, resources.QueryParam{Key: name, Value: value, Description: desc})
+		case "header":
+			r.Header = append(r.Header, resources.Header{Key: name, Value: value, Description: desc})
+		case "body":
+			if err := cv.v2Body(r, op, p); err != nil {
+				return err
+			}
+		case "formData":
+			formParams = append(formParams, p)
+		}
+	}
+
+	if len(formParams) > 0 {
+		cv.v2Form(r, op, formParams)
+	}
+
+	return nil
+}
+
+// declarePathVariables adds a variable for each path segment without one,
+// as documents don't always declare their path parameters.
+func declarePathVariables(r *resources.Request, segments []string) {
+	for _, s := range segments {
+		if !strings.HasPrefix(s, ":") {
+			continue
+		}
+
+		declared := false
+		for _, v := range r.URL.Variable {
+			declared = declared || v.Key == s[1:]
+		}
+		if !declared {
+			r.URL.Variable = append(r.URL.Variable, resources.URLVariable{Key: s[1:], Value: "<string>"})
+		}
+	}
+}
+
+// addAuth sets the auth of a request from the security of its operation.
+// Without one, the request inherits the auth of the collection.
+func (cv *collectionConverter) addAuth(r *resources.Request, op openapi.Operation) {
+	security, ok := op.Value["security"]
+	if !ok {
+		return
+	}
+
+	if auth, ok := cv.auth(security); ok {
+		r.Auth = auth
+	} else if cv.globalAuth {
+		r.Auth = &gen.Auth{Type: "noauth"}
+	}
+}
+
+// rawURL writes the raw form of a URL, which Postman shows.
+func rawURL(u resources.URL) string {
+	raw := strings.Join(u.Host, ".")
+	if len(u.Path) > 0 {
+		raw += "/" + strings.Join(u.Path, "/")
+	}
+
+	for i, q := range u.Query {
+		sep := "&"
+		if i == 0 {
+			sep = "?"
+		}
+		raw += sep + q.Key + "=" + q.Value
+	}
+
+	return raw
+}
+
+// parameterExample returns the example of a parameter, or a placeholder
+// naming its type.
+func (cv *collectionConverter) parameterExample(p map[string]interface{}) string {
+	for _, key := range []string{"example", "x-example", "default"} {
+		if v, ok := p[key]; ok {
+			return text(v)
+		}
+	}
+
+	if examples, ok := p["examples"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(examples) {
+			if ex := cv.deref(examples[name]); ex != nil {
+				if v, ok := ex["value"]; ok {
+					return text(v)
+				}
+			}
+		}
+	}
+
+	schema := cv.deref(p["schema"])
+	if schema == nil {
+		// OpenAPI 2 parameters hold their schema themselves.
+		schema = p
+	}
+
+	for _, key := range []string{"example", "default"} {
+		if v, ok := schema[key]; ok {
+			return text(v)
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		return text(enum[0])
+	}
+
+	t, _ := schema["type"].(string)
+	if format, ok := schema["format"].(string); ok {
+		t = format
+	}
+	if t == "" {
+		t = "string"
+	}
+
+	return "<" + t + ">"
+}
+
+// preferredMediaType returns the media type to use from those an operation
+// supports: JSON if possible, then forms, then the first.
+func preferredMediaType(types []string) string {
+	if len(types) == 0 {
+		return ""
+	}
+
+	for _, want := range []string{"application/json", "+json", "json", "application/x-www-form-urlencoded", "multipart/form-data"} {
+		for _, t := range types {
+			if t == want || strings.HasSuffix(t, want) || (want == "json" && strings.Contains(t, want)) {
+				return t
+			}
+		}
+	}
+
+	return types[0]
+}
+
+func (cv *collectionConverter) v3Body(r *resources.Request, body map[string]interface{}) error {
+	content, _ := body["content"].(map[string]interface{})
+	mediaType := preferredMediaType(sortedKeys(content))
+	if mediaType == "" {
+		return nil
+	}
+
+	media, _ := content[mediaType].(map[string]interface{})
+	schema := cv.deref(media["schema"])
+
+	r.Header = append(r.Header, resources.Header{Key: "Content-Type", Value: mediaType})
+
+	if mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data" {
+		r.Body = cv.formBody(mediaType, schema)
+		return nil
+	}
+
+	return cv.rawBody(r, mediaType, cv.mediaExample(media, schema))
+}
+
+// mediaExample returns the example of a media type, generating one from
+// its schema when there is none.
+func (cv *collectionConverter) mediaExample(media, schema map[string]interface{}) interface{} {
+	if v, ok := media["example"]; ok {
+		return v
+	}
+
+	if examples, ok := media["examples"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(examples) {
+			if ex := cv.deref(examples[name]); ex != nil {
+				if v, ok := ex["value"]; ok {
+					return v
+				}
+			}
+		}
+	}
+
+	return cv.example(schema)
+}
+
+// rawBody sets the body of a request to an example, unless there is none.
+func (cv *collectionConverter) rawBody(r *resources.Request, mediaType string, example interface{}) error {
+	if example == nil {
+		return nil
+	}
+
+	body := &resources.RequestBody{Mode: "raw"}
+	if s, ok := example.(string); ok && !strings.Contains(mediaType, "json") {
+		body.Raw = s
+	} else {
+		b, err := json.MarshalIndent(example, "", "  ")
+		if err != nil {
+			return err
+		}
+		body.Raw = string(b)
+	}
+
+	language := "text"
+	switch {
+	case strings.Contains(mediaType, "json"):
+		language = "json"
+	case strings.Contains(mediaType, "xml"):
+		language = "xml"
+	case strings.Contains(mediaType, "html"):
+		language = "html"
+	}
+	body.Options = &resources.BodyOptions{Raw: &struct {
+		Language string `json:"language,omitempty"`
+	}{Language: language}}
+
+	r.Body = body
+
+	return nil
+}
+
+// formBody returns a form body with a field for every property of schema.
+func (cv *collectionConverter) formBody(mediaType string, schema map[string]interface{}) *resources.RequestBody {
+	body := &resources.RequestBody{Mode: "urlencoded"}
+	if mediaType == "multipart/form-data" {
+		body.Mode = "formdata"
+	}
+
+	props, _ := schema["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(props) {
+		prop := cv.deref(props[name])
+		field := resources.FormParameter{Key: name}
+		if desc, ok := prop["description"].(string); ok {
+			field.Description = desc
+		}
+
+		if prop["format"] == "binary" || prop["type"] == "file" {
+			field.Type = "file"
+		} else {
+			field.Value = text(cv.example(prop))
+			if body.Mode == "formdata" {
+				field.Type = "text"
+			}
+		}
+
+		if body.Mode == "formdata" {
+			body.FormData = append(body.FormData, field)
+		} else {
+			body.URLEncoded = append(body.URLEncoded, field)
+		}
+	}
+
+	return body
+}
+
+// mediaTypes returns the media types an OpenAPI 2 operation consumes or
+// produces.
+func (cv *collectionConverter) v2MediaTypes(op openapi.Operation, key string) []string {
+	list, ok := op.Value[key].([]interface{})
+	if !ok {
+		list, _ = cv.doc.Root[key].([]interface{})
+	}
+
+	types := make([]string, 0, len(list))
+	for _, t := range list {
+		if s, ok := t.(string); ok {
+			types = append(types, s)
+		}
+	}
+
+	return types
+}
+
+func (cv *collectionConverter) v2Body(r *resources.Request, op openapi.Operation, p map[string]interface{}) error {
+	mediaType := preferredMediaType(cv.v2MediaTypes(op, "consumes"))
+	if mediaType == "" {
+		mediaType = "application/json"
+	}
+
+	r.Header = append(r.Header, resources.Header{Key: "Content-Type", Value: mediaType})
+
+	example, ok := p["x-example"]
+	if !ok {
+		example = cv.example(cv.deref(p["schema"]))
+	}
+
+	return cv.rawBody(r, mediaType, example)
+}
+
+func (cv *collectionConverter) v2Form(r *resources.Request, op openapi.Operation, params []map[string]interface{}) {
+	mediaType := "application/x-www-form-urlencoded"
+	for _, t := range cv.v2MediaTypes(op, "consumes") {
+		if t == "multipart/form-data" {
+			mediaType = t
+		}
+	}
+
+	schema := map[string]interface{}{"type": "object"}
+	props := make(map[string]interface{})
+	for _, p := range params {
+		name, _ := p["name"].(string)
+		props[name] = p
+		if p["type"] == "file" {
+			mediaType = "multipart/form-data"
+		}
+	}
+	schema["properties"] = props
+
+	r.Header = append(r.Header, resources.Header{Key: "Content-Type", Value: mediaType})
+	r.Body = cv.formBody(mediaType, schema)
+}
+
+// responses converts the responses of an operation to saved examples. It
+// also returns the media type to accept.
+func (cv *collectionConverter) responses(op openapi.Operation, r *resources.Request) ([]savedResponse, string) {
+	all, _ := op.Value["responses"].(map[string]interface{})
+
+	var (
+		out    []savedResponse
+		accept string
+	)
+	success := false
+	for code := range all {
+		if strings.HasPrefix(code, "2") {
+			success = true
+		}
+	}
+
+	for _, code := range sortedKeys(all) {
+		resp := cv.deref(all[code])
+		if resp == nil || strings.HasPrefix(code, "x-") {
+			continue
+		}
+
+		status, err := strconv.Atoi(code)
+		if err != nil {
+			// Ranges such as "5XX", and "default" next to declared
+			// success codes, describe errors.
+			status = http.StatusInternalServerError
+			if code == "default" && !success {
+				status = http.StatusOK
+			}
+		}
+
+		saved := savedResponse{
+			OriginalRequest: r,
+			Status:          http.StatusText(status),
+			Code:            status,
+		}
+
+		saved.Name, _ = resp["description"].(string)
+		if saved.Name == "" {
+			saved.Name = saved.Status
+		}
+
+		if mediaType, body, ok := cv.responseBody(op, resp); ok {
+			saved.Header = resources.HeaderList{{Key: "Content-Type", Value: mediaType}}
+			saved.Body = body
+			if accept == "" {
+				accept = mediaType
+			}
+		}
+
+		out = append(out, saved)
+	}
+
+	return out, accept
+}
+
+// responseBody returns the media type and an example body of a response.
+func (cv *collectionConverter) responseBody(op openapi.Operation, resp map[string]interface{}) (string, string, bool) {
+	var (
+		mediaType string
+		example   interface{}
+	)
+
+	if cv.doc.IsV2() {
+		schema := cv.deref(resp["schema"])
+		examples, _ := resp["examples"].(map[string]interface{})
+		if schema == nil && len(examples) == 0 {
+			return "", "", false
+		}
+
+		mediaType = preferredMediaType(append(cv.v2MediaTypes(op, "produces"), sortedKeys(examples)...))
+		if mediaType == "" {
+			mediaType = "application/json"
+		}
+
+		var ok bool
+		if example, ok = examples[mediaType]; !ok {
+			example = cv.example(schema)
+		}
+	} else {
+		content, _ := resp["content"].(map[string]interface{})
+		mediaType = preferredMediaType(sortedKeys(content))
+		if mediaType == "" {
+			return "", "", false
+		}
+
+		media, _ := content[mediaType].(map[string]interface{})
+		example = cv.mediaExample(media, cv.deref(media["schema"]))
+	}
+
+	if example == nil {
+		return mediaType, "", true
+	}
+
+	if s, ok := example.(string); ok && !strings.Contains(mediaType, "json") {
+		return mediaType, s, true
+	}
+
+	b, err := json.MarshalIndent(example, "", "  ")
+	if err != nil {
+		return "", "", false
+	}
+
+	return mediaType, string(b), true
+}
+
+// example generates an example value from a schema. It prefers examples,
+// defaults, constants and enums of the schema, and stops at schemas that
+// refer to themselves.
+func (cv *collectionConverter) example(schema map[string]interface{}) interface{} {
+	return cv.exampleOf(schema, make(map[string]bool), 0)
+}
+
+func (cv *collectionConverter) exampleOf(v interface{}, seen map[string]bool, depth int) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok || depth > 10 {
+		return nil
+	}
+
+	if ref, ok := m["$ref"].(string); ok {
+		if seen[ref] {
+			return nil
+		}
+
+		target, err := cv.doc.Resolve(ref)
+		if err != nil {
+			return nil
+		}
+
+		seen[ref] = true
+		defer delete(seen, ref)
+
+		return cv.exampleOf(target, seen, depth+1)
+	}
+
+	if v, ok := givenExample(m); ok {
+		return v
+	}
+
+	if v, ok := cv.composedExample(m, seen, depth); ok {
+		return v
+	}
+
+	switch schemaType(m) {
+	case "object":
+		return cv.objectExample(m, seen, depth)
+	case "array":
+		return cv.arrayExample(m, seen, depth)
+	case "integer", "number":
+		return numberExample(m)
+	case "boolean":
+		return true
+	case "string":
+		return stringExample(m)
+	}
+
+	return nil
+}
+
+// givenExample returns the value a schema gives as its example: an
+// example, a default, a constant or the first of its allowed values.
+func givenExample(m map[string]interface{}) (interface{}, bool) {
+	for _, key := range []string{"example", "x-example", "default", "const"} {
+		if v, ok := m[key]; ok {
+			return v, true
+		}
+	}
+
+	if enum, ok := m["enum"].([]interface{}); ok && len(enum) > 0 {
+		return enum[0], true
+	}
+
+	return nil, false
+}
+
+// composedExample generates an example for a schema composed of others,
+// merging the examples of allOf and taking the first choice of oneOf and
+// anyOf.
+func (cv *collectionConverter) composedExample(m map[string]interface{}, seen map[string]bool, depth int) (interface{}, bool) {
+	if all, ok := m["allOf"].([]interface{}); ok {
+		merged := make(map[string]interface{})
+		for _, sub := range all {
+			if obj, ok := cv.exampleOf(sub, seen, depth+1).(map[string]interface{}); ok {
+				for k, v := range obj {
+					merged[k] = v
+				}
+			}
+		}
+		return merged, true
+	}
+
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if choices, ok := m[key].([]interface{}); ok && len(choices) > 0 {
+			return cv.exampleOf(choices[0], seen, depth+1), true
+		}
+	}
+
+	return nil, false
+}
+
+// schemaType returns the type of a schema, guessing objects and arrays
+// from their keywords when the type is left out.
+func schemaType(m map[string]interface{}) string {
+	if t, _ := m["type"].(string); t != "" {
+		return t
+	}
+
+	if _, ok := m["properties"]; ok {
+		return "object"
+	}
+
+	if _, ok := m["items"]; ok {
+		return "array"
+	}
+
+	return ""
+}
+
+func (cv *collectionConverter) objectExample(m map[string]interface{}, seen map[string]bool, depth int) interface{} {
+	obj := make(map[string]interface{})
+	props, _ := m["properties"].(map[string]interface{})
+	for name, prop := range props {
+		obj[name] = cv.exampleOf(prop, seen, depth+1)
+	}
+
+	return obj
+}
+
+func (cv *collectionConverter) arrayExample(m map[string]interface{}, seen map[string]bool, depth int) interface{} {
+	item := cv.exampleOf(m["items"], seen, depth+1)
+	if item == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{item}
+}
+
+func numberExample(m map[string]interface{}) interface{} {
+	if min, ok := m["minimum"].(float64); ok {
+		return min
+	}
+
+	return 0
+}
+
+// stringExample returns a string in the format of a schema.
+func stringExample(m map[string]interface{}) interface{} {
+	switch m["format"] {
+	case "date":
+		return "2020-01-01"
+	case "date-time":
+		return "2020-01-01T00:00:00Z"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	case "uri", "url":
+		return "https://example.com"
+	}
+
+	return "string"
+}
+
+// auth converts the first security requirement that can be expressed as
+// collection auth. The secrets are collection variables.
+func (cv *collectionConverter) auth(security interface{}) (*gen.Auth, bool) {
+	reqs, _ := security.([]interface{})
+	for _, req := range reqs {
+		names, _ := req.(map[string]interface{})
+		for _, name := range sortedKeys(names) {
+			if auth, ok := cv.schemeAuth(name, names[name]); ok {
+				return auth, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (cv *collectionConverter) schemeAuth(name string, scopes interface{}) (*gen.Auth, bool) {
+	var schemes map[string]interface{}
+	if cv.doc.IsV2() {
+		schemes, _ = cv.doc.Root["securityDefinitions"].(map[string]interface{})
+	} else {
+		components, _ := cv.doc.Root["components"].(map[string]interface{})
+		schemes, _ = components["securitySchemes"].(map[string]interface{})
+	}
+
+	scheme := cv.deref(schemes[name])
+	if scheme == nil {
+		return nil, false
+	}
+
+	attr := func(key, value string) *gen.AuthAttribute {
+		return &gen.AuthAttribute{Key: key, Value: value, Type: "string"}
+	}
+
+	kind, _ := scheme["type"].(string)
+	httpScheme, _ := scheme["scheme"].(string)
+	if kind == "basic" {
+		kind, httpScheme = "http", "basic"
+	}
+
+	switch {
+	case kind == "http" && strings.EqualFold(httpScheme, "basic"):
+		cv.addVariable("username", "")
+		cv.addVariable("password", "")
+		return &gen.Auth{Type: "basic", Basic: []*gen.AuthAttribute{
+			attr("username", "{{username}}"),
+			attr("password", "{{password}}"),
+		}}, true
+	case kind == "http" && strings.EqualFold(httpScheme, "bearer"):
+		cv.addVariable("bearerToken", "")
+		return &gen.Auth{Type: "bearer", Bearer: []*gen.AuthAttribute{
+			attr("token", "{{bearerToken}}"),
+		}}, true
+	case kind == "http" && strings.EqualFold(httpScheme, "digest"):
+		cv.addVariable("username", "")
+		cv.addVariable("password", "")
+		return &gen.Auth{Type: "digest", Digest: []*gen.AuthAttribute{
+			attr("username", "{{username}}"),
+			attr("password", "{{password}}"),
+		}}, true
+	case kind == "apiKey":
+		key, _ := scheme["name"].(string)
+		in, _ := scheme["in"].(string)
+		if in != "query" {
+			in = "header"
+		}
+		cv.addVariable("apiKey", "")
+		return &gen.Auth{Type: "apikey", Apikey: []*gen.AuthAttribute{
+			attr("key", key),
+			attr("value", "{{apiKey}}"),
+			attr("in", in),
+		}}, true
+	case kind == "oauth2":
+		return cv.oauth2(scheme, scopes), true
+	}
+
+	return nil, false
+}
+
+func (cv *collectionConverter) oauth2(scheme map[string]interface{}, scopes interface{}) *gen.Auth {
+	var (
+		grant string
+		flow  map[string]interface{}
+	)
+
+	if cv.doc.IsV2() {
+		flow = scheme
+		switch scheme["flow"] {
+		case "accessCode":
+			grant = "authorization_code"
+		case "application":
+			grant = "client_credentials"
+		case "password":
+			grant = "password_credentials"
+		default:
+			grant = "implicit"
+		}
+	} else {
+		flows, _ := scheme["flows"].(map[string]interface{})
+		for _, f := range []struct{ key, grant string }{
+			{"authorizationCode", "authorization_code"},
+			{"clientCredentials", "client_credentials"},
+			{"password", "password_credentials"},
+			{"implicit", "implicit"},
+		} {
+			if m, ok := flows[f.key].(map[string]interface{}); ok {
+				flow, grant = m, f.grant
+				break
+			}
+		}
+	}
+
+	var scopeNames []string
+	list, _ := scopes.([]interface{})
+	for _, s := range list {
+		scopeNames = append(scopeNames, text(s))
+	}
+
+	cv.addVariable("clientId", "")
+	cv.addVariable("clientSecret", "")
+
+	attrs := []*gen.AuthAttribute{
+		{Key: "grant_type", Value: grant, Type: "string"},
+		{Key: "clientId", Value: "{{clientId}}", Type: "string"},
+		{Key: "clientSecret", Value: "{{clientSecret}}", Type: "string"},
+	}
+	if u, ok := flow["authorizationUrl"].(string); ok {
+		attrs = append(attrs, &gen.AuthAttribute{Key: "authUrl", Value: u, Type: "string"})
+	}
+	if u, ok := flow["tokenUrl"].(string); ok {
+		attrs = append(attrs, &gen.AuthAttribute{Key: "accessTokenUrl", Value: u, Type: "string"})
+	}
+	if len(scopeNames) > 0 {
+		attrs = append(attrs, &gen.AuthAttribute{Key: "scope", Value: strings.Join(scopeNames, " "), Type: "string"})
+	}
+
+	return &gen.Auth{Type: "oauth2", Oauth2: attrs}
+}
+
+// text returns a value decoded from a document as parameter text.
+func text(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,277 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/convert"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func readCollection(t *testing.T, path string) *resources.Collection {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return &c
+}
+
+// golden compares have with the content of a golden file, or rewrites the
+// file when run with -update.
+func golden(t *testing.T, path string, have []byte) {
+	t.Helper()
+
+	if *update {
+		if err := ioutil.WriteFile(path, have, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(have, want) {
+		t.Errorf("output differs from %s, run with -update to see the difference with git\nhave:\n%s", path, have)
+	}
+}
+
+func TestToOpenAPI3(t *testing.T) {
+	doc, err := convert.ToOpenAPI3(readCollection(t, "testdata/collection.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := convert.Write(&buf, doc, "yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	golden(t, "testdata/openapi3.golden.yaml", buf.Bytes())
+}
+
+func TestToOpenAPI3JSON(t *testing.T) {
+	doc, err := convert.ToOpenAPI3(readCollection(t, "testdata/collection.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := convert.Write(&buf, doc, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		OpenAPI string                            `json:"openapi"`
+		Paths   map[string]map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for p := range v.Paths {
+		paths = append(paths, p)
+	}
+
+	if v.OpenAPI != convert.OpenAPIVersion || len(paths) != 6 {
+		t.Errorf("have version %q and paths %v", v.OpenAPI, paths)
+	}
+
+	if err := convert.Write(&buf, doc, "xml"); err == nil {
+		t.Error("have no error for an unknown format")
+	}
+}
+
+func TestInferSchema(t *testing.T) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(`[{"a": 1}, {"a": 1.5, "b": null}, {"b": "x"}]`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &convert.Schema{
+		Type: "array",
+		Items: &convert.Schema{
+			Type: "object",
+			Properties: map[string]*convert.Schema{
+				"a": {Type: "number"},
+				"b": {Type: "string", Nullable: true},
+			},
+		},
+	}
+
+	if have := convert.InferSchema(v); !reflect.DeepEqual(have, want) {
+		t.Errorf("have schema %+v, want %+v", have, want)
+	}
+}
+
+func readDocument(t *testing.T, path string) *openapi.Document {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := openapi.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+func fromOpenAPI(t *testing.T, path, folders string) []byte {
+	t.Helper()
+
+	c, err := convert.FromOpenAPI(readDocument(t, path), convert.CollectionOptions{Folders: folders})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := convert.Write(&buf, c, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := resources.ValidateCollection(v); err != nil {
+		t.Error(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestFromOpenAPI(t *testing.T) {
+	golden(t, "testdata/openapi3.golden.json", fromOpenAPI(t, "testdata/openapi3.yaml", ""))
+}
+
+func TestFromOpenAPIV2(t *testing.T) {
+	golden(t, "testdata/swagger.golden.json", fromOpenAPI(t, "testdata/swagger.json", convert.FoldersByTag))
+}
+
+func TestFromOpenAPIFoldersByPath(t *testing.T) {
+	c, err := convert.FromOpenAPI(readDocument(t, "testdata/openapi3.yaml"), convert.CollectionOptions{Folders: convert.FoldersByPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	err = c.Items.Walk(func(ref resources.ItemRef) error {
+		paths = append(paths, ref.FullPath())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"pets",
+		"pets/List pets",
+		"pets/createPet",
+		"pets/{petId}",
+		"pets/{petId}/Upload photo",
+		"health",
+		"health/Health",
+		"owners",
+		"owners/List owners",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("have items %v, want %v", paths, want)
+	}
+
+	if _, err := convert.FromOpenAPI(readDocument(t, "testdata/openapi3.yaml"), convert.CollectionOptions{Folders: "size"}); err == nil {
+		t.Error("have no error for an unknown folder layout")
+	}
+}
+
+func TestWriteLoadTest(t *testing.T) {
+	env := &resources.Environment{
+		Name: "staging",
+		Values: []resources.KeyValuePair{
+			{Key: "baseUrl", Value: "https://staging.example.com:8443", Enabled: true},
+			{Key: "token", Value: "staging-token", Enabled: true},
+			{Key: "region", Value: "eu", Enabled: true},
+			{Key: "sku", Value: "A-1", Enabled: true},
+		},
+	}
+
+	lt, err := convert.ToLoadTest(readCollection(t, "testdata/loadtest.json"), env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"trace"}; !reflect.DeepEqual(lt.Unresolved, want) {
+		t.Errorf("have unresolved variables %v, want %v", lt.Unresolved, want)
+	}
+
+	for _, tool := range convert.LoadTestTools() {
+		var buf bytes.Buffer
+		if err := convert.WriteLoadTest(&buf, lt, tool); err != nil {
+			t.Fatal(err)
+		}
+
+		golden(t, "testdata/loadtest."+tool+".golden", buf.Bytes())
+	}
+
+	if err := convert.WriteLoadTest(ioutil.Discard, lt, "gatling"); err == nil {
+		t.Error("have no error for an unknown tool")
+	}
+}
+
+func TestToLoadTestUnresolvedURL(t *testing.T) {
+	lt, err := convert.ToLoadTest(readCollection(t, "testdata/loadtest.json"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"region", "sku", "token", "trace"}; !reflect.DeepEqual(lt.Unresolved, want) {
+		t.Errorf("have unresolved variables %v, want %v", lt.Unresolved, want)
+	}
+
+	var buf bytes.Buffer
+	if err := convert.WriteLoadTest(&buf, lt, "k6"); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `"http://localhost:3000/orders/{{region}}?notify=true"`; !strings.Contains(buf.String(), want) {
+		t.Errorf("have script %s, want it to request %s", buf.String(), want)
+	}
+}
@@ -0,0 +1,366 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/codegen"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+// LoadTestTools returns the load-testing tools WriteLoadTest writes scripts
+// for.
+func LoadTestTools() []string {
+	return []string{"jmeter", "k6", "locust"}
+}
+
+// LoadTest is a load test built from the requests of a collection. The
+// requests of a group run one after the other, while groups run side by
+// side.
+type LoadTest struct {
+	Name   string
+	Groups []LoadTestGroup
+
+	// Unresolved holds the variables left in URLs, headers and bodies
+	// because they have no value, sorted by name.
+	Unresolved []string
+}
+
+// LoadTestGroup holds the requests of a folder.
+type LoadTestGroup struct {
+	Name     string
+	Requests []LoadTestRequest
+}
+
+// LoadTestRequest is a request with its variables resolved and its auth
+// applied.
+type LoadTestRequest struct {
+	Name   string
+	Method string
+	URL    *url.URL
+	Header []resources.Header
+	Body   string
+}
+
+// ToLoadTest builds a load test from a collection, resolving variables
+// against the collection and an optional environment.
+//
+// Each folder with requests becomes a group, named after its path; requests
+// outside any folder form a group named after the collection. Auth is
+// applied the way "run collection" applies it, so only the auth types it
+// supports can be converted. Dynamic variables, such as {{$guid}}, are
+// generated once, when converting.
+func ToLoadTest(c *resources.Collection, env *resources.Environment) (*LoadTest, error) {
+	if c.Collection == nil || c.Info == nil {
+		return nil, fmt.Errorf("the collection has no info")
+	}
+
+	r := runner.New(c, runner.Options{Environment: env})
+	steps, err := r.Plan()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &LoadTest{Name: c.Info.Name}
+	groups := make(map[string]int)
+	unresolved := make(map[string]bool)
+	for _, s := range steps {
+		path := s.Item.FullPath()
+
+		def, err := s.Item.Item.ParseRequest()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		req, err := runner.ResolveRequest(def, s.Auth, r.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		out := LoadTestRequest{Name: path, Method: req.Method, URL: req.URL, Header: req.Header, Body: req.Body}
+		for _, name := range req.Unresolved {
+			unresolved[name] = true
+		}
+
+		group := resources.JoinItemPath(s.Item.Path...)
+		if group == "" {
+			group = t.Name
+		}
+
+		i, ok := groups[group]
+		if !ok {
+			i = len(t.Groups)
+			groups[group] = i
+			t.Groups = append(t.Groups, LoadTestGroup{Name: group})
+		}
+		t.Groups[i].Requests = append(t.Groups[i].Requests, out)
+	}
+
+	for name := range unresolved {
+		t.Unresolved = append(t.Unresolved, name)
+	}
+	sort.Strings(t.Unresolved)
+
+	return t, nil
+}
+
+// WriteLoadTest writes a load test as a JMeter test plan, a k6 script or a
+// Locust file. Every group runs with a single user and, for JMeter and k6,
+// for a single iteration, so the load is shaped with the tool's own
+// settings.
+func WriteLoadTest(w io.Writer, t *LoadTest, tool string) error {
+	var tmpl *template.Template
+	switch tool {
+	case "jmeter":
+		tmpl = jmeterTemplate
+	case "k6":
+		tmpl = k6Template
+	case "locust":
+		tmpl = locustTemplate
+	default:
+		return fmt.Errorf("unknown load-testing tool %q, expected one of: %s", tool, strings.Join(LoadTestTools(), ", "))
+	}
+
+	view := loadTestView{Name: t.Name}
+	seen := make(map[string]bool)
+	for _, g := range t.Groups {
+		ident := identifier(g.Name)
+		for i := 2; seen[ident]; i++ {
+			ident = fmt.Sprintf("%s%d", identifier(g.Name), i)
+		}
+		seen[ident] = true
+
+		view.Groups = append(view.Groups, loadTestGroupView{LoadTestGroup: g, Ident: ident})
+	}
+
+	return tmpl.Execute(w, view)
+}
+
+type loadTestView struct {
+	Name   string
+	Groups []loadTestGroupView
+}
+
+type loadTestGroupView struct {
+	LoadTestGroup
+
+	// Ident is the group name as a unique CamelCase identifier, for names
+	// of functions and classes.
+	Ident string
+}
+
+// identifier turns a name into a CamelCase identifier, such as "AdminUsers"
+// for "admin/users".
+func identifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			upper = true
+			continue
+		}
+
+		if b.Len() == 0 && unicode.IsDigit(r) {
+			b.WriteString("Group")
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	if b.Len() == 0 {
+		return "Group"
+	}
+
+	return b.String()
+}
+
+var loadTestFuncs = template.FuncMap{
+	"quote": codegen.Quote,
+	// xml escapes text for elements and attributes. Line feeds stay as
+	// they are, while carriage returns are escaped so they survive
+	// parsing.
+	"xml": func(s string) string {
+		var buf bytes.Buffer
+		// Writing to a buffer never fails.
+		_ = xml.EscapeText(&buf, []byte(s))
+		return strings.Replace(buf.String(), "&#xA;", "\n", -1)
+	},
+	"host": func(u *url.URL) string {
+		return u.Scheme + "://" + u.Host
+	},
+	"requestURI": func(u *url.URL) string {
+		return runner.UnescapeVariables(u.RequestURI())
+	},
+	"url": func(u *url.URL) string {
+		return runner.UnescapeVariables(u.String())
+	},
+}
+
+var jmeterTemplate = template.Must(template.New("jmeter").Funcs(loadTestFuncs).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<jmeterTestPlan version="1.2" properties="5.0" jmeter="5.4.1">
+  <hashTree>
+    <TestPlan guiclass="TestPlanGui" testclass="TestPlan" testname="{{xml .Name}}" enabled="true">
+      <boolProp name="TestPlan.functional_mode">false</boolProp>
+      <boolProp name="TestPlan.serialize_threadgroups">false</boolProp>
+      <elementProp name="TestPlan.user_defined_variables" elementType="Arguments" guiclass="ArgumentsPanel" testclass="Arguments" enabled="true">
+        <collectionProp name="Arguments.arguments"/>
+      </elementProp>
+    </TestPlan>
+    <hashTree>
+{{- range .Groups}}
+      <ThreadGroup guiclass="ThreadGroupGui" testclass="ThreadGroup" testname="{{xml .Name}}" enabled="true">
+        <stringProp name="ThreadGroup.on_sample_error">continue</stringProp>
+        <elementProp name="ThreadGroup.main_controller" elementType="LoopController" guiclass="LoopControlPanel" testclass="LoopController" enabled="true">
+          <boolProp name="LoopController.continue_forever">false</boolProp>
+          <stringProp name="LoopController.loops">1</stringProp>
+        </elementProp>
+        <stringProp name="ThreadGroup.num_threads">1</stringProp>
+        <stringProp name="ThreadGroup.ramp_time">1</stringProp>
+        <boolProp name="ThreadGroup.scheduler">false</boolProp>
+        <stringProp name="ThreadGroup.duration"></stringProp>
+        <stringProp name="ThreadGroup.delay"></stringProp>
+      </ThreadGroup>
+      <hashTree>
+{{- range .Requests}}
+        <HTTPSamplerProxy guiclass="HttpTestSampleGui" testclass="HTTPSamplerProxy" testname="{{xml .Name}}" enabled="true">
+{{- if .Body}}
+          <boolProp name="HTTPSampler.postBodyRaw">true</boolProp>
+          <elementProp name="HTTPsampler.Arguments" elementType="Arguments">
+            <collectionProp name="Arguments.arguments">
+              <elementProp name="" elementType="HTTPArgument">
+                <boolProp name="HTTPArgument.always_encode">false</boolProp>
+                <stringProp name="Argument.value">{{xml .Body}}</stringProp>
+                <stringProp name="Argument.metadata">=</stringProp>
+              </elementProp>
+            </collectionProp>
+          </elementProp>
+{{- else}}
+          <elementProp name="HTTPsampler.Arguments" elementType="Arguments" guiclass="HTTPArgumentsPanel" testclass="Arguments" enabled="true">
+            <collectionProp name="Arguments.arguments"/>
+          </elementProp>
+{{- end}}
+          <stringProp name="HTTPSampler.domain">{{xml .URL.Hostname}}</stringProp>
+          <stringProp name="HTTPSampler.port">{{xml .URL.Port}}</stringProp>
+          <stringProp name="HTTPSampler.protocol">{{xml .URL.Scheme}}</stringProp>
+          <stringProp name="HTTPSampler.path">{{xml (requestURI .URL)}}</stringProp>
+          <stringProp name="HTTPSampler.method">{{xml .Method}}</stringProp>
+          <stringProp name="HTTPSampler.contentEncoding">UTF-8</stringProp>
+          <boolProp name="HTTPSampler.follow_redirects">true</boolProp>
+          <boolProp name="HTTPSampler.auto_redirects">false</boolProp>
+          <boolProp name="HTTPSampler.use_keepalive">true</boolProp>
+          <boolProp name="HTTPSampler.DO_MULTIPART_POST">false</boolProp>
+        </HTTPSamplerProxy>
+{{- if .Header}}
+        <hashTree>
+          <HeaderManager guiclass="HeaderPanel" testclass="HeaderManager" testname="HTTP Header Manager" enabled="true">
+            <collectionProp name="HeaderManager.headers">
+{{- range .Header}}
+              <elementProp name="" elementType="Header">
+                <stringProp name="Header.name">{{xml .Key}}</stringProp>
+                <stringProp name="Header.value">{{xml .Value}}</stringProp>
+              </elementProp>
+{{- end}}
+            </collectionProp>
+          </HeaderManager>
+          <hashTree/>
+        </hashTree>
+{{- else}}
+        <hashTree/>
+{{- end}}
+{{- end}}
+      </hashTree>
+{{- end}}
+    </hashTree>
+  </hashTree>
+</jmeterTestPlan>
+`))
+
+var k6Template = template.Must(template.New("k6").Funcs(loadTestFuncs).Parse(`// Load test for the {{quote .Name}} collection. Each scenario runs the
+// requests of a folder in order.
+import http from 'k6/http';
+
+export const options = {
+  scenarios: {
+{{- range .Groups}}
+    run{{.Ident}}: {
+      executor: 'per-vu-iterations',
+      exec: 'run{{.Ident}}',
+      vus: 1,
+      iterations: 1,
+    },
+{{- end}}
+  },
+};
+{{range .Groups}}
+// {{.Name}}
+export function run{{.Ident}}() {
+{{- range .Requests}}
+  http.request({{quote .Method}}, {{quote (url .URL)}}, {{if .Body}}{{quote .Body}}{{else}}null{{end}}, {
+    headers: {
+{{- range .Header}}
+      {{quote .Key}}: {{quote .Value}},
+{{- end}}
+    },
+    tags: { name: {{quote .Name}} },
+  });
+{{- end}}
+}
+{{end -}}
+`))
+
+var locustTemplate = template.Must(template.New("locust").Funcs(loadTestFuncs).Parse(`# Load test for the {{quote .Name}} collection. Each user class runs the
+# requests of a folder in order.
+from locust import HttpUser, task
+{{range .Groups}}
+
+# {{.Name}}
+class {{.Ident}}User(HttpUser):
+    host = {{quote (host (index .Requests 0).URL)}}
+
+    @task
+    def run(self):
+{{- range .Requests}}
+        self.client.request(
+            {{quote .Method}},
+            {{quote (url .URL)}},
+            name={{quote .Name}},
+            headers={
+{{- range .Header}}
+                {{quote .Key}}: {{quote .Value}},
+{{- end}}
+            },
+{{- if .Body}}
+            data={{quote .Body}}.encode(),
+{{- end}}
+        )
+{{- end}}
+{{end -}}
+`))
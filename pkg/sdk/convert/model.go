@@ -0,0 +1,186 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+// OpenAPI is an OpenAPI 3.0 document. Members are declared in the order
+// they are usually written, which is the order they are encoded in.
+type OpenAPI struct {
+	OpenAPI    string                `json:"openapi" yaml:"openapi"`
+	Info       Info                  `json:"info" yaml:"info"`
+	Servers    []Server              `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Tags       []Tag                 `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Security   []SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
+	Paths      map[string]*PathItem  `json:"paths" yaml:"paths"`
+	Components *Components           `json:"components,omitempty" yaml:"components,omitempty"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Version     string `json:"version" yaml:"version"`
+}
+
+// Server is a base URL of the API.
+type Server struct {
+	URL       string                    `json:"url" yaml:"url"`
+	Variables map[string]ServerVariable `json:"variables,omitempty" yaml:"variables,omitempty"`
+}
+
+// ServerVariable is a variable part of a server URL.
+type ServerVariable struct {
+	Default string `json:"default" yaml:"default"`
+}
+
+// Tag groups operations.
+type Tag struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// SecurityRequirement names the security schemes an operation requires,
+// with the scopes each needs.
+type SecurityRequirement map[string][]string
+
+// PathItem holds the operations of a path.
+type PathItem struct {
+	Get     *Operation `json:"get,omitempty" yaml:"get,omitempty"`
+	Put     *Operation `json:"put,omitempty" yaml:"put,omitempty"`
+	Post    *Operation `json:"post,omitempty" yaml:"post,omitempty"`
+	Delete  *Operation `json:"delete,omitempty" yaml:"delete,omitempty"`
+	Options *Operation `json:"options,omitempty" yaml:"options,omitempty"`
+	Head    *Operation `json:"head,omitempty" yaml:"head,omitempty"`
+	Patch   *Operation `json:"patch,omitempty" yaml:"patch,omitempty"`
+	Trace   *Operation `json:"trace,omitempty" yaml:"trace,omitempty"`
+}
+
+// operation returns the operation for a method, which must be lowercase,
+// creating it when create is set. It returns nil for methods OpenAPI
+// doesn't support.
+func (p *PathItem) operation(method string, create bool) *Operation {
+	var op **Operation
+	switch method {
+	case "get":
+		op = &p.Get
+	case "put":
+		op = &p.Put
+	case "post":
+		op = &p.Post
+	case "delete":
+		op = &p.Delete
+	case "options":
+		op = &p.Options
+	case "head":
+		op = &p.Head
+	case "patch":
+		op = &p.Patch
+	case "trace":
+		op = &p.Trace
+	default:
+		return nil
+	}
+
+	if *op == nil && create {
+		*op = &Operation{}
+	}
+
+	return *op
+}
+
+// Operation is an operation on a path.
+type Operation struct {
+	Tags        []string               `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary     string                 `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	OperationID string                 `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Parameters  []Parameter            `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]*Response   `json:"responses" yaml:"responses"`
+	Security    *[]SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
+}
+
+// Parameter is a path, query or header parameter of an operation.
+type Parameter struct {
+	Name        string      `json:"name" yaml:"name"`
+	In          string      `json:"in" yaml:"in"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool        `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema      *Schema     `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Example     interface{} `json:"example,omitempty" yaml:"example,omitempty"`
+}
+
+// RequestBody is the body of an operation's requests.
+type RequestBody struct {
+	Content map[string]*MediaType `json:"content" yaml:"content"`
+}
+
+// MediaType describes content of one media type.
+type MediaType struct {
+	Schema   *Schema             `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Example  interface{}         `json:"example,omitempty" yaml:"example,omitempty"`
+	Examples map[string]*Example `json:"examples,omitempty" yaml:"examples,omitempty"`
+}
+
+// Example is a named example value.
+type Example struct {
+	Summary string      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Value   interface{} `json:"value" yaml:"value"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string                `json:"description" yaml:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+// Components holds reusable parts of the document.
+type Components struct {
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is a way of authenticating.
+type SecurityScheme struct {
+	Type   string      `json:"type" yaml:"type"`
+	Scheme string      `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Name   string      `json:"name,omitempty" yaml:"name,omitempty"`
+	In     string      `json:"in,omitempty" yaml:"in,omitempty"`
+	Flows  *OAuthFlows `json:"flows,omitempty" yaml:"flows,omitempty"`
+}
+
+// OAuthFlows holds the OAuth 2 flows a scheme supports.
+type OAuthFlows struct {
+	Implicit          *OAuthFlow `json:"implicit,omitempty" yaml:"implicit,omitempty"`
+	Password          *OAuthFlow `json:"password,omitempty" yaml:"password,omitempty"`
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlow `json:"authorizationCode,omitempty" yaml:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow is an OAuth 2 flow.
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty" yaml:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty" yaml:"tokenUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes" yaml:"scopes"`
+}
+
+// Schema is a JSON schema as written in OpenAPI 3.0.
+type Schema struct {
+	Type       string             `json:"type,omitempty" yaml:"type,omitempty"`
+	Format     string             `json:"format,omitempty" yaml:"format,omitempty"`
+	Nullable   bool               `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Items      *Schema            `json:"items,omitempty" yaml:"items,omitempty"`
+}
@@ -0,0 +1,727 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package convert translates collections to and from other formats, such
+// as OpenAPI documents.
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// OpenAPIVersion is the version of the documents ToOpenAPI3 writes.
+const OpenAPIVersion = "3.0.3"
+
+// variable matches a variable reference, such as {{baseUrl}}.
+var variable = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+// ToOpenAPI3 converts a collection to an OpenAPI 3 document.
+//
+// Folders become tags and requests become operations, with the innermost
+// folder as their tag. Path variables, query parameters and headers become
+// parameters, and JSON bodies and saved examples get schemas inferred from
+// their values. Base URLs become servers, with variables as server
+// variables defaulting to the collection's values. Auth becomes security
+// schemes; awsv4, hawk, ntlm, oauth1 and edgegrid auth have no OpenAPI
+// equivalent and are left out.
+//
+// Requests with a method OpenAPI doesn't support, or for the same method
+// and path as an earlier request, are skipped.
+func ToOpenAPI3(c *resources.Collection) (*OpenAPI, error) {
+	if c.Collection == nil || c.Info == nil {
+		return nil, fmt.Errorf("the collection has no info")
+	}
+
+	cv := &openAPIConverter{
+		doc: &OpenAPI{
+			OpenAPI: OpenAPIVersion,
+			Info: Info{
+				Title:       c.Info.Name,
+				Description: resources.DescriptionText(c.Info.Description),
+				Version:     "1.0.0",
+			},
+			Paths: make(map[string]*PathItem),
+		},
+		variables:    make(map[string]string),
+		tags:         make(map[string]bool),
+		operationIDs: make(map[string]bool),
+		servers:      make(map[string]bool),
+		schemes:      make(map[string]*SecurityScheme),
+		folderAuth:   make(map[string]*gen.Auth),
+		folderNotes:  make(map[string]string),
+	}
+
+	if v, ok := c.Info.Version.(string); ok && v != "" {
+		cv.doc.Info.Version = v
+	}
+
+	for _, v := range c.Variable {
+		if v == nil || v.Disabled {
+			continue
+		}
+
+		key := v.Key
+		if key == "" {
+			key = v.ID
+		}
+		cv.variables[key] = fmt.Sprint(valueOrEmpty(v.Value))
+	}
+
+	auth, err := resources.ParseAuth(c.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s", err)
+	}
+	cv.collectionAuth = auth
+
+	if req, ok := cv.security(auth); ok {
+		cv.doc.Security = []SecurityRequirement{req}
+	}
+
+	if c.Items != nil {
+		if err := c.Items.Walk(cv.visit); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cv.schemes) > 0 {
+		cv.doc.Components = &Components{SecuritySchemes: cv.schemes}
+	}
+
+	return cv.doc, nil
+}
+
+type openAPIConverter struct {
+	doc *OpenAPI
+
+	variables    map[string]string
+	tags         map[string]bool
+	operationIDs map[string]bool
+	servers      map[string]bool
+	schemes      map[string]*SecurityScheme
+
+	collectionAuth *gen.Auth
+
+	// folderAuth holds the auth in effect for each folder and folderNotes
+	// its description, by path.
+	folderAuth  map[string]*gen.Auth
+	folderNotes map[string]string
+}
+
+func (cv *openAPIConverter) visit(ref resources.ItemRef) error {
+	parent := resources.JoinItemPath(ref.Path...)
+
+	if ref.IsFolder() {
+		group := ref.Node.ItemGroup
+		auth := cv.inheritedAuth(parent)
+		if group != nil && group.ItemGroup != nil {
+			own, err := resources.ParseAuth(group.Auth)
+			if err != nil {
+				return fmt.Errorf("%s: auth: %s", ref.FullPath(), err)
+			}
+			if own != nil {
+				auth = own
+			}
+
+			cv.folderNotes[ref.FullPath()] = resources.DescriptionText(group.Description)
+		}
+		cv.folderAuth[ref.FullPath()] = auth
+
+		return nil
+	}
+
+	if err := cv.request(ref, parent); err != nil {
+		return fmt.Errorf("%s: %s", ref.FullPath(), err)
+	}
+
+	return nil
+}
+
+func (cv *openAPIConverter) inheritedAuth(folder string) *gen.Auth {
+	if folder == "" {
+		return cv.collectionAuth
+	}
+
+	return cv.folderAuth[folder]
+}
+
+func (cv *openAPIConverter) request(ref resources.ItemRef, folder string) error {
+	item := ref.Item
+	r, err := item.ParseRequest()
+	if err != nil {
+		return err
+	}
+
+	base, segments, query := splitURL(r.URL)
+	cv.addServer(base)
+
+	path, params := pathParameters(r, segments)
+
+	pathItem := cv.doc.Paths[path]
+	if pathItem == nil {
+		pathItem = &PathItem{}
+	}
+
+	method := strings.ToLower(r.Method)
+	if op := pathItem.operation(method, false); op != nil {
+		return nil
+	}
+
+	op := pathItem.operation(method, true)
+	if op == nil {
+		return nil
+	}
+	cv.doc.Paths[path] = pathItem
+
+	op.Summary = ref.Name()
+	op.Description = resources.DescriptionText(r.Description)
+	if op.Description == "" && item.Item != nil {
+		op.Description = resources.DescriptionText(item.Description)
+	}
+	op.OperationID = cv.operationID(ref.Name(), method, path)
+	cv.tag(op, ref, folder)
+
+	auth := r.Auth
+	if auth == nil {
+		auth = cv.inheritedAuth(folder)
+	}
+	op.Security = cv.operationSecurity(auth)
+
+	op.Parameters = append(params, requestParameters(r, query, auth)...)
+	op.RequestBody = operationBody(method, r)
+	op.Responses = responses(item)
+
+	return nil
+}
+
+// pathParameters returns the OpenAPI path of a request, with its variables
+// in braces, and the parameters for those variables.
+func pathParameters(r *resources.Request, segments []string) (string, []Parameter) {
+	var params []Parameter
+	path := "/"
+	for i, s := range segments {
+		if i > 0 {
+			path += "/"
+		}
+
+		name := ""
+		if strings.HasPrefix(s, ":") && len(s) > 1 {
+			name = s[1:]
+		} else if m := variable.FindStringSubmatch(s); m != nil && m[0] == s {
+			name = m[1]
+		}
+
+		if name == "" {
+			path += s
+			continue
+		}
+
+		path += "{" + name + "}"
+		p := Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}}
+		for _, v := range r.URL.Variable {
+			if v.Key == name {
+				p.Description = resources.DescriptionText(v.Description)
+				p.Example, p.Schema = exampleParameter(v.Value)
+			}
+		}
+		params = append(params, p)
+	}
+
+	return path, params
+}
+
+// requestParameters returns the query and header parameters of a request.
+// The headers OpenAPI describes elsewhere, such as the content type and the
+// credentials of auth, are left out.
+func requestParameters(r *resources.Request, query []resources.QueryParam, auth *gen.Auth) []Parameter {
+	var params []Parameter
+
+	seen := make(map[string]bool)
+	for _, q := range query {
+		if q.Key == "" || seen[q.Key] {
+			continue
+		}
+		seen[q.Key] = true
+
+		p := Parameter{Name: q.Key, In: "query", Description: resources.DescriptionText(q.Description)}
+		p.Example, p.Schema = exampleParameter(q.Value)
+		params = append(params, p)
+	}
+
+	apiKeyHeader := ""
+	if auth != nil && auth.Type == "apikey" {
+		apiKeyHeader = resources.AuthValues(auth)["key"]
+	}
+
+	for _, h := range r.Header {
+		switch {
+		case h.Key == "",
+			strings.EqualFold(h.Key, "Content-Type"),
+			strings.EqualFold(h.Key, "Accept"),
+			strings.EqualFold(h.Key, "Authorization"),
+			strings.EqualFold(h.Key, apiKeyHeader):
+			continue
+		}
+
+		p := Parameter{Name: h.Key, In: "header", Description: resources.DescriptionText(h.Description)}
+		p.Example, p.Schema = exampleParameter(h.Value)
+		params = append(params, p)
+	}
+
+	return params
+}
+
+// operationBody returns the request body of an operation, or nil for the
+// methods that take none.
+func operationBody(method string, r *resources.Request) *RequestBody {
+	if method == "get" || method == "head" {
+		return nil
+	}
+
+	contentType, _ := r.Header.Get("Content-Type")
+	return requestBody(r.Body, contentType)
+}
+
+// tag files an operation under the folder holding its request, adding the
+// folder to the tags of the document the first time.
+func (cv *openAPIConverter) tag(op *Operation, ref resources.ItemRef, folder string) {
+	if len(ref.Path) == 0 {
+		return
+	}
+
+	tag := ref.Path[len(ref.Path)-1]
+	op.Tags = []string{tag}
+	if !cv.tags[tag] {
+		cv.tags[tag] = true
+		cv.doc.Tags = append(cv.doc.Tags, Tag{Name: tag, Description: cv.folderNotes[folder]})
+	}
+}
+
+// splitURL returns the base of a URL, up to and including the port, the
+// segments of its path and its query parameters.
+func splitURL(u resources.URL) (string, []string, []resources.QueryParam) {
+	if len(u.Host) > 0 || len(u.Path) > 0 {
+		base := strings.Join(u.Host, ".")
+		if u.Protocol != "" {
+			base = u.Protocol + "://" + base
+		}
+		if u.Port != "" {
+			base += ":" + u.Port
+		}
+
+		return base, nonEmpty(u.Path), u.Query
+	}
+
+	raw := u.Raw
+	if i := strings.Index(raw, "#"); i >= 0 {
+		raw = raw[:i]
+	}
+
+	var query []resources.QueryParam
+	if i := strings.Index(raw, "?"); i >= 0 {
+		for _, pair := range strings.Split(raw[i+1:], "&") {
+			kv := strings.SplitN(pair, "=", 2)
+			q := resources.QueryParam{Key: kv[0]}
+			if len(kv) == 2 {
+				q.Value = kv[1]
+			}
+			if k, err := url.QueryUnescape(q.Key); err == nil {
+				q.Key = k
+			}
+			if v, err := url.QueryUnescape(q.Value); err == nil {
+				q.Value = v
+			}
+			query = append(query, q)
+		}
+		raw = raw[:i]
+	}
+
+	scheme := ""
+	if i := strings.Index(raw, "://"); i >= 0 {
+		scheme, raw = raw[:i+3], raw[i+3:]
+	}
+
+	host, path := raw, ""
+	if i := strings.Index(raw, "/"); i >= 0 {
+		host, path = raw[:i], raw[i+1:]
+	}
+
+	return scheme + host, nonEmpty(strings.Split(path, "/")), query
+}
+
+func nonEmpty(s []string) []string {
+	var out []string
+	for _, v := range s {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// addServer adds a base URL as a server. Variables become server variables
+// defaulting to the collection's values.
+func (cv *openAPIConverter) addServer(base string) {
+	if base == "" || cv.servers[base] {
+		return
+	}
+	cv.servers[base] = true
+
+	s := Server{URL: variable.ReplaceAllString(base, "{$1}")}
+	for _, m := range variable.FindAllStringSubmatch(base, -1) {
+		if s.Variables == nil {
+			s.Variables = make(map[string]ServerVariable)
+		}
+		s.Variables[m[1]] = ServerVariable{Default: cv.variables[m[1]]}
+	}
+
+	cv.doc.Servers = append(cv.doc.Servers, s)
+}
+
+// operationID returns a unique operationId made from the request name, such
+// as "listPets" for "List pets".
+func (cv *openAPIConverter) operationID(name, method, path string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		words = strings.FieldsFunc(method+" "+path, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+	}
+
+	var b strings.Builder
+	for i, w := range words {
+		r := []rune(w)
+		if i == 0 {
+			r[0] = unicode.ToLower(r[0])
+		} else {
+			r[0] = unicode.ToUpper(r[0])
+		}
+		b.WriteString(string(r))
+	}
+
+	id := b.String()
+	for n := 2; cv.operationIDs[id]; n++ {
+		id = b.String() + strconv.Itoa(n)
+	}
+	cv.operationIDs[id] = true
+
+	return id
+}
+
+// exampleParameter returns the example and schema of a parameter value.
+// Values using variables aren't examples.
+func exampleParameter(value string) (interface{}, *Schema) {
+	if value == "" || variable.MatchString(value) {
+		return nil, &Schema{Type: "string"}
+	}
+
+	return inferScalar(value)
+}
+
+// operationSecurity returns the security of an operation whose auth is in
+// effect, or nil when it is the same as the document's.
+func (cv *openAPIConverter) operationSecurity(auth *gen.Auth) *[]SecurityRequirement {
+	if auth == cv.collectionAuth {
+		return nil
+	}
+
+	reqs := []SecurityRequirement{}
+	if req, ok := cv.security(auth); ok {
+		reqs = append(reqs, req)
+	} else if cv.doc.Security == nil {
+		return nil
+	}
+
+	return &reqs
+}
+
+// security returns the security requirement for auth, adding its scheme to
+// the document. It returns false for no auth or auth OpenAPI can't express.
+func (cv *openAPIConverter) security(auth *gen.Auth) (SecurityRequirement, bool) {
+	if auth == nil {
+		return nil, false
+	}
+
+	values := resources.AuthValues(auth)
+	var (
+		name   string
+		scheme *SecurityScheme
+		scopes = []string{}
+	)
+
+	switch auth.Type {
+	case "basic", "bearer", "digest":
+		name = auth.Type + "Auth"
+		scheme = &SecurityScheme{Type: "http", Scheme: auth.Type}
+	case "apikey":
+		in := values["in"]
+		if in == "" {
+			in = "header"
+		}
+		key := values["key"]
+		if key == "" {
+			key = "X-API-Key"
+		}
+		name = "apiKeyAuth"
+		scheme = &SecurityScheme{Type: "apiKey", Name: key, In: in}
+	case "oauth2":
+		flow := &OAuthFlow{Scopes: make(map[string]string)}
+		for _, s := range strings.Fields(values["scope"]) {
+			flow.Scopes[s] = ""
+			scopes = append(scopes, s)
+		}
+
+		flows := &OAuthFlows{}
+		switch values["grant_type"] {
+		case "client_credentials":
+			flow.TokenURL = values["accessTokenUrl"]
+			flows.ClientCredentials = flow
+		case "password", "password_credentials":
+			flow.TokenURL = values["accessTokenUrl"]
+			flows.Password = flow
+		case "implicit":
+			flow.AuthorizationURL = values["authUrl"]
+			flows.Implicit = flow
+		default:
+			flow.AuthorizationURL = values["authUrl"]
+			flow.TokenURL = values["accessTokenUrl"]
+			flows.AuthorizationCode = flow
+		}
+
+		name = "oauth2"
+		scheme = &SecurityScheme{Type: "oauth2", Flows: flows}
+	default:
+		return nil, false
+	}
+
+	base := name
+	for n := 2; ; n++ {
+		existing, ok := cv.schemes[name]
+		if !ok {
+			cv.schemes[name] = scheme
+			break
+		}
+		if reflect.DeepEqual(existing, scheme) {
+			break
+		}
+		name = base + strconv.Itoa(n)
+	}
+
+	return SecurityRequirement{name: scopes}, true
+}
+
+// mediaTypes maps the languages of raw bodies to media types.
+var mediaTypes = map[string]string{
+	"json":       "application/json",
+	"xml":        "application/xml",
+	"html":       "text/html",
+	"javascript": "application/javascript",
+	"text":       "text/plain",
+}
+
+func requestBody(body *resources.RequestBody, contentType string) *RequestBody {
+	if body == nil || body.Disabled {
+		return nil
+	}
+
+	media := &MediaType{}
+	switch body.Mode {
+	case "raw":
+		if strings.TrimSpace(body.Raw) == "" {
+			return nil
+		}
+
+		if contentType == "" {
+			contentType = mediaTypes[body.Language()]
+		}
+
+		contentType, media = rawContent(contentType, body.Raw)
+	case "urlencoded", "formdata":
+		contentType = "application/x-www-form-urlencoded"
+		if body.Mode == "formdata" {
+			contentType = "multipart/form-data"
+		}
+
+		media.Schema = &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		example := make(map[string]interface{})
+		for _, p := range body.URLEncoded {
+			media.Schema.Properties[p.Key] = &Schema{Type: "string"}
+			example[p.Key] = p.Value
+		}
+		for _, p := range body.FormData {
+			if p.Type == "file" {
+				media.Schema.Properties[p.Key] = &Schema{Type: "string", Format: "binary"}
+				continue
+			}
+			media.Schema.Properties[p.Key] = &Schema{Type: "string"}
+			example[p.Key] = p.Value
+		}
+		if len(example) > 0 {
+			media.Example = example
+		}
+	case "graphql":
+		if body.GraphQL == nil {
+			return nil
+		}
+
+		contentType = "application/json"
+		example := map[string]interface{}{"query": body.GraphQL.Query}
+		if vars, ok := parseJSON(body.GraphQL.Variables); ok {
+			example["variables"] = vars
+		}
+		media.Schema = InferSchema(example)
+		media.Example = example
+	case "file":
+		contentType = "application/octet-stream"
+		media.Schema = &Schema{Type: "string", Format: "binary"}
+	default:
+		return nil
+	}
+
+	return &RequestBody{Content: map[string]*MediaType{mediaType(contentType): media}}
+}
+
+// rawContent describes a raw body. Bodies that are JSON get a schema
+// inferred from them.
+func rawContent(contentType, raw string) (string, *MediaType) {
+	isJSON := contentType == "" || strings.Contains(strings.ToLower(contentType), "json")
+	if isJSON {
+		if v, ok := parseJSON(raw); ok {
+			if contentType == "" {
+				contentType = "application/json"
+			}
+			return contentType, &MediaType{Schema: InferSchema(v), Example: v}
+		}
+	}
+
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	return contentType, &MediaType{Schema: &Schema{Type: "string"}, Example: raw}
+}
+
+// mediaType strips parameters, such as the charset, from a content type.
+func mediaType(contentType string) string {
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
+
+// responses converts the saved examples of a request.
+func responses(item *resources.Item) map[string]*Response {
+	out := make(map[string]*Response)
+	if item.Item == nil || len(item.Response) == 0 {
+		out["200"] = &Response{Description: "Successful response"}
+		return out
+	}
+
+	names := item.ResponseNames()
+	for i, r := range item.Response {
+		if r == nil {
+			continue
+		}
+
+		code := r.Code
+		if code == 0 {
+			code = http.StatusOK
+		}
+		key := strconv.Itoa(code)
+
+		resp := out[key]
+		if resp == nil {
+			resp = &Response{Description: r.Status}
+			if resp.Description == "" {
+				resp.Description = http.StatusText(code)
+			}
+			out[key] = resp
+		}
+
+		body, _ := r.Body.(string)
+		if strings.TrimSpace(body) == "" {
+			continue
+		}
+
+		var headers resources.HeaderList
+		if b, err := json.Marshal(r.Header); err == nil {
+			_ = json.Unmarshal(b, &headers)
+		}
+		contentType, _ := headers.Get("Content-Type")
+
+		contentType, media := rawContent(contentType, body)
+		contentType = mediaType(contentType)
+
+		if resp.Content == nil {
+			resp.Content = make(map[string]*MediaType)
+		}
+
+		existing := resp.Content[contentType]
+		if existing == nil {
+			existing = &MediaType{Schema: media.Schema, Examples: make(map[string]*Example)}
+			resp.Content[contentType] = existing
+		} else if media.Schema.Type != "string" {
+			existing.Schema = mergeSchemas(existing.Schema, media.Schema)
+		}
+
+		name := names[i]
+		if name == "" {
+			name = key
+		}
+		exampleName := exampleKey(name)
+		for n := 2; existing.Examples[exampleName] != nil; n++ {
+			exampleName = exampleKey(name) + strconv.Itoa(n)
+		}
+		existing.Examples[exampleName] = &Example{Summary: name, Value: media.Example}
+	}
+
+	return out
+}
+
+// exampleKey turns the name of a saved example into a key of examples, such
+// as "not-found" for "Not found".
+func exampleKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "example"
+	}
+
+	return strings.Join(words, "-")
+}
+
+func valueOrEmpty(v interface{}) interface{} {
+	if v == nil {
+		return ""
+	}
+
+	return v
+}
@@ -0,0 +1,146 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// InferSchema returns a schema describing a value decoded by encoding/json,
+// such as an example body. The items of an array are described by merging
+// the schemas of all elements.
+func InferSchema(v interface{}) *Schema {
+	switch v := v.(type) {
+	case nil:
+		return &Schema{Nullable: true}
+	case bool:
+		return &Schema{Type: "boolean"}
+	case float64:
+		if v == math.Trunc(v) {
+			return &Schema{Type: "integer"}
+		}
+		return &Schema{Type: "number"}
+	case string:
+		s := &Schema{Type: "string"}
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			s.Format = "date-time"
+		}
+		return s
+	case []interface{}:
+		items := &Schema{}
+		for i, e := range v {
+			if i == 0 {
+				items = InferSchema(e)
+			} else {
+				items = mergeSchemas(items, InferSchema(e))
+			}
+		}
+		return &Schema{Type: "array", Items: items}
+	case map[string]interface{}:
+		s := &Schema{Type: "object", Properties: make(map[string]*Schema, len(v))}
+		for k, p := range v {
+			s.Properties[k] = InferSchema(p)
+		}
+		return s
+	}
+
+	return &Schema{}
+}
+
+// mergeSchemas combines the schemas of two examples of the same value. When
+// the types differ, the first wins, except that null makes it nullable.
+func mergeSchemas(a, b *Schema) *Schema {
+	switch {
+	case a.Type == "" && a.Nullable:
+		b.Nullable = true
+		return b
+	case b.Type == "" && b.Nullable:
+		a.Nullable = true
+		return a
+	case a.Type == "integer" && b.Type == "number":
+		return b
+	case a.Type != b.Type:
+		return a
+	}
+
+	if a.Format != b.Format {
+		a.Format = ""
+	}
+
+	if a.Type == "object" {
+		for k, p := range b.Properties {
+			if existing, ok := a.Properties[k]; ok {
+				a.Properties[k] = mergeSchemas(existing, p)
+			} else {
+				a.Properties[k] = p
+			}
+		}
+	}
+
+	if a.Type == "array" && a.Items != nil && b.Items != nil {
+		a.Items = mergeSchemas(a.Items, b.Items)
+	}
+
+	return a
+}
+
+// inferScalar returns the value of a parameter given as text, such as a
+// query parameter, with a schema for it.
+func inferScalar(s string) (interface{}, *Schema) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, &Schema{Type: "integer"}
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, &Schema{Type: "number"}
+	}
+
+	if b, err := strconv.ParseBool(s); err == nil && (s == "true" || s == "false") {
+		return b, &Schema{Type: "boolean"}
+	}
+
+	return s, InferSchema(s)
+}
+
+// bareVariable matches a variable used as a JSON value without quotes,
+// such as {"id": {{id}}}.
+var bareVariable = regexp.MustCompile(`([:\[,]\s*)(\{\{[^{}"]+\}\})(\s*[,\]}])`)
+
+// parseJSON decodes a JSON body. Variables used as bare values are taken as
+// strings, so bodies written for Postman still decode.
+func parseJSON(s string) (interface{}, bool) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err == nil {
+		return v, true
+	}
+
+	// Matches can't overlap, so replace until nothing changes.
+	for quoted := ""; quoted != s; {
+		quoted = s
+		s = bareVariable.ReplaceAllString(s, `$1"$2"$3`)
+	}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
@@ -0,0 +1,47 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Formats returns the formats Write supports.
+func Formats() []string {
+	return []string{"yaml", "json"}
+}
+
+// Write encodes a converted document, such as an OpenAPI document, as YAML
+// or JSON.
+func Write(w io.Writer, v interface{}, format string) error {
+	switch format {
+	case "yaml":
+		return yaml.NewEncoder(w).Encode(v)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	return fmt.Errorf("unknown format %q, expected one of: %s", format, strings.Join(Formats(), ", "))
+}
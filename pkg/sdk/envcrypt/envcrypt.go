@@ -0,0 +1,384 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envcrypt encrypts the values of variables in Postman environment
+// files, so they can be kept in version control. Only the values are
+// encrypted; names and the structure of the file stay readable, and
+// values that are already encrypted are left as they are, so a change to
+// one variable changes one line.
+//
+// Keys are derived from a passphrase with scrypt and values are sealed
+// with AES-256-GCM, bound to the name of their variable. An encrypted value
+// has the form "ENC[v1,<salt>,<sealed value>]".
+package envcrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	prefix  = "ENC[v1,"
+	suffix  = "]"
+	saltLen = 16
+)
+
+// ErrNoPassphrase is returned by New for an empty passphrase.
+var ErrNoPassphrase = errors.New("empty passphrase")
+
+// Cipher encrypts and decrypts values with a key derived from a
+// passphrase.
+type Cipher struct {
+	passphrase []byte
+	salt       []byte
+	keys       map[string]cipher.AEAD
+}
+
+// New returns a Cipher for the passphrase.
+func New(passphrase string) (*Cipher, error) {
+	if passphrase == "" {
+		return nil, ErrNoPassphrase
+	}
+
+	return &Cipher{passphrase: []byte(passphrase), keys: make(map[string]cipher.AEAD)}, nil
+}
+
+// IsEncrypted reports whether value is encrypted.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix)
+}
+
+// aead returns the cipher for the salt. Deriving a key is slow by design,
+// so keys are kept for the lifetime of the Cipher.
+func (c *Cipher) aead(salt []byte) (cipher.AEAD, error) {
+	if a, ok := c.keys[string(salt)]; ok {
+		return a, nil
+	}
+
+	key, err := scrypt.Key(c.passphrase, salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	c.keys[string(salt)] = a
+
+	return a, nil
+}
+
+// EncryptValue encrypts the value of the variable name. Values that are
+// already encrypted are returned as they are.
+func (c *Cipher) EncryptValue(name, value string) (string, error) {
+	if IsEncrypted(value) {
+		return value, nil
+	}
+
+	if c.salt == nil {
+		salt := make([]byte, saltLen)
+		if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+			return "", err
+		}
+		c.salt = salt
+	}
+
+	a, err := c.aead(c.salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, a.NonceSize(), a.NonceSize()+len(value)+a.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := a.Seal(nonce, nonce, []byte(value), []byte(name))
+
+	enc := base64.StdEncoding
+	return prefix + enc.EncodeToString(c.salt) + "," + enc.EncodeToString(sealed) + suffix, nil
+}
+
+// DecryptValue decrypts the value of the variable name. Values that aren't
+// encrypted are returned as they are.
+func (c *Cipher) DecryptValue(name, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, prefix), suffix), ",")
+	if len(parts) != 2 {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	a, err := c.aead(salt)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < a.NonceSize() {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	plain, err := a.Open(nil, sealed[:a.NonceSize()], sealed[a.NonceSize():], []byte(name))
+	if err != nil {
+		return "", errors.New("unable to decrypt, wrong passphrase or modified value")
+	}
+
+	return string(plain), nil
+}
+
+// Encrypt encrypts the values of the variables in an environment file.
+func (c *Cipher) Encrypt(b []byte) ([]byte, error) {
+	return transform(b, c.EncryptValue)
+}
+
+// Decrypt decrypts the values of the variables in an environment file.
+func (c *Cipher) Decrypt(b []byte) ([]byte, error) {
+	return transform(b, c.DecryptValue)
+}
+
+// Encrypted reports whether any value in the environment file is
+// encrypted. It fails when the file isn't an environment.
+func Encrypted(b []byte) (bool, error) {
+	found := false
+	_, err := transform(b, func(name, value string) (string, error) {
+		if IsEncrypted(value) {
+			found = true
+		}
+		return value, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// transform applies fn to the string values of the variables in an
+// environment file, which may be wrapped in an "environment" member. The
+// order of members is kept and the file is indented with two spaces.
+func transform(b []byte, fn func(name, value string) (string, error)) ([]byte, error) {
+	out, err := transformEnvironment(b, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, out, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+func transformEnvironment(b []byte, fn func(name, value string) (string, error)) ([]byte, error) {
+	env, err := decodeObject(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(env) == 1 && env[0].key == "environment" {
+		inner, err := transformEnvironment(env[0].value, fn)
+		if err != nil {
+			return nil, err
+		}
+		env[0].value = inner
+
+		return encodeObject(env)
+	}
+
+	for i, m := range env {
+		if m.key != "values" {
+			continue
+		}
+
+		var values []json.RawMessage
+		if err := json.Unmarshal(m.value, &values); err != nil {
+			return nil, fmt.Errorf("values: %s", err)
+		}
+
+		for j, raw := range values {
+			v, err := transformValue(raw, fn)
+			if err != nil {
+				return nil, err
+			}
+			values[j] = v
+		}
+
+		env[i].value = encodeArray(values)
+	}
+
+	return encodeObject(env)
+}
+
+func transformValue(b []byte, fn func(name, value string) (string, error)) ([]byte, error) {
+	v, err := decodeObject(b)
+	if err != nil {
+		return nil, fmt.Errorf("values: %s", err)
+	}
+
+	var name string
+	for _, m := range v {
+		if m.key != "key" {
+			continue
+		}
+
+		// The name is bound to encrypted values, so it has to be known.
+		if err := json.Unmarshal(m.value, &name); err != nil {
+			return nil, fmt.Errorf("values: the key %s is not a string", m.value)
+		}
+	}
+
+	for i, m := range v {
+		if m.key != "value" {
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal(m.value, &value); err != nil {
+			continue
+		}
+
+		value, err := fn(name, value)
+		if err != nil {
+			return nil, fmt.Errorf("variable %q: %s", name, err)
+		}
+
+		if v[i].value, err = encodeString(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return encodeObject(v)
+}
+
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// decodeObject decodes the members of a JSON object in order.
+func decodeObject(b []byte) ([]member, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := t.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("expected a JSON object")
+	}
+
+	var members []member
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var value json.RawMessage
+		if err := d.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		members = append(members, member{key: t.(string), value: value})
+	}
+
+	if _, err := d.Token(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func encodeObject(members []member) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		k, err := encodeString(m.key)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func encodeArray(values []json.RawMessage) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes()
+}
+
+// encodeString encodes s without escaping HTML characters, so values read
+// the same as in the Postman app.
+func encodeString(s string) ([]byte, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(s); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
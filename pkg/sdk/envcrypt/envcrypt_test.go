@@ -0,0 +1,156 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envcrypt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/envcrypt"
+)
+
+const plainEnvironment = `{
+  "name": "dev",
+  "values": [
+    {
+      "key": "host",
+      "value": "https://example.com/?a=1&b=2",
+      "enabled": true
+    },
+    {
+      "key": "token",
+      "value": "s3cret",
+      "enabled": true,
+      "type": "secret"
+    }
+  ],
+  "_postman_variable_scope": "environment"
+}
+`
+
+func TestEncryptDecrypt(t *testing.T) {
+	c, err := envcrypt.New("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := c.Encrypt([]byte(plainEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := string(encrypted)
+	if strings.Contains(s, "s3cret") || strings.Contains(s, "example.com") {
+		t.Errorf("have values in the clear in %s", s)
+	}
+
+	for _, want := range []string{`"key": "token"`, `"type": "secret"`, `"_postman_variable_scope": "environment"`} {
+		if !strings.Contains(s, want) {
+			t.Errorf("have %s, want it to contain %s", s, want)
+		}
+	}
+
+	if ok, err := envcrypt.Encrypted(encrypted); !ok || err != nil {
+		t.Errorf("have the file not encrypted (%v), want it encrypted", err)
+	}
+
+	if ok, err := envcrypt.Encrypted([]byte(plainEnvironment)); ok || err != nil {
+		t.Errorf("have the plain file encrypted (%v), want it not encrypted", err)
+	}
+
+	again, err := c.Encrypt(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(again) != s {
+		t.Errorf("have encrypted values changed by encrypting again:\n%s\nwant:\n%s", again, s)
+	}
+
+	d, err := envcrypt.New("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := d.Decrypt(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decrypted) != plainEnvironment {
+		t.Errorf("have\n%s\nwant\n%s", decrypted, plainEnvironment)
+	}
+}
+
+func TestDecryptWrongPassphrase(t *testing.T) {
+	c, _ := envcrypt.New("passphrase")
+	encrypted, err := c.Encrypt([]byte(plainEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, _ := envcrypt.New("wrong")
+	_, err = d.Decrypt(encrypted)
+	if err == nil || !strings.Contains(err.Error(), `variable "host"`) {
+		t.Errorf("have error %v, want it to name the variable", err)
+	}
+}
+
+func TestDecryptValueBoundToName(t *testing.T) {
+	c, _ := envcrypt.New("passphrase")
+	v, err := c.EncryptValue("token", "s3cret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.DecryptValue("other", v); err == nil {
+		t.Error("have a value decrypted under another name, want an error")
+	}
+
+	if have, err := c.DecryptValue("token", v); err != nil || have != "s3cret" {
+		t.Errorf("have %q, %v, want s3cret", have, err)
+	}
+}
+
+func TestWrappedEnvironment(t *testing.T) {
+	c, _ := envcrypt.New("passphrase")
+	encrypted, err := c.Encrypt([]byte(`{"environment": {"values": [{"key": "k", "value": "v"}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := envcrypt.Encrypted(encrypted); !ok || err != nil {
+		t.Errorf("have %s not encrypted (%v), want it encrypted", encrypted, err)
+	}
+}
+
+func TestMalformedEnvironment(t *testing.T) {
+	if _, err := envcrypt.Encrypted([]byte(`{"values": `)); err == nil {
+		t.Error("have no error for malformed JSON, want one")
+	}
+
+	c, _ := envcrypt.New("passphrase")
+	if _, err := c.Encrypt([]byte(`{"values": [{"key": 1, "value": "v"}]}`)); err == nil {
+		t.Error("have no error for a key that isn't a string, want one")
+	}
+}
+
+func TestNewEmptyPassphrase(t *testing.T) {
+	if _, err := envcrypt.New(""); err != envcrypt.ErrNoPassphrase {
+		t.Errorf("have %v, want ErrNoPassphrase", err)
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envfile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// SecretMarker is the comment that marks the variable on the next line of a
+// dotenv file as secret.
+const SecretMarker = "# postmanctl:secret"
+
+var (
+	dotenvKey   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+	dotenvBare  = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]+$`)
+	dotenvQuote = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "$", `\$`)
+)
+
+// writeDotenv writes a dotenv file. Disabled variables are commented out
+// and secrets follow a SecretMarker line, so that reading the file back
+// restores both.
+func writeDotenv(w io.Writer, env *resources.Environment) error {
+	for _, v := range env.Values {
+		if !dotenvKey.MatchString(v.Key) {
+			return fmt.Errorf("variable %q is not a valid dotenv name", v.Key)
+		}
+
+		var b strings.Builder
+		if v.Type == resources.SecretValueType {
+			b.WriteString(SecretMarker + "\n")
+		}
+		if !v.Enabled {
+			b.WriteString("# ")
+		}
+		fmt.Fprintf(&b, "%s=%s\n", v.Key, quoteDotenv(v.Value))
+
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func quoteDotenv(s string) string {
+	switch {
+	case s == "" || dotenvBare.MatchString(s):
+		return s
+	case !strings.ContainsAny(s, "'\n\r"):
+		return "'" + s + "'"
+	}
+
+	return `"` + dotenvQuote.Replace(s) + `"`
+}
+
+// readDotenv reads a dotenv file. Assignments may start with "export", and
+// values may be single quoted, which keeps them as they are, or double
+// quoted, which expands escape sequences such as \n. Quoted values can span
+// lines. A commented out assignment is read as a disabled variable.
+func readDotenv(r io.Reader) ([]resources.KeyValuePair, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.Replace(string(b), "\r\n", "\n", -1), "\n")
+
+	var (
+		values []resources.KeyValuePair
+		secret bool
+	)
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		enabled := true
+
+		// The marker only applies to the line right after it.
+		marked := secret
+		secret = false
+
+		switch {
+		case line == "":
+			continue
+		case line == SecretMarker:
+			secret = true
+			continue
+		case strings.HasPrefix(line, "#"):
+			line = strings.TrimSpace(line[1:])
+			enabled = false
+		}
+
+		key, rest, err := splitAssignment(line)
+		if err != nil {
+			if !enabled {
+				// Just a comment.
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+
+		var more []string
+		if enabled {
+			more = lines[i+1:]
+		}
+
+		value, consumed, err := parseDotenvValue(rest, more)
+		if err != nil {
+			if !enabled {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		i += consumed
+
+		v := resources.KeyValuePair{Key: key, Value: value, Enabled: enabled}
+		if marked {
+			v.Type = resources.SecretValueType
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func splitAssignment(line string) (string, string, error) {
+	if strings.HasPrefix(line, "export ") {
+		line = strings.TrimSpace(line[len("export "):])
+	}
+
+	eq := strings.Index(line, "=")
+	if eq < 0 {
+		return "", "", errors.New("want KEY=VALUE")
+	}
+
+	key := strings.TrimSpace(line[:eq])
+	if !dotenvKey.MatchString(key) {
+		return "", "", fmt.Errorf("%q is not a valid name", key)
+	}
+
+	return key, strings.TrimLeft(line[eq+1:], " \t"), nil
+}
+
+// parseDotenvValue parses the value of an assignment. Quoted values that
+// don't end on the line continue on the lines in more, and the number of
+// them that were used is returned.
+func parseDotenvValue(s string, more []string) (string, int, error) {
+	if s == "" {
+		return "", 0, nil
+	}
+
+	quote := s[0]
+	if quote != '\'' && quote != '"' {
+		if i := strings.Index(s, " #"); i >= 0 {
+			s = s[:i]
+		}
+		return strings.TrimSpace(s), 0, nil
+	}
+
+	var (
+		b        strings.Builder
+		consumed int
+		text     = s[1:]
+	)
+
+	for {
+		for i := 0; i < len(text); i++ {
+			c := text[i]
+			switch {
+			case c == quote:
+				if rest := strings.TrimSpace(text[i+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+					return "", 0, fmt.Errorf("unexpected %q after the closing quote", rest)
+				}
+				return b.String(), consumed, nil
+			case c == '\\' && quote == '"' && i+1 < len(text):
+				i++
+				switch text[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 'r':
+					b.WriteByte('\r')
+				case 't':
+					b.WriteByte('\t')
+				case '"', '\\', '$':
+					b.WriteByte(text[i])
+				default:
+					b.WriteByte('\\')
+					b.WriteByte(text[i])
+				}
+			default:
+				b.WriteByte(c)
+			}
+		}
+
+		if consumed == len(more) {
+			return "", 0, errors.New("unterminated quoted value")
+		}
+
+		b.WriteByte('\n')
+		text = more[consumed]
+		consumed++
+	}
+}
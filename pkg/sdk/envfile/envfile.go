@@ -0,0 +1,191 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envfile converts the variables of environments to and from files
+// such as dotenv files, shell scripts and Kubernetes secrets.
+package envfile
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var writers = map[string]func(io.Writer, *resources.Environment) error{
+	"dotenv":     writeDotenv,
+	"json":       writeJSON,
+	"k8s-secret": writeK8sSecret,
+	"shell":      writeShell,
+}
+
+var readers = map[string]func(io.Reader) ([]resources.KeyValuePair, error){
+	"dotenv": readDotenv,
+	"json":   readJSON,
+}
+
+// Write writes the variables of an environment in the given format.
+func Write(w io.Writer, env *resources.Environment, format string) error {
+	write, ok := writers[format]
+	if !ok {
+		return fmt.Errorf("unknown format: %s", format)
+	}
+
+	return write(w, env)
+}
+
+// Read reads variables in the given format.
+func Read(r io.Reader, format string) ([]resources.KeyValuePair, error) {
+	read, ok := readers[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+
+	return read(r)
+}
+
+// Formats returns the formats environments can be written in.
+func Formats() []string {
+	names := make([]string, 0, len(writers))
+	for k := range writers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ReadFormats returns the formats variables can be read from.
+func ReadFormats() []string {
+	names := make([]string, 0, len(readers))
+	for k := range readers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// exportedEnvironment is the format the Postman app exports environments in.
+type exportedEnvironment struct {
+	ID     string                   `json:"id,omitempty"`
+	Name   string                   `json:"name"`
+	Values []resources.KeyValuePair `json:"values"`
+	Scope  string                   `json:"_postman_variable_scope"`
+}
+
+func writeJSON(w io.Writer, env *resources.Environment) error {
+	values := env.Values
+	if values == nil {
+		values = []resources.KeyValuePair{}
+	}
+
+	b, err := json.MarshalIndent(exportedEnvironment{
+		ID:     env.ID,
+		Name:   env.Name,
+		Values: values,
+		Scope:  "environment",
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+func readJSON(r io.Reader) ([]resources.KeyValuePair, error) {
+	var env exportedEnvironment
+	if err := json.NewDecoder(r).Decode(&env); err != nil {
+		return nil, err
+	}
+
+	return env.Values, nil
+}
+
+var shellName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// writeShell writes export statements for sh-compatible shells. Disabled
+// variables are commented out.
+func writeShell(w io.Writer, env *resources.Environment) error {
+	for _, v := range env.Values {
+		if !shellName.MatchString(v.Key) {
+			return fmt.Errorf("variable %q is not a valid shell variable name", v.Key)
+		}
+
+		prefix := ""
+		if !v.Enabled {
+			prefix = "# "
+		}
+
+		if _, err := fmt.Fprintf(w, "%sexport %s=%s\n", prefix, v.Key, shellQuote(v.Value)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+var (
+	secretKey        = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+)
+
+// writeK8sSecret writes a Kubernetes Secret manifest holding the enabled
+// variables, named after the environment.
+func writeK8sSecret(w io.Writer, env *resources.Environment) error {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(env.Name), "-"), "-.")
+	if name == "" {
+		name = "postman-environment"
+	}
+
+	var b strings.Builder
+	b.WriteString("apiVersion: v1\nkind: Secret\nmetadata:\n")
+	fmt.Fprintf(&b, "  name: %s\n", name)
+	b.WriteString("type: Opaque\ndata:")
+
+	enabled := 0
+	for _, v := range env.Values {
+		if !v.Enabled {
+			continue
+		}
+
+		if !secretKey.MatchString(v.Key) {
+			return fmt.Errorf("variable %q is not a valid Kubernetes secret key", v.Key)
+		}
+
+		fmt.Fprintf(&b, "\n  %s: %s", strconv.Quote(v.Key), strconv.Quote(base64.StdEncoding.EncodeToString([]byte(v.Value))))
+		enabled++
+	}
+
+	if enabled == 0 {
+		b.WriteString(" {}")
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
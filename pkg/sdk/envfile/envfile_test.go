@@ -0,0 +1,208 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envfile_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/envfile"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var env = &resources.Environment{
+	ID:   "abcdef",
+	Name: "Staging API",
+	Values: []resources.KeyValuePair{
+		{Key: "HOST", Value: "staging.example.com", Enabled: true},
+		{Key: "TOKEN", Value: "s3cr3t value", Enabled: true, Type: resources.SecretValueType},
+		{Key: "GREETING", Value: "it's \"quoted\"\nand $multiline", Enabled: true},
+		{Key: "DEBUG", Value: "true", Enabled: false},
+		{Key: "EMPTY", Value: "", Enabled: true},
+	},
+}
+
+func TestWriteDotenv(t *testing.T) {
+	var b bytes.Buffer
+	if err := envfile.Write(&b, env, "dotenv"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `HOST=staging.example.com
+# postmanctl:secret
+TOKEN='s3cr3t value'
+GREETING="it's \"quoted\"\nand \$multiline"
+# DEBUG=true
+EMPTY=
+`
+	if b.String() != want {
+		t.Errorf("have:\n%s\nwant:\n%s", b.String(), want)
+	}
+
+	values, err := envfile.Read(&b, "dotenv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(values, env.Values) {
+		t.Errorf("have values %+v after a round trip, want %+v", values, env.Values)
+	}
+}
+
+func TestReadDotenv(t *testing.T) {
+	file := `# Local settings, see https://example.com/docs?a=b
+
+export HOST=localhost # the API host
+PORT = 8080
+KEY='literal \n $HOME'
+CERT="-----BEGIN-----
+abc
+-----END-----"
+#DISABLED=1
+`
+
+	values, err := envfile.Read(strings.NewReader(file), "dotenv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []resources.KeyValuePair{
+		{Key: "HOST", Value: "localhost", Enabled: true},
+		{Key: "PORT", Value: "8080", Enabled: true},
+		{Key: "KEY", Value: `literal \n $HOME`, Enabled: true},
+		{Key: "CERT", Value: "-----BEGIN-----\nabc\n-----END-----", Enabled: true},
+		{Key: "DISABLED", Value: "1", Enabled: false},
+	}
+
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("have values %+v, want %+v", values, want)
+	}
+}
+
+func TestReadDotenvSecretMarker(t *testing.T) {
+	file := `# postmanctl:secret
+# a note about the token
+TOKEN=abc
+# postmanctl:secret
+
+PASSWORD=def
+# postmanctl:secret
+KEY=ghi
+HOST=localhost
+`
+
+	values, err := envfile.Read(strings.NewReader(file), "dotenv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []resources.KeyValuePair{
+		{Key: "TOKEN", Value: "abc", Enabled: true},
+		{Key: "PASSWORD", Value: "def", Enabled: true},
+		{Key: "KEY", Value: "ghi", Enabled: true, Type: resources.SecretValueType},
+		{Key: "HOST", Value: "localhost", Enabled: true},
+	}
+
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("have values %+v, want %+v", values, want)
+	}
+}
+
+func TestReadDotenvErrors(t *testing.T) {
+	for _, file := range []string{"HOST", "MY KEY=1", `KEY="unterminated`, `KEY='a' b`} {
+		if _, err := envfile.Read(strings.NewReader(file), "dotenv"); err == nil {
+			t.Errorf("have no error for %q, want one", file)
+		}
+	}
+}
+
+func TestWriteShell(t *testing.T) {
+	var b bytes.Buffer
+	if err := envfile.Write(&b, env, "shell"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `export HOST='staging.example.com'
+export TOKEN='s3cr3t value'
+export GREETING='it'\''s "quoted"
+and $multiline'
+# export DEBUG='true'
+export EMPTY=''
+`
+	if b.String() != want {
+		t.Errorf("have:\n%s\nwant:\n%s", b.String(), want)
+	}
+
+	invalid := &resources.Environment{Values: []resources.KeyValuePair{{Key: "base-url", Enabled: true}}}
+	if err := envfile.Write(&b, invalid, "shell"); err == nil {
+		t.Error("have no error for an invalid shell variable name, want one")
+	}
+}
+
+func TestWriteK8sSecret(t *testing.T) {
+	var b bytes.Buffer
+	if err := envfile.Write(&b, env, "k8s-secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `apiVersion: v1
+kind: Secret
+metadata:
+  name: staging-api
+type: Opaque
+data:
+  "HOST": "c3RhZ2luZy5leGFtcGxlLmNvbQ=="
+  "TOKEN": "czNjcjN0IHZhbHVl"
+  "GREETING": "aXQncyAicXVvdGVkIgphbmQgJG11bHRpbGluZQ=="
+  "EMPTY": ""
+`
+	if b.String() != want {
+		t.Errorf("have:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	var b bytes.Buffer
+	if err := envfile.Write(&b, env, "json"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(b.String(), `"_postman_variable_scope": "environment"`) {
+		t.Errorf("have %s, want a Postman environment file", b.String())
+	}
+
+	values, err := envfile.Read(&b, "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(values, env.Values) {
+		t.Errorf("have values %+v after a round trip, want %+v", values, env.Values)
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	if err := envfile.Write(&bytes.Buffer{}, env, "xml"); err == nil {
+		t.Error("have no error writing xml, want one")
+	}
+
+	if _, err := envfile.Read(strings.NewReader(""), "shell"); err == nil {
+		t.Error("have no error reading shell, want one")
+	}
+}
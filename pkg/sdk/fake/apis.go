@@ -0,0 +1,454 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+)
+
+func (a *API) listAPIs(w http.ResponseWriter, r *request) {
+	workspace := r.URL.Query().Get("workspace")
+
+	items := []object{}
+	for _, e := range a.apis.list() {
+		if workspace == "" || e.workspace == workspace {
+			items = append(items, e.data)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{"apis": items})
+}
+
+func (a *API) getAPI(w http.ResponseWriter, id string) {
+	e, ok := a.apis.get(id)
+	if !ok {
+		notFound(w, "API")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"api": e.data})
+}
+
+func (a *API) createAPI(w http.ResponseWriter, r *request) {
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	api, ok := r.decode(w, "api")
+	if !ok {
+		return
+	}
+
+	if str(api, "name") == "" {
+		malformed(w, "api", "name is required.")
+		return
+	}
+
+	id := newID()
+	now := a.timestamp()
+	e := &entry{
+		id:        id,
+		workspace: ws.id,
+		data: object{
+			"id":          id,
+			"name":        str(api, "name"),
+			"summary":     str(api, "summary"),
+			"description": str(api, "description"),
+			"createdBy":   a.owner(),
+			"updatedBy":   a.owner(),
+			"team":        "",
+			"createdAt":   now,
+			"updatedAt":   now,
+		},
+	}
+
+	a.apis.add(e)
+
+	writeJSON(w, http.StatusOK, object{"api": e.data})
+}
+
+func (a *API) replaceAPI(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.apis.get(id)
+	if !ok {
+		notFound(w, "API")
+		return
+	}
+
+	api, ok := r.decode(w, "api")
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"name", "summary", "description"} {
+		if v, ok := api[key].(string); ok {
+			e.data[key] = v
+		}
+	}
+	e.data["updatedBy"] = a.owner()
+	e.data["updatedAt"] = a.timestamp()
+
+	writeJSON(w, http.StatusOK, object{"api": e.data})
+}
+
+func (a *API) deleteAPI(w http.ResponseWriter, id string) {
+	e, ok := a.apis.get(id)
+	if !ok {
+		notFound(w, "API")
+		return
+	}
+
+	for _, v := range a.versions.children(e.id) {
+		a.removeVersion(v)
+	}
+	a.apis.remove(e.id)
+
+	writeJSON(w, http.StatusOK, object{"api": object{"id": e.id}})
+}
+
+// version finds a version of an API, writing a not found error when either
+// doesn't exist.
+func (a *API) version(w http.ResponseWriter, apiID, id string) (*entry, bool) {
+	api, ok := a.apis.get(apiID)
+	if !ok {
+		notFound(w, "API")
+		return nil, false
+	}
+
+	v, ok := a.versions.get(id)
+	if !ok || v.parent != api.id {
+		notFound(w, "version")
+		return nil, false
+	}
+
+	return v, true
+}
+
+func (a *API) listVersions(w http.ResponseWriter, apiID string) {
+	api, ok := a.apis.get(apiID)
+	if !ok {
+		notFound(w, "API")
+		return
+	}
+
+	items := []object{}
+	for _, v := range a.versions.children(api.id) {
+		items = append(items, v.data)
+	}
+
+	writeJSON(w, http.StatusOK, object{"versions": items})
+}
+
+func (a *API) getVersion(w http.ResponseWriter, apiID, id string) {
+	v, ok := a.version(w, apiID, id)
+	if !ok {
+		return
+	}
+
+	schemas := []string{}
+	for _, s := range a.schemas.children(v.id) {
+		schemas = append(schemas, s.id)
+	}
+
+	data := clone(v.data)
+	data["schema"] = schemas
+
+	writeJSON(w, http.StatusOK, object{"version": data})
+}
+
+func (a *API) createVersion(w http.ResponseWriter, r *request, apiID string) {
+	api, ok := a.apis.get(apiID)
+	if !ok {
+		notFound(w, "API")
+		return
+	}
+
+	version, ok := r.decode(w, "version")
+	if !ok {
+		return
+	}
+
+	if str(version, "name") == "" {
+		malformed(w, "version", "name is required.")
+		return
+	}
+
+	id := newID()
+	now := a.timestamp()
+	v := &entry{
+		id:     id,
+		parent: api.id,
+		data: object{
+			"id":            id,
+			"name":          str(version, "name"),
+			"transactionId": "",
+			"createdAt":     now,
+			"updatedAt":     now,
+			"api":           api.id,
+			"createdBy":     a.owner(),
+			"updatedBy":     a.owner(),
+			"lastRevision":  0,
+		},
+	}
+
+	a.versions.add(v)
+
+	writeJSON(w, http.StatusOK, object{"version": v.data})
+}
+
+func (a *API) replaceVersion(w http.ResponseWriter, r *request, apiID, id string) {
+	v, ok := a.version(w, apiID, id)
+	if !ok {
+		return
+	}
+
+	version, ok := r.decode(w, "version")
+	if !ok {
+		return
+	}
+
+	if name := str(version, "name"); name != "" {
+		v.data["name"] = name
+	}
+	v.data["updatedBy"] = a.owner()
+	v.data["updatedAt"] = a.timestamp()
+
+	writeJSON(w, http.StatusOK, object{"version": v.data})
+}
+
+func (a *API) deleteVersion(w http.ResponseWriter, apiID, id string) {
+	v, ok := a.version(w, apiID, id)
+	if !ok {
+		return
+	}
+
+	a.removeVersion(v)
+
+	writeJSON(w, http.StatusOK, object{"version": object{"id": v.id}})
+}
+
+func (a *API) removeVersion(v *entry) {
+	for _, s := range a.schemas.children(v.id) {
+		a.schemas.remove(s.id)
+	}
+	a.versions.remove(v.id)
+}
+
+func (a *API) schema(w http.ResponseWriter, apiID, versionID, id string) (*entry, bool) {
+	v, ok := a.version(w, apiID, versionID)
+	if !ok {
+		return nil, false
+	}
+
+	s, ok := a.schemas.get(id)
+	if !ok || s.parent != v.id {
+		notFound(w, "schema")
+		return nil, false
+	}
+
+	return s, true
+}
+
+func (a *API) getSchema(w http.ResponseWriter, apiID, versionID, id string) {
+	s, ok := a.schema(w, apiID, versionID, id)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"schema": s.data})
+}
+
+func (a *API) createSchema(w http.ResponseWriter, r *request, apiID, versionID string) {
+	v, ok := a.version(w, apiID, versionID)
+	if !ok {
+		return
+	}
+
+	schema, ok := r.decode(w, "schema")
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"type", "language"} {
+		if str(schema, key) == "" {
+			malformed(w, "schema", key+" is required.")
+			return
+		}
+	}
+
+	id := newID()
+	now := a.timestamp()
+	s := &entry{
+		id:     id,
+		parent: v.id,
+		data: object{
+			"id":         id,
+			"apiVersion": v.id,
+			"createdBy":  a.owner(),
+			"updatedBy":  a.owner(),
+			"type":       str(schema, "type"),
+			"language":   str(schema, "language"),
+			"createdAt":  now,
+			"updatedAt":  now,
+			"schema":     str(schema, "schema"),
+		},
+	}
+
+	a.schemas.add(s)
+
+	writeJSON(w, http.StatusOK, object{"schema": s.data})
+}
+
+func (a *API) replaceSchema(w http.ResponseWriter, r *request, apiID, versionID, id string) {
+	s, ok := a.schema(w, apiID, versionID, id)
+	if !ok {
+		return
+	}
+
+	schema, ok := r.decode(w, "schema")
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"type", "language", "schema"} {
+		if v, ok := schema[key].(string); ok {
+			s.data[key] = v
+		}
+	}
+	s.data["updatedBy"] = a.owner()
+	s.data["updatedAt"] = a.timestamp()
+
+	writeJSON(w, http.StatusOK, object{"schema": s.data})
+}
+
+func (a *API) deleteSchema(w http.ResponseWriter, apiID, versionID, id string) {
+	s, ok := a.schema(w, apiID, versionID, id)
+	if !ok {
+		return
+	}
+
+	a.schemas.remove(s.id)
+
+	writeJSON(w, http.StatusOK, object{"schema": object{"id": s.id}})
+}
+
+func (a *API) getRelations(w http.ResponseWriter, apiID, versionID string) {
+	v, ok := a.version(w, apiID, versionID)
+	if !ok {
+		return
+	}
+
+	relations := object{}
+	for kind, links := range v.relations {
+		relations[kind] = links
+	}
+
+	writeJSON(w, http.StatusOK, object{"relations": relations})
+}
+
+// relationKinds maps the relation types of an API version to the kind of
+// element they link.
+var relationKinds = map[string]string{
+	"documentation":   "collection",
+	"contracttest":    "collection",
+	"testsuite":       "collection",
+	"integrationtest": "collection",
+	"environment":     "environment",
+	"mock":            "mock",
+	"monitor":         "monitor",
+}
+
+func (a *API) createRelations(w http.ResponseWriter, r *request, apiID, versionID string) {
+	v, ok := a.version(w, apiID, versionID)
+	if !ok {
+		return
+	}
+
+	body, ok := r.body(w)
+	if !ok {
+		return
+	}
+
+	// Validate every element before linking any.
+	links := make(map[string][]object)
+	for kind, ids := range body {
+		element, ok := relationKinds[kind]
+		if !ok {
+			malformed(w, "relations", "unknown relation type "+kind+".")
+			return
+		}
+
+		list, _ := ids.([]interface{})
+		for _, v := range list {
+			id, _ := v.(string)
+			link, ok := a.link(element, id)
+			if !ok {
+				notFound(w, element)
+				return
+			}
+			links[kind] = append(links[kind], link)
+		}
+	}
+
+	if v.relations == nil {
+		v.relations = make(map[string]object)
+	}
+
+	result := object{}
+	for kind, list := range links {
+		if v.relations[kind] == nil {
+			v.relations[kind] = object{}
+		}
+
+		ids := []string{}
+		for _, link := range list {
+			v.relations[kind][str(link, "id")] = link
+			ids = append(ids, str(link, "id"))
+		}
+		result[kind] = ids
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// link describes an element linked to an API version.
+func (a *API) link(element, id string) (object, bool) {
+	stores := map[string]*store{
+		"collection":  a.collections,
+		"environment": a.environments,
+		"mock":        a.mocks,
+		"monitor":     a.monitors,
+	}
+
+	e, ok := stores[element].get(id)
+	if !ok {
+		return nil, false
+	}
+
+	name := str(e.data, "name")
+	if element == "collection" {
+		name = str(a.collectionRef(e), "name")
+	}
+
+	now := a.timestamp()
+	link := object{"id": e.id, "name": name, "createdAt": now, "updatedAt": now}
+	if element == "mock" {
+		link["url"] = str(e.data, "mockUrl")
+	}
+
+	return link, true
+}
@@ -0,0 +1,229 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+)
+
+func (a *API) collectionRef(e *entry) object {
+	info, _ := e.data["info"].(object)
+	return object{"id": e.id, "name": str(info, "name"), "uid": a.uid(e.id)}
+}
+
+func (a *API) listCollections(w http.ResponseWriter) {
+	items := []object{}
+	for _, e := range a.collections.list() {
+		item := a.collectionRef(e)
+		item["owner"] = a.owner()
+		if e.fork != nil {
+			item["fork"] = e.fork
+		}
+		items = append(items, item)
+	}
+
+	writeJSON(w, http.StatusOK, object{"collections": items})
+}
+
+func (a *API) getCollection(w http.ResponseWriter, id string) {
+	e, ok := a.collections.get(id)
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"collection": e.data})
+}
+
+// validCollection checks the members the Postman API requires and sets the
+// ID of the collection.
+func validCollection(w http.ResponseWriter, c object, id string) bool {
+	info, ok := c["info"].(object)
+	if !ok {
+		malformed(w, "collection", "info is required.")
+		return false
+	}
+
+	if str(info, "name") == "" {
+		malformed(w, "collection", "info.name is required.")
+		return false
+	}
+
+	info["_postman_id"] = id
+	if _, ok := c["item"]; !ok {
+		c["item"] = []interface{}{}
+	}
+
+	return true
+}
+
+func (a *API) createCollection(w http.ResponseWriter, r *request) {
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	c, ok := r.decode(w, "collection")
+	if !ok {
+		return
+	}
+
+	id := newID()
+	if !validCollection(w, c, id) {
+		return
+	}
+
+	e := &entry{id: id, data: c}
+	a.collections.add(e)
+	addToWorkspace(ws, "collections", a.collectionRef(e))
+
+	writeJSON(w, http.StatusOK, object{"collection": a.collectionRef(e)})
+}
+
+func (a *API) replaceCollection(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.collections.get(id)
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	c, ok := r.decode(w, "collection")
+	if !ok || !validCollection(w, c, e.id) {
+		return
+	}
+
+	e.data = c
+	ref := a.collectionRef(e)
+	a.renameInWorkspaces("collections", e.id, str(ref, "name"))
+
+	writeJSON(w, http.StatusOK, object{"collection": ref})
+}
+
+func (a *API) deleteCollection(w http.ResponseWriter, id string) {
+	e, ok := a.collections.get(id)
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	a.collections.remove(e.id)
+	a.removeFromWorkspaces("collections", e.id)
+
+	writeJSON(w, http.StatusOK, object{"collection": object{"id": e.id, "uid": a.uid(e.id)}})
+}
+
+func (a *API) forkCollection(w http.ResponseWriter, r *request, id string) {
+	if r.URL.Query().Get("workspace") == "" {
+		paramMissing(w, "workspace")
+		return
+	}
+
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	source, ok := a.collections.get(id)
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	body, ok := r.body(w)
+	if !ok {
+		return
+	}
+
+	label := str(body, "label")
+	if label == "" {
+		paramMissing(w, "label")
+		return
+	}
+
+	fork := &entry{
+		id:   newID(),
+		data: clone(source.data),
+		fork: object{
+			"label":     label,
+			"createdAt": a.timestamp(),
+			"from":      a.uid(source.id),
+		},
+	}
+	fork.data["info"].(object)["_postman_id"] = fork.id
+
+	a.collections.add(fork)
+	addToWorkspace(ws, "collections", a.collectionRef(fork))
+
+	ref := a.collectionRef(fork)
+	ref["fork"] = fork.fork
+	writeJSON(w, http.StatusOK, object{"collection": ref})
+}
+
+// Merge strategies of the Postman API.
+const (
+	mergeDeleteSource                = "deleteSource"
+	mergeUpdateSourceWithDestination = "updateSourceWithDestination"
+	defaultMergeStrategy             = mergeUpdateSourceWithDestination
+)
+
+func (a *API) mergeCollection(w http.ResponseWriter, r *request) {
+	body, ok := r.body(w)
+	if !ok {
+		return
+	}
+
+	for _, param := range []string{"source", "destination"} {
+		if str(body, param) == "" {
+			paramMissing(w, param)
+			return
+		}
+	}
+
+	strategy := str(body, "strategy")
+	switch strategy {
+	case "":
+		strategy = defaultMergeStrategy
+	case mergeDeleteSource, mergeUpdateSourceWithDestination:
+	default:
+		writeError(w, http.StatusBadRequest, "invalidParamError",
+			"strategy must be one of deleteSource, updateSourceWithDestination")
+		return
+	}
+
+	source, ok := a.collections.get(str(body, "source"))
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	destination, ok := a.collections.get(str(body, "destination"))
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	destination.data = clone(source.data)
+	destination.data["info"].(object)["_postman_id"] = destination.id
+	a.renameInWorkspaces("collections", destination.id, str(a.collectionRef(destination), "name"))
+
+	if strategy == mergeDeleteSource {
+		a.collections.remove(source.id)
+		a.removeFromWorkspaces("collections", source.id)
+	}
+
+	writeJSON(w, http.StatusOK, object{"collection": object{"id": destination.id, "uid": a.uid(destination.id)}})
+}
@@ -0,0 +1,120 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+)
+
+func (a *API) environmentRef(e *entry) object {
+	return object{"id": e.id, "name": str(e.data, "name"), "uid": a.uid(e.id)}
+}
+
+func (a *API) listEnvironments(w http.ResponseWriter) {
+	items := []object{}
+	for _, e := range a.environments.list() {
+		item := a.environmentRef(e)
+		item["owner"] = a.owner()
+		items = append(items, item)
+	}
+
+	writeJSON(w, http.StatusOK, object{"environments": items})
+}
+
+func (a *API) getEnvironment(w http.ResponseWriter, id string) {
+	e, ok := a.environments.get(id)
+	if !ok {
+		notFound(w, "environment")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"environment": e.data})
+}
+
+func (a *API) createEnvironment(w http.ResponseWriter, r *request) {
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	env, ok := r.decode(w, "environment")
+	if !ok {
+		return
+	}
+
+	if str(env, "name") == "" {
+		malformed(w, "environment", "name is required.")
+		return
+	}
+
+	values, _ := env["values"].([]interface{})
+	if values == nil {
+		values = []interface{}{}
+	}
+
+	id := newID()
+	e := &entry{
+		id: id,
+		data: object{
+			"id":     id,
+			"name":   str(env, "name"),
+			"values": values,
+		},
+	}
+
+	a.environments.add(e)
+	addToWorkspace(ws, "environments", a.environmentRef(e))
+
+	writeJSON(w, http.StatusOK, object{"environment": a.environmentRef(e)})
+}
+
+func (a *API) replaceEnvironment(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.environments.get(id)
+	if !ok {
+		notFound(w, "environment")
+		return
+	}
+
+	env, ok := r.decode(w, "environment")
+	if !ok {
+		return
+	}
+
+	if name := str(env, "name"); name != "" {
+		e.data["name"] = name
+		a.renameInWorkspaces("environments", e.id, name)
+	}
+
+	if values, ok := env["values"].([]interface{}); ok {
+		e.data["values"] = values
+	}
+
+	writeJSON(w, http.StatusOK, object{"environment": a.environmentRef(e)})
+}
+
+func (a *API) deleteEnvironment(w http.ResponseWriter, id string) {
+	e, ok := a.environments.get(id)
+	if !ok {
+		notFound(w, "environment")
+		return
+	}
+
+	a.environments.remove(e.id)
+	a.removeFromWorkspaces("environments", e.id)
+
+	writeJSON(w, http.StatusOK, object{"environment": object{"id": e.id, "uid": a.uid(e.id)}})
+}
@@ -0,0 +1,331 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory fake of the Postman API, so that code
+// built on sdk.Service can be tested without a network.
+//
+//	api := fake.NewServer()
+//	defer api.Close()
+//
+//	service := api.Service()
+//	result, err := service.CreateCollectionFromReader(ctx, r, "")
+//	if err != nil {
+//		// ...
+//	}
+//	c, err := service.Collection(ctx, result.PreferredID())
+//
+// The fake keeps collections, environments, mocks, monitors, workspaces,
+// APIs, API versions, schemas and relations in memory, and answers with the
+// response and error bodies of the Postman API.
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/client"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// Defaults for a new API.
+const (
+	DefaultUserID    = 1234567
+	DefaultRateLimit = 300
+)
+
+// API is an http.Handler that emulates the Postman API.
+type API struct {
+	// APIKey is the only key accepted in the X-API-Key header. Any key is
+	// accepted when it's empty.
+	APIKey string
+
+	// UserID is the ID of the user owning the resources.
+	UserID int
+
+	// RateLimit is the number of requests allowed per minute. Requests over
+	// the limit get a 429 response.
+	RateLimit int
+
+	// Now returns the current time. It's used for timestamps and rate
+	// limiting.
+	Now func() time.Time
+
+	mu          sync.Mutex
+	window      time.Time
+	requests    int
+	failures    []*resources.ErrorResponse
+	failureCode []int
+
+	collections  *store
+	environments *store
+	mocks        *store
+	monitors     *store
+	workspaces   *store
+	apis         *store
+	versions     *store
+	schemas      *store
+	runs         map[string]*resources.MonitorRun
+
+	// DefaultWorkspace is the ID of the personal workspace that resources
+	// are added to when no workspace is given.
+	DefaultWorkspace string
+}
+
+// New creates an empty fake API with a personal workspace.
+func New() *API {
+	a := &API{
+		UserID:       DefaultUserID,
+		RateLimit:    DefaultRateLimit,
+		Now:          time.Now,
+		collections:  newStore(),
+		environments: newStore(),
+		mocks:        newStore(),
+		monitors:     newStore(),
+		workspaces:   newStore(),
+		apis:         newStore(),
+		versions:     newStore(),
+		schemas:      newStore(),
+		runs:         make(map[string]*resources.MonitorRun),
+	}
+
+	a.DefaultWorkspace = newID()
+	a.workspaces.add(&entry{
+		id:   a.DefaultWorkspace,
+		data: newWorkspace(a.DefaultWorkspace, "My Workspace", "personal", ""),
+	})
+
+	return a
+}
+
+// FailNext makes the next request fail with the given status code and
+// Postman error.
+func (a *API) FailNext(code int, name, message string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.failureCode = append(a.failureCode, code)
+	a.failures = append(a.failures, &resources.ErrorResponse{
+		Error: resources.Error{Name: name, Message: message},
+	})
+}
+
+// SetMonitorRun sets the result returned when running a monitor. By default
+// a run succeeds without executing any requests.
+func (a *API) SetMonitorRun(id string, run *resources.MonitorRun) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	e, ok := a.monitors.get(id)
+	if !ok {
+		return fmt.Errorf("monitor %s not found", id)
+	}
+
+	a.runs[e.id] = run
+	return nil
+}
+
+// ServeHTTP answers a Postman API request.
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if !a.limit(w) {
+		return
+	}
+
+	if a.APIKey != "" {
+		switch key := r.Header.Get("X-API-Key"); {
+		case key == "":
+			writeError(w, http.StatusUnauthorized, "AuthenticationError",
+				"API Key missing. Every request requires an API Key to be sent.")
+			return
+		case key != a.APIKey:
+			writeError(w, http.StatusUnauthorized, "AuthenticationError",
+				"Invalid API Key. Every request requires a valid API Key to be sent.")
+			return
+		}
+	}
+
+	if len(a.failures) > 0 {
+		code, failure := a.failureCode[0], a.failures[0]
+		a.failureCode, a.failures = a.failureCode[1:], a.failures[1:]
+		writeJSON(w, code, failure)
+		return
+	}
+
+	var path []string
+	for _, s := range strings.Split(strings.Trim(r.URL.Path, "/"), "/") {
+		if unescaped, err := url.PathUnescape(s); err == nil {
+			s = unescaped
+		}
+		path = append(path, s)
+	}
+
+	req := &request{Request: r, path: path}
+	if !a.route(w, req) {
+		writeError(w, http.StatusNotFound, "notFound", "Requested resource not found")
+	}
+}
+
+// limit sets the rate limit headers and reports whether the request is
+// within the limit.
+func (a *API) limit(w http.ResponseWriter) bool {
+	now := a.Now()
+	if now.Sub(a.window) >= time.Minute {
+		a.window = now.Truncate(time.Minute)
+		a.requests = 0
+	}
+
+	reset := a.window.Add(time.Minute).Unix()
+	a.requests++
+
+	remaining := a.RateLimit - a.requests
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(a.RateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+
+	if a.requests > a.RateLimit {
+		w.Header().Set("Retry-After", strconv.FormatInt(reset-now.Unix(), 10))
+		writeError(w, http.StatusTooManyRequests, "rateLimited",
+			fmt.Sprintf("Rate limit exceeded. Please retry after %d", reset))
+		return false
+	}
+
+	return true
+}
+
+// Server is a fake API listening on a local HTTP server.
+type Server struct {
+	*httptest.Server
+	API *API
+}
+
+// NewServer starts a fake API. The caller should call Close when finished.
+func NewServer() *Server {
+	api := New()
+	return &Server{
+		Server: httptest.NewServer(api),
+		API:    api,
+	}
+}
+
+// Service returns a service that talks to the fake API.
+func (s *Server) Service() *sdk.Service {
+	u, _ := url.Parse(s.URL)
+	return sdk.NewService(client.NewOptions(u, s.API.APIKey, s.Client()))
+}
+
+type object = map[string]interface{}
+
+type request struct {
+	*http.Request
+	path []string
+}
+
+// match reports whether the request has the given method and a path of the
+// given pattern, where "*" stands for any segment.
+func (r *request) match(method string, pattern ...string) bool {
+	if r.Method != method || len(r.path) != len(pattern) {
+		return false
+	}
+
+	for i, p := range pattern {
+		if p != "*" && p != r.path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// body reads a request body holding a JSON object.
+func (r *request) body(w http.ResponseWriter) (object, bool) {
+	var v object
+	if err := json.NewDecoder(r.Body).Decode(&v); err != nil || v == nil {
+		writeError(w, http.StatusBadRequest, "malformedRequestError", "The request body is not valid JSON.")
+		return nil, false
+	}
+
+	return v, true
+}
+
+// decode reads the resource wrapped in a request body, such as the
+// collection of {"collection": {...}}.
+func (r *request) decode(w http.ResponseWriter, key string) (object, bool) {
+	body, ok := r.body(w)
+	if !ok {
+		return nil, false
+	}
+
+	v, ok := body[key].(object)
+	if !ok {
+		malformed(w, key, key+" is required.")
+	}
+
+	return v, ok
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		code = http.StatusInternalServerError
+		b = []byte(`{"error":{"name":"serverError","message":"Something went wrong."}}`)
+	}
+
+	w.WriteHeader(code)
+	_, _ = w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, code int, name, message string) {
+	writeJSON(w, code, resources.ErrorResponse{
+		Error: resources.Error{Name: name, Message: message},
+	})
+}
+
+func notFound(w http.ResponseWriter, kind string) {
+	writeError(w, http.StatusNotFound, "instanceNotFoundError",
+		fmt.Sprintf("We could not find the %s you are looking for", kind))
+}
+
+func paramMissing(w http.ResponseWriter, name string) {
+	writeError(w, http.StatusBadRequest, "paramMissingError",
+		fmt.Sprintf("Parameter, %s is missing in the request.", name))
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,440 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/client"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/fake"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+const collectionJSON = `{
+	"info": {
+		"name": "Echo",
+		"schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+	},
+	"item": [{"name": "Get", "request": "https://postman-echo.com/get"}]
+}`
+
+func requestError(t *testing.T, err error, code int, name string) {
+	t.Helper()
+
+	var e *client.RequestError
+	if !errors.As(err, &e) {
+		t.Fatalf("have error %v, want a request error", err)
+	}
+
+	if e.StatusCode != code || e.Name != name {
+		t.Errorf("have error %d %s, want %d %s", e.StatusCode, e.Name, code, name)
+	}
+}
+
+func TestCollections(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+
+	created, err := service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := created.PreferredID()
+
+	c, err := service.Collection(ctx, uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Info.Name != "Echo" || len(c.Items.Requests()) != 1 {
+		t.Errorf("have collection %s with %d requests, want Echo with 1", c.Info.Name, len(c.Items.Requests()))
+	}
+
+	if _, err := service.ReplaceCollectionFromReader(ctx, strings.NewReader(strings.Replace(collectionJSON, "Echo", "Echo v2", 1)), uid); err != nil {
+		t.Fatal(err)
+	}
+
+	ws, err := service.Workspace(ctx, api.API.DefaultWorkspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ws.Collections) != 1 || ws.Collections[0].UID != uid || ws.Collections[0].Name != "Echo v2" {
+		t.Errorf("have workspace collections %+v, want %s", ws.Collections, uid)
+	}
+
+	created, err = service.ForkCollection(ctx, uid, api.API.DefaultWorkspace, "feature")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forkUID := created.PreferredID()
+
+	list, err := service.Collections(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*list) != 2 || (*list)[1].Fork == nil || (*list)[1].Fork.From != uid {
+		t.Fatalf("have collections %+v, want the original and a fork", *list)
+	}
+
+	if _, err := service.MergeCollection(ctx, forkUID, uid, "deleteSource"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Collection(ctx, forkUID); err == nil {
+		t.Error("have the fork after merging with deleteSource, want it deleted")
+	}
+
+	if _, err := service.DeleteCollection(ctx, uid); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.Collection(ctx, uid)
+	requestError(t, err, http.StatusNotFound, "instanceNotFoundError")
+}
+
+func TestCreateErrors(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+
+	_, err := service.CreateCollectionFromReader(ctx, strings.NewReader(`{"info": {}}`), "")
+	if _, ok := err.(*resources.ValidationError); !ok {
+		t.Errorf("have error %v, want a validation error", err)
+	}
+
+	_, err = service.CreateCollection(ctx, &resources.Collection{Collection: &gen.Collection{Info: &gen.Info{}}}, "")
+	if _, ok := err.(*resources.ValidationError); !ok {
+		t.Errorf("have error %v, want a validation error", err)
+	}
+
+	_, err = service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), "missing")
+	requestError(t, err, http.StatusNotFound, "instanceNotFoundError")
+
+	_, err = service.CreateMockFromReader(ctx, strings.NewReader(`{"name": "mock"}`), "")
+	requestError(t, err, http.StatusBadRequest, "paramMissingError")
+
+	_, err = service.ForkCollection(ctx, "missing", "", "label")
+	requestError(t, err, http.StatusBadRequest, "paramMissingError")
+}
+
+// resultID returns a function giving the ID of a created resource, which
+// fails t when the resource couldn't be created.
+func resultID(t *testing.T) func(*resources.Result, error) string {
+	return func(result *resources.Result, err error) string {
+		t.Helper()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return result.PreferredID()
+	}
+}
+
+func TestEnvironments(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	env := id(service.CreateEnvironmentFromReader(ctx, strings.NewReader(`{"name": "Local", "values": [{"key": "host", "value": "localhost", "enabled": true}]}`), ""))
+
+	e, err := service.Environment(ctx, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Name != "Local" || len(e.Values) != 1 || e.Values[0].Value != "localhost" {
+		t.Errorf("have environment %+v, want Local with host", e)
+	}
+}
+
+func TestMocks(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	collection := id(service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), ""))
+	env := id(service.CreateEnvironmentFromReader(ctx, strings.NewReader(`{"name": "Local", "values": []}`), ""))
+	mockUID := id(service.CreateMockFromReader(ctx, strings.NewReader(`{"name": "Echo mock", "collection": "`+collection+`", "environment": "`+env+`"}`), ""))
+
+	m, err := service.Mock(ctx, mockUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Collection != collection || m.Environment != env || !m.Config.MatchWildcards || m.MockURL == "" {
+		t.Errorf("have mock %+v, want one for %s and %s", m, collection, env)
+	}
+
+	_, err = service.DeleteMock(ctx, "missing")
+	requestError(t, err, http.StatusNotFound, "instanceNotFoundError")
+}
+
+func TestMonitors(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	collection := id(service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), ""))
+	monitorUID := id(service.CreateMonitorFromReader(ctx, strings.NewReader(`{"name": "Nightly", "collection": "`+collection+`", "schedule": {"cron": "0 0 * * *"}}`), ""))
+
+	run, err := service.RunMonitor(ctx, monitorUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if run.Info.Status != "success" || run.Info.CollectionUID != collection {
+		t.Errorf("have run %+v, want a successful run of %s", run.Info, collection)
+	}
+
+	if _, err := service.DeleteMonitor(ctx, monitorUID); err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, err := service.Monitors(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*monitors) != 0 {
+		t.Errorf("have monitors %+v, want none", *monitors)
+	}
+}
+
+func TestSetMonitorRun(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	collection := id(service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), ""))
+	monitorUID := id(service.CreateMonitorFromReader(ctx, strings.NewReader(`{"name": "Nightly", "collection": "`+collection+`", "schedule": {"cron": "0 0 * * *"}}`), ""))
+
+	monitor, err := service.Monitor(ctx, monitorUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failed := &resources.MonitorRun{
+		Info:  resources.MonitorRunInfo{MonitorID: monitor.ID, Status: "failed"},
+		Stats: resources.MonitorRunStats{Assertions: resources.MonitorRunCount{Total: 2, Failed: 1}},
+	}
+	if err := api.API.SetMonitorRun(monitorUID, failed); err != nil {
+		t.Fatal(err)
+	}
+
+	run, err := service.RunMonitor(ctx, monitorUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !run.Failed() || run.Stats.Assertions.Failed != 1 {
+		t.Errorf("have run %+v, want the failed run", run)
+	}
+}
+
+func TestAPIs(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	workspace := id(service.CreateWorkspaceFromReader(ctx, strings.NewReader(`{"name": "Team", "type": "team"}`), ""))
+	apiID := id(service.CreateAPIFromReader(ctx, strings.NewReader(`{"name": "Petstore"}`), workspace))
+	versionID := id(service.CreateAPIVersionFromReader(ctx, strings.NewReader(`{"name": "1.0.0"}`), "", apiID))
+
+	apis, err := service.APIs(ctx, workspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*apis) != 1 || (*apis)[0].Name != "Petstore" {
+		t.Errorf("have APIs %+v, want Petstore", *apis)
+	}
+
+	if _, err := service.DeleteAPI(ctx, apiID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.APIVersion(ctx, apiID, versionID)
+	requestError(t, err, http.StatusNotFound, "instanceNotFoundError")
+}
+
+func TestAPISchemas(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	apiID := id(service.CreateAPIFromReader(ctx, strings.NewReader(`{"name": "Petstore"}`), ""))
+	versionID := id(service.CreateAPIVersionFromReader(ctx, strings.NewReader(`{"name": "1.0.0"}`), "", apiID))
+	schemaID := id(service.CreateSchemaFromReader(ctx, strings.NewReader(`{"type": "openapi3", "language": "yaml", "schema": "openapi: 3.0.0"}`), "", apiID, versionID))
+
+	version, err := service.APIVersion(ctx, apiID, versionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(version.Schema) != 1 || version.Schema[0] != schemaID {
+		t.Errorf("have version schemas %v, want %s", version.Schema, schemaID)
+	}
+
+	if _, err := service.ReplaceSchemaFromReader(ctx, strings.NewReader(`{"schema": "openapi: 3.0.3"}`), schemaID, apiID, versionID); err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := service.Schema(ctx, apiID, versionID, schemaID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Schema != "openapi: 3.0.3" || schema.Type != "openapi3" {
+		t.Errorf("have schema %+v, want the replaced openapi3 schema", schema)
+	}
+}
+
+func TestAPIRelations(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	ctx := context.Background()
+	service := api.Service()
+	id := resultID(t)
+
+	apiID := id(service.CreateAPIFromReader(ctx, strings.NewReader(`{"name": "Petstore"}`), ""))
+	versionID := id(service.CreateAPIVersionFromReader(ctx, strings.NewReader(`{"name": "1.0.0"}`), "", apiID))
+	collection := id(service.CreateCollectionFromReader(ctx, strings.NewReader(collectionJSON), ""))
+
+	res, err := http.Post(api.URL+"/apis/"+apiID+"/versions/"+versionID+"/relations", "application/json",
+		strings.NewReader(`{"contracttest": ["`+collection+`"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	relations, err := service.FormattedAPIRelationItems(ctx, apiID, versionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*relations) != 1 || (*relations)[0].Name != "Echo" || (*relations)[0].Type != "contracttest" {
+		t.Errorf("have relations %+v, want the Echo contract test", *relations)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	api.API.APIKey = "PMAK-secret"
+
+	user, err := api.Service().User(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.ID != "1234567" {
+		t.Errorf("have user %s, want 1234567", user.ID)
+	}
+
+	res, err := http.Get(api.URL + "/me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("have status %d without an API key, want 401", res.StatusCode)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	now := time.Date(2020, 6, 1, 12, 0, 30, 0, time.UTC)
+	api.API.Now = func() time.Time { return now }
+	api.API.RateLimit = 2
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		res, err := http.Get(api.URL + "/me")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+
+		if res.StatusCode != want {
+			t.Errorf("request %d: have status %d, want %d", i, res.StatusCode, want)
+		}
+
+		if have, want := res.Header.Get("X-RateLimit-Remaining"), []string{"1", "0", "0"}[i]; have != want {
+			t.Errorf("request %d: have X-RateLimit-Remaining %s, want %s", i, have, want)
+		}
+
+		if have := res.Header.Get("X-RateLimit-Reset"); have != "1591012860" {
+			t.Errorf("request %d: have X-RateLimit-Reset %s, want the start of the next minute", i, have)
+		}
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := api.Service().User(context.Background()); err != nil {
+		t.Errorf("have error %s in the next minute, want none", err)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	api := fake.NewServer()
+	defer api.Close()
+
+	api.API.FailNext(http.StatusInternalServerError, "serverError", "Something went wrong.")
+
+	service := api.Service()
+	_, err := service.Collections(context.Background())
+	requestError(t, err, http.StatusInternalServerError, "serverError")
+
+	if _, err := service.Collections(context.Background()); err != nil {
+		t.Errorf("have error %s after the injected failure, want none", err)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+)
+
+func (a *API) listMocks(w http.ResponseWriter) {
+	items := []object{}
+	for _, e := range a.mocks.list() {
+		items = append(items, e.data)
+	}
+
+	writeJSON(w, http.StatusOK, object{"mocks": items})
+}
+
+func (a *API) getMock(w http.ResponseWriter, id string) {
+	e, ok := a.mocks.get(id)
+	if !ok {
+		notFound(w, "mock")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"mock": e.data})
+}
+
+// environmentUID resolves the optional environment of a mock or monitor.
+func (a *API) environmentUID(w http.ResponseWriter, id string) (string, bool) {
+	if id == "" {
+		return "", true
+	}
+
+	e, ok := a.environments.get(id)
+	if !ok {
+		notFound(w, "environment")
+		return "", false
+	}
+
+	return a.uid(e.id), true
+}
+
+func (a *API) createMock(w http.ResponseWriter, r *request) {
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	m, ok := r.decode(w, "mock")
+	if !ok {
+		return
+	}
+
+	if str(m, "collection") == "" {
+		paramMissing(w, "collection")
+		return
+	}
+
+	c, ok := a.collections.get(str(m, "collection"))
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	env, ok := a.environmentUID(w, str(m, "environment"))
+	if !ok {
+		return
+	}
+
+	private, _ := m["private"].(bool)
+
+	id := newID()
+	e := &entry{
+		id: id,
+		data: object{
+			"id":         id,
+			"owner":      a.owner(),
+			"uid":        a.uid(id),
+			"collection": a.uid(c.id),
+			"mockUrl":    "https://" + id + ".mock.pstmn.io",
+			"name":       str(m, "name"),
+			"config": object{
+				"headers":          []interface{}{},
+				"matchBody":        false,
+				"matchQueryParams": true,
+				"matchWildcards":   true,
+			},
+			"environment": env,
+			"private":     private,
+		},
+	}
+
+	a.mocks.add(e)
+	addToWorkspace(ws, "mocks", object{"id": id})
+
+	writeJSON(w, http.StatusOK, object{"mock": e.data})
+}
+
+func (a *API) replaceMock(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.mocks.get(id)
+	if !ok {
+		notFound(w, "mock")
+		return
+	}
+
+	m, ok := r.decode(w, "mock")
+	if !ok {
+		return
+	}
+
+	if _, ok := m["environment"]; ok {
+		env, ok := a.environmentUID(w, str(m, "environment"))
+		if !ok {
+			return
+		}
+		e.data["environment"] = env
+	}
+
+	if name, ok := m["name"].(string); ok {
+		e.data["name"] = name
+	}
+
+	if private, ok := m["private"].(bool); ok {
+		e.data["private"] = private
+	}
+
+	writeJSON(w, http.StatusOK, object{"mock": e.data})
+}
+
+func (a *API) deleteMock(w http.ResponseWriter, id string) {
+	e, ok := a.mocks.get(id)
+	if !ok {
+		notFound(w, "mock")
+		return
+	}
+
+	a.mocks.remove(e.id)
+	a.removeFromWorkspaces("mocks", e.id)
+
+	writeJSON(w, http.StatusOK, object{"mock": object{"id": e.id, "uid": a.uid(e.id)}})
+}
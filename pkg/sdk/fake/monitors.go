@@ -0,0 +1,214 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func (a *API) monitorRef(e *entry) object {
+	return object{"id": e.id, "name": str(e.data, "name"), "uid": a.uid(e.id)}
+}
+
+func (a *API) listMonitors(w http.ResponseWriter) {
+	items := []object{}
+	for _, e := range a.monitors.list() {
+		item := a.monitorRef(e)
+		item["owner"] = a.UserID
+		items = append(items, item)
+	}
+
+	writeJSON(w, http.StatusOK, object{"monitors": items})
+}
+
+func (a *API) getMonitor(w http.ResponseWriter, id string) {
+	e, ok := a.monitors.get(id)
+	if !ok {
+		notFound(w, "monitor")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"monitor": e.data})
+}
+
+// schedule validates the schedule of a monitor. The fake doesn't evaluate
+// cron expressions, so the next run is always the start of the next minute.
+func (a *API) schedule(w http.ResponseWriter, m object) (object, bool) {
+	s, _ := m["schedule"].(object)
+	if str(s, "cron") == "" {
+		malformed(w, "monitor", "schedule.cron is required.")
+		return nil, false
+	}
+
+	timezone := str(s, "timezone")
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	return object{
+		"cron":     str(s, "cron"),
+		"timezone": timezone,
+		"nextRun":  a.Now().UTC().Truncate(time.Minute).Add(time.Minute).Format("2006-01-02T15:04:05.000Z"),
+	}, true
+}
+
+func (a *API) createMonitor(w http.ResponseWriter, r *request) {
+	ws, ok := a.workspaceFor(w, r)
+	if !ok {
+		return
+	}
+
+	m, ok := r.decode(w, "monitor")
+	if !ok {
+		return
+	}
+
+	if str(m, "name") == "" {
+		malformed(w, "monitor", "name is required.")
+		return
+	}
+
+	if str(m, "collection") == "" {
+		paramMissing(w, "collection")
+		return
+	}
+
+	schedule, ok := a.schedule(w, m)
+	if !ok {
+		return
+	}
+
+	c, ok := a.collections.get(str(m, "collection"))
+	if !ok {
+		notFound(w, "collection")
+		return
+	}
+
+	env, ok := a.environmentUID(w, str(m, "environment"))
+	if !ok {
+		return
+	}
+
+	id := newID()
+	e := &entry{
+		id: id,
+		data: object{
+			"id":             id,
+			"name":           str(m, "name"),
+			"uid":            a.uid(id),
+			"owner":          a.UserID,
+			"collectionUid":  a.uid(c.id),
+			"environmentUid": env,
+			"options": object{
+				"strictSSL":       true,
+				"followRedirects": true,
+				"requestTimeout":  nil,
+				"requestDelay":    0,
+			},
+			"notifications": object{
+				"onError":   []interface{}{},
+				"onFailure": []interface{}{},
+			},
+			"distribution": []interface{}{},
+			"schedule":     schedule,
+		},
+	}
+
+	a.monitors.add(e)
+	addToWorkspace(ws, "monitors", object{"id": id})
+
+	writeJSON(w, http.StatusOK, object{"monitor": a.monitorRef(e)})
+}
+
+func (a *API) replaceMonitor(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.monitors.get(id)
+	if !ok {
+		notFound(w, "monitor")
+		return
+	}
+
+	m, ok := r.decode(w, "monitor")
+	if !ok {
+		return
+	}
+
+	if _, ok := m["schedule"]; ok {
+		schedule, ok := a.schedule(w, m)
+		if !ok {
+			return
+		}
+		e.data["schedule"] = schedule
+	}
+
+	if name := str(m, "name"); name != "" {
+		e.data["name"] = name
+	}
+
+	writeJSON(w, http.StatusOK, object{"monitor": a.monitorRef(e)})
+}
+
+func (a *API) deleteMonitor(w http.ResponseWriter, id string) {
+	e, ok := a.monitors.get(id)
+	if !ok {
+		notFound(w, "monitor")
+		return
+	}
+
+	a.monitors.remove(e.id)
+	a.removeFromWorkspaces("monitors", e.id)
+	delete(a.runs, e.id)
+
+	writeJSON(w, http.StatusOK, object{"monitor": object{"id": e.id, "uid": a.uid(e.id)}})
+}
+
+func (a *API) runMonitor(w http.ResponseWriter, id string) {
+	e, ok := a.monitors.get(id)
+	if !ok {
+		notFound(w, "monitor")
+		return
+	}
+
+	if run, ok := a.runs[e.id]; ok {
+		writeJSON(w, http.StatusOK, resources.MonitorRunResponse{Run: *run})
+		return
+	}
+
+	now := a.timestamp()
+	writeJSON(w, http.StatusOK, object{
+		"run": object{
+			"info": object{
+				"jobId":          newID(),
+				"monitorId":      e.id,
+				"name":           str(e.data, "name"),
+				"collectionUid":  str(e.data, "collectionUid"),
+				"environmentUid": str(e.data, "environmentUid"),
+				"status":         "success",
+				"startedAt":      now,
+				"finishedAt":     now,
+			},
+			"stats": object{
+				"assertions": object{"total": 0, "failed": 0},
+				"requests":   object{"total": 0, "failed": 0},
+			},
+			"executions": []interface{}{},
+			"failures":   []interface{}{},
+		},
+	})
+}
@@ -0,0 +1,295 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// route dispatches a request to the handlers of its resource. It reports
+// false when no route matches.
+func (a *API) route(w http.ResponseWriter, r *request) bool {
+	if len(r.path) == 0 {
+		return false
+	}
+
+	switch r.path[0] {
+	case "me":
+		if !r.match("GET", "me") {
+			return false
+		}
+		writeJSON(w, http.StatusOK, object{"user": object{"id": a.UserID}})
+		return true
+	case "collections":
+		return a.routeCollections(w, r)
+	case "environments":
+		return a.routeEnvironments(w, r)
+	case "mocks":
+		return a.routeMocks(w, r)
+	case "monitors":
+		return a.routeMonitors(w, r)
+	case "workspaces":
+		return a.routeWorkspaces(w, r)
+	case "apis":
+		if len(r.path) > 2 {
+			return a.routeVersions(w, r)
+		}
+		return a.routeAPIs(w, r)
+	}
+
+	return false
+}
+
+func (a *API) routeCollections(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "collections"):
+		a.listCollections(w)
+	case r.match("POST", "collections"):
+		a.createCollection(w, r)
+	case r.match("POST", "collections", "fork", "*"):
+		a.forkCollection(w, r, r.path[2])
+	case r.match("POST", "collections", "merge"):
+		a.mergeCollection(w, r)
+	case r.match("GET", "collections", "*"):
+		a.getCollection(w, r.path[1])
+	case r.match("PUT", "collections", "*"):
+		a.replaceCollection(w, r, r.path[1])
+	case r.match("DELETE", "collections", "*"):
+		a.deleteCollection(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) routeEnvironments(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "environments"):
+		a.listEnvironments(w)
+	case r.match("POST", "environments"):
+		a.createEnvironment(w, r)
+	case r.match("GET", "environments", "*"):
+		a.getEnvironment(w, r.path[1])
+	case r.match("PUT", "environments", "*"):
+		a.replaceEnvironment(w, r, r.path[1])
+	case r.match("DELETE", "environments", "*"):
+		a.deleteEnvironment(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) routeMocks(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "mocks"):
+		a.listMocks(w)
+	case r.match("POST", "mocks"):
+		a.createMock(w, r)
+	case r.match("GET", "mocks", "*"):
+		a.getMock(w, r.path[1])
+	case r.match("PUT", "mocks", "*"):
+		a.replaceMock(w, r, r.path[1])
+	case r.match("DELETE", "mocks", "*"):
+		a.deleteMock(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) routeMonitors(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "monitors"):
+		a.listMonitors(w)
+	case r.match("POST", "monitors"):
+		a.createMonitor(w, r)
+	case r.match("GET", "monitors", "*"):
+		a.getMonitor(w, r.path[1])
+	case r.match("PUT", "monitors", "*"):
+		a.replaceMonitor(w, r, r.path[1])
+	case r.match("DELETE", "monitors", "*"):
+		a.deleteMonitor(w, r.path[1])
+	case r.match("POST", "monitors", "*", "run"):
+		a.runMonitor(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) routeWorkspaces(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "workspaces"):
+		a.listWorkspaces(w)
+	case r.match("POST", "workspaces"):
+		a.createWorkspace(w, r)
+	case r.match("GET", "workspaces", "*"):
+		a.getWorkspace(w, r.path[1])
+	case r.match("PUT", "workspaces", "*"):
+		a.replaceWorkspace(w, r, r.path[1])
+	case r.match("DELETE", "workspaces", "*"):
+		a.deleteWorkspace(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) routeAPIs(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "apis"):
+		a.listAPIs(w, r)
+	case r.match("POST", "apis"):
+		a.createAPI(w, r)
+	case r.match("GET", "apis", "*"):
+		a.getAPI(w, r.path[1])
+	case r.match("PUT", "apis", "*"):
+		a.replaceAPI(w, r, r.path[1])
+	case r.match("DELETE", "apis", "*"):
+		a.deleteAPI(w, r.path[1])
+	default:
+		return false
+	}
+
+	return true
+}
+
+// routeVersions dispatches the requests for the versions of an API, along
+// with their schemas and relations.
+func (a *API) routeVersions(w http.ResponseWriter, r *request) bool {
+	switch {
+	case r.match("GET", "apis", "*", "versions"):
+		a.listVersions(w, r.path[1])
+	case r.match("POST", "apis", "*", "versions"):
+		a.createVersion(w, r, r.path[1])
+	case r.match("GET", "apis", "*", "versions", "*"):
+		a.getVersion(w, r.path[1], r.path[3])
+	case r.match("PUT", "apis", "*", "versions", "*"):
+		a.replaceVersion(w, r, r.path[1], r.path[3])
+	case r.match("DELETE", "apis", "*", "versions", "*"):
+		a.deleteVersion(w, r.path[1], r.path[3])
+
+	case r.match("POST", "apis", "*", "versions", "*", "schemas"):
+		a.createSchema(w, r, r.path[1], r.path[3])
+	case r.match("GET", "apis", "*", "versions", "*", "schemas", "*"):
+		a.getSchema(w, r.path[1], r.path[3], r.path[5])
+	case r.match("PUT", "apis", "*", "versions", "*", "schemas", "*"):
+		a.replaceSchema(w, r, r.path[1], r.path[3], r.path[5])
+	case r.match("DELETE", "apis", "*", "versions", "*", "schemas", "*"):
+		a.deleteSchema(w, r.path[1], r.path[3], r.path[5])
+
+	case r.match("GET", "apis", "*", "versions", "*", "relations"):
+		a.getRelations(w, r.path[1], r.path[3])
+	case r.match("POST", "apis", "*", "versions", "*", "relations"):
+		a.createRelations(w, r, r.path[1], r.path[3])
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (a *API) uid(id string) string {
+	return fmt.Sprintf("%d-%s", a.UserID, id)
+}
+
+func (a *API) owner() string {
+	return strconv.Itoa(a.UserID)
+}
+
+func (a *API) timestamp() string {
+	return a.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// workspaceFor returns the workspace given in the query of a create request,
+// or the default workspace.
+func (a *API) workspaceFor(w http.ResponseWriter, r *request) (*entry, bool) {
+	id := r.URL.Query().Get("workspace")
+	if id == "" {
+		id = a.DefaultWorkspace
+	}
+
+	ws, ok := a.workspaces.get(id)
+	if !ok {
+		notFound(w, "workspace")
+	}
+
+	return ws, ok
+}
+
+// addToWorkspace lists a resource in a workspace. member is the workspace
+// property listing resources of its type, such as "collections".
+func addToWorkspace(ws *entry, member string, ref object) {
+	refs, _ := ws.data[member].([]interface{})
+	ws.data[member] = append(refs, ref)
+}
+
+func (a *API) removeFromWorkspaces(member, id string) {
+	for _, ws := range a.workspaces.list() {
+		refs, _ := ws.data[member].([]interface{})
+
+		kept := make([]interface{}, 0, len(refs))
+		for _, ref := range refs {
+			if o, ok := ref.(object); !ok || o["id"] != id {
+				kept = append(kept, ref)
+			}
+		}
+		ws.data[member] = kept
+	}
+}
+
+func (a *API) renameInWorkspaces(member, id, name string) {
+	for _, ws := range a.workspaces.list() {
+		refs, _ := ws.data[member].([]interface{})
+		for _, ref := range refs {
+			if o, ok := ref.(object); ok && o["id"] == id {
+				o["name"] = name
+			}
+		}
+	}
+}
+
+// str returns a string member of an object, or "" when it's missing.
+func str(o object, key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// clone copies an object so that stored data doesn't share state with
+// request bodies or responses.
+func clone(o object) object {
+	b, _ := json.Marshal(o)
+
+	var c object
+	_ = json.Unmarshal(b, &c)
+
+	return c
+}
+
+func malformed(w http.ResponseWriter, kind, message string) {
+	writeError(w, http.StatusBadRequest, "malformedRequestError",
+		fmt.Sprintf("Found 1 errors with the supplied %s: %s", kind, message))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"strings"
+)
+
+// entry is a stored resource. data holds the resource as the API returns it
+// from a GET request.
+type entry struct {
+	id string
+
+	// parent is the API of a version, or the version of a schema.
+	parent string
+
+	// workspace is the workspace an API was created in.
+	workspace string
+
+	// fork describes where a forked collection came from.
+	fork object
+
+	// relations holds the elements linked to an API version, by type and
+	// ID.
+	relations map[string]object
+
+	data object
+}
+
+// store keeps resources of one type in the order they were created.
+type store struct {
+	ids     []string
+	entries map[string]*entry
+}
+
+func newStore() *store {
+	return &store{entries: make(map[string]*entry)}
+}
+
+func (s *store) add(e *entry) {
+	if _, ok := s.entries[e.id]; !ok {
+		s.ids = append(s.ids, e.id)
+	}
+	s.entries[e.id] = e
+}
+
+// get finds a resource by ID, or by a UID of the form <owner>-<id>.
+func (s *store) get(id string) (*entry, bool) {
+	if e, ok := s.entries[id]; ok {
+		return e, true
+	}
+
+	if i := strings.Index(id, "-"); i > 0 && isDigits(id[:i]) {
+		e, ok := s.entries[id[i+1:]]
+		return e, ok
+	}
+
+	return nil, false
+}
+
+func (s *store) remove(id string) {
+	delete(s.entries, id)
+	for i, v := range s.ids {
+		if v == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *store) list() []*entry {
+	entries := make([]*entry, len(s.ids))
+	for i, id := range s.ids {
+		entries[i] = s.entries[id]
+	}
+
+	return entries
+}
+
+// children returns the resources with the given parent.
+func (s *store) children(parent string) []*entry {
+	var entries []*entry
+	for _, e := range s.list() {
+		if e.parent == parent {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
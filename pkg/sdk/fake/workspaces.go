@@ -0,0 +1,145 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+)
+
+// workspaceMembers are the workspace properties listing its resources.
+var workspaceMembers = []string{"collections", "environments", "mocks", "monitors"}
+
+func newWorkspace(id, name, kind, description string) object {
+	ws := object{
+		"id":          id,
+		"name":        name,
+		"type":        kind,
+		"description": description,
+	}
+
+	for _, member := range workspaceMembers {
+		ws[member] = []interface{}{}
+	}
+
+	return ws
+}
+
+func (a *API) listWorkspaces(w http.ResponseWriter) {
+	items := []object{}
+	for _, e := range a.workspaces.list() {
+		items = append(items, object{
+			"id":   e.id,
+			"name": str(e.data, "name"),
+			"type": str(e.data, "type"),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, object{"workspaces": items})
+}
+
+func (a *API) getWorkspace(w http.ResponseWriter, id string) {
+	e, ok := a.workspaces.get(id)
+	if !ok {
+		notFound(w, "workspace")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"workspace": e.data})
+}
+
+func validWorkspaceType(w http.ResponseWriter, kind string) bool {
+	if kind != "personal" && kind != "team" {
+		malformed(w, "workspace", "type must be one of personal, team.")
+		return false
+	}
+
+	return true
+}
+
+func (a *API) createWorkspace(w http.ResponseWriter, r *request) {
+	ws, ok := r.decode(w, "workspace")
+	if !ok {
+		return
+	}
+
+	if str(ws, "name") == "" {
+		malformed(w, "workspace", "name is required.")
+		return
+	}
+
+	if !validWorkspaceType(w, str(ws, "type")) {
+		return
+	}
+
+	id := newID()
+	e := &entry{id: id, data: newWorkspace(id, str(ws, "name"), str(ws, "type"), str(ws, "description"))}
+	for _, member := range workspaceMembers {
+		if refs, ok := ws[member].([]interface{}); ok {
+			e.data[member] = refs
+		}
+	}
+
+	a.workspaces.add(e)
+
+	writeJSON(w, http.StatusOK, object{"workspace": object{"id": id, "name": str(ws, "name")}})
+}
+
+func (a *API) replaceWorkspace(w http.ResponseWriter, r *request, id string) {
+	e, ok := a.workspaces.get(id)
+	if !ok {
+		notFound(w, "workspace")
+		return
+	}
+
+	ws, ok := r.decode(w, "workspace")
+	if !ok {
+		return
+	}
+
+	if kind, ok := ws["type"].(string); ok {
+		if !validWorkspaceType(w, kind) {
+			return
+		}
+		e.data["type"] = kind
+	}
+
+	for _, key := range []string{"name", "description"} {
+		if v, ok := ws[key].(string); ok {
+			e.data[key] = v
+		}
+	}
+
+	for _, member := range workspaceMembers {
+		if refs, ok := ws[member].([]interface{}); ok {
+			e.data[member] = refs
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{"workspace": object{"id": e.id, "name": str(e.data, "name")}})
+}
+
+func (a *API) deleteWorkspace(w http.ResponseWriter, id string) {
+	e, ok := a.workspaces.get(id)
+	if !ok {
+		notFound(w, "workspace")
+		return
+	}
+
+	a.workspaces.remove(e.id)
+
+	writeJSON(w, http.StatusOK, object{"workspace": object{"id": e.id}})
+}
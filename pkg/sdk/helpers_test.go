@@ -27,6 +27,15 @@ import (
 	"github.com/kevinswiber/postmanctl/pkg/sdk/client"
 )
 
+// collectionInput is a minimal valid collection.
+const collectionInput = `{
+	"info": {
+		"name": "Test",
+		"schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+	},
+	"item": []
+}`
+
 func NewTestService(server *httptest.Server) *sdk.Service {
 	u, _ := url.Parse(server.URL)
 	options := client.NewOptions(u, "", http.DefaultClient)
@@ -0,0 +1,154 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+//go:generate moq -out sdkmock/service.go -pkg sdkmock . Interface:ServiceMock
+
+import (
+	"context"
+	"io"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// Interface is the Postman API as implemented by Service. Consumers can
+// depend on it, or on one of the narrower interfaces it's made of, to
+// substitute the API in tests or to wrap it with caching, logging or dry-run
+// behavior.
+type Interface interface {
+	CollectionsService
+	EnvironmentsService
+	MocksService
+	MonitorsService
+	WorkspacesService
+	APIsService
+	APIVersionsService
+	SchemasService
+	UserService
+	ResourcesService
+}
+
+var _ Interface = (*Service)(nil)
+
+// CollectionsService works with collections.
+type CollectionsService interface {
+	Collections(ctx context.Context) (*resources.CollectionListItems, error)
+	Collection(ctx context.Context, id string) (*resources.Collection, error)
+	CreateCollection(ctx context.Context, c *resources.Collection, workspace string) (*resources.Collection, error)
+	CreateCollectionFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceCollection(ctx context.Context, resourceID string, c *resources.Collection) (*resources.Collection, error)
+	ReplaceCollectionFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	DeleteCollection(ctx context.Context, resourceID string) (*resources.Result, error)
+	ForkCollection(ctx context.Context, id, workspace, label string) (*resources.Result, error)
+	MergeCollection(ctx context.Context, id, destination, strategy string) (*resources.Result, error)
+}
+
+// EnvironmentsService works with environments.
+type EnvironmentsService interface {
+	Environments(ctx context.Context) (*resources.EnvironmentListItems, error)
+	Environment(ctx context.Context, id string) (*resources.Environment, error)
+	CreateEnvironment(ctx context.Context, e *resources.Environment, workspace string) (*resources.Environment, error)
+	CreateEnvironmentFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceEnvironment(ctx context.Context, resourceID string, e *resources.Environment) (*resources.Environment, error)
+	ReplaceEnvironmentFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	UpdateEnvironment(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error)
+	DeleteEnvironment(ctx context.Context, resourceID string) (*resources.Result, error)
+}
+
+// MocksService works with mock servers.
+type MocksService interface {
+	Mocks(ctx context.Context) (*resources.MockListItems, error)
+	Mock(ctx context.Context, id string) (*resources.Mock, error)
+	CreateMock(ctx context.Context, m *resources.Mock, workspace string) (*resources.Mock, error)
+	CreateMockFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceMock(ctx context.Context, resourceID string, m *resources.Mock) (*resources.Mock, error)
+	ReplaceMockFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	DeleteMock(ctx context.Context, resourceID string) (*resources.Result, error)
+}
+
+// MonitorsService works with monitors and runs them.
+type MonitorsService interface {
+	Monitors(ctx context.Context) (*resources.MonitorListItems, error)
+	Monitor(ctx context.Context, id string) (*resources.Monitor, error)
+	CreateMonitor(ctx context.Context, m *resources.Monitor, workspace string) (*resources.Monitor, error)
+	CreateMonitorFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceMonitor(ctx context.Context, resourceID string, m *resources.Monitor) (*resources.Monitor, error)
+	ReplaceMonitorFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	DeleteMonitor(ctx context.Context, resourceID string) (*resources.Result, error)
+	RunMonitor(ctx context.Context, id string) (*resources.MonitorRun, error)
+}
+
+// WorkspacesService works with workspaces.
+type WorkspacesService interface {
+	Workspaces(ctx context.Context) (*resources.WorkspaceListItems, error)
+	Workspace(ctx context.Context, id string) (*resources.Workspace, error)
+	CreateWorkspace(ctx context.Context, w *resources.Workspace) (*resources.Workspace, error)
+	CreateWorkspaceFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceWorkspace(ctx context.Context, resourceID string, w *resources.Workspace) (*resources.Workspace, error)
+	ReplaceWorkspaceFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	DeleteWorkspace(ctx context.Context, resourceID string) (*resources.Result, error)
+}
+
+// APIsService works with APIs.
+type APIsService interface {
+	APIs(ctx context.Context, workspace string) (*resources.APIListItems, error)
+	API(ctx context.Context, id string) (*resources.API, error)
+	CreateAPI(ctx context.Context, a *resources.API, workspace string) (*resources.API, error)
+	CreateAPIFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+	ReplaceAPI(ctx context.Context, resourceID string, a *resources.API) (*resources.API, error)
+	ReplaceAPIFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+	DeleteAPI(ctx context.Context, resourceID string) (*resources.Result, error)
+}
+
+// APIVersionsService works with API versions and the elements related to
+// them.
+type APIVersionsService interface {
+	APIVersions(ctx context.Context, apiID string) (*resources.APIVersionListItems, error)
+	APIVersion(ctx context.Context, apiID, id string) (*resources.APIVersion, error)
+	CreateAPIVersion(ctx context.Context, a *resources.APIVersion, workspace, apiID string) (*resources.APIVersion, error)
+	CreateAPIVersionFromReader(ctx context.Context, reader io.Reader, workspace, apiID string) (*resources.Result, error)
+	ReplaceAPIVersion(ctx context.Context, resourceID, apiID string, a *resources.APIVersion) (*resources.APIVersion, error)
+	ReplaceAPIVersionFromReader(ctx context.Context, reader io.Reader, resourceID, apiID string) (*resources.Result, error)
+	DeleteAPIVersion(ctx context.Context, resourceID, apiID string) (*resources.Result, error)
+	APIRelations(ctx context.Context, apiID, apiVersionID string) (*resources.APIRelations, error)
+	FormattedAPIRelationItems(ctx context.Context, apiID, apiVersionID string) (*resources.FormattedAPIRelationItems, error)
+}
+
+// SchemasService works with the schemas of API versions.
+type SchemasService interface {
+	Schema(ctx context.Context, apiID, apiVersionID, id string) (*resources.Schema, error)
+	CreateSchema(ctx context.Context, sc *resources.Schema, workspace, apiID, apiVersionID string) (*resources.Schema, error)
+	CreateSchemaFromReader(ctx context.Context, reader io.Reader, workspace, apiID, apiVersionID string) (*resources.Result, error)
+	ReplaceSchema(ctx context.Context, resourceID, apiID, apiVersionID string, sc *resources.Schema) (*resources.Schema, error)
+	ReplaceSchemaFromReader(ctx context.Context, reader io.Reader, resourceID, apiID, apiVersionID string) (*resources.Result, error)
+	DeleteSchema(ctx context.Context, resourceID, apiID, apiVersionID string) (*resources.Result, error)
+}
+
+// UserService describes the user the API key belongs to.
+type UserService interface {
+	User(ctx context.Context) (*resources.User, error)
+}
+
+// ResourcesService creates, replaces, patches and deletes resources of any
+// type.
+type ResourcesService interface {
+	CreateFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams, urlParams map[string]string) (*resources.Result, error)
+	ReplaceFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (*resources.Result, error)
+	Patch(ctx context.Context, t resources.ResourceType, urlParams map[string]string, fn patch.Func) (*resources.Result, error)
+	Delete(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (*resources.Result, error)
+}
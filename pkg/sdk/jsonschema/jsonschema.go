@@ -0,0 +1,527 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonschema validates JSON values against JSON schemas (draft 4).
+//
+// It supports the keywords of the Postman collection schema: type, enum,
+// properties, required, additionalProperties, items, oneOf, anyOf, allOf,
+// not, minimum, maximum, minLength, maxLength, pattern, minItems and
+// maxItems. References must point within the schema.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Schema is a compiled JSON schema.
+type Schema struct {
+	root     interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+// Compile reads a JSON schema.
+func Compile(b []byte) (*Schema, error) {
+	var root interface{}
+	if err := json.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+
+	if _, ok := root.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("a schema must be an object")
+	}
+
+	patterns := make(map[string]*regexp.Regexp)
+	if err := compilePatterns(root, patterns); err != nil {
+		return nil, err
+	}
+
+	return &Schema{root: root, patterns: patterns}, nil
+}
+
+// compilePatterns compiles the pattern keywords found in a schema, skipping
+// the values given by enum and default.
+func compilePatterns(v interface{}, patterns map[string]*regexp.Regexp) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range v {
+			if k == "enum" || k == "default" {
+				continue
+			}
+
+			if pattern, ok := sub.(string); ok && k == "pattern" {
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					return fmt.Errorf("invalid pattern %q: %s", pattern, err)
+				}
+				patterns[pattern] = re
+				continue
+			}
+
+			if err := compilePatterns(sub, patterns); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, sub := range v {
+			if err := compilePatterns(sub, patterns); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Error is a value failing the schema.
+type Error struct {
+	// Path leads to the value, such as "item[3].item[0].request.url". It
+	// is empty for the document itself.
+	Path    string
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate returns the errors of a value decoded by encoding/json, or none
+// when it is valid. It is safe to call from several goroutines at once.
+func (s *Schema) Validate(v interface{}) []Error {
+	return s.validate("", s.root, v)
+}
+
+// ValidateJSON decodes a document and validates it.
+func (s *Schema) ValidateJSON(b []byte) ([]Error, error) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return s.Validate(v), nil
+}
+
+func (s *Schema) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("reference %s is not supported", ref)
+	}
+
+	v := s.root
+	pointer := strings.TrimPrefix(ref[1:], "/")
+	if pointer == "" {
+		return v, nil
+	}
+
+	for _, token := range strings.Split(pointer, "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("reference %s not found", ref)
+		}
+
+		if v, ok = m[token]; !ok {
+			return nil, fmt.Errorf("reference %s not found", ref)
+		}
+	}
+
+	return v, nil
+}
+
+func (s *Schema) validate(path string, schema, v interface{}) []Error {
+	sch, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if ref, ok := sch["$ref"].(string); ok {
+		target, err := s.resolve(ref)
+		if err != nil {
+			return []Error{{path, err.Error()}}
+		}
+
+		return s.validate(path, target, v)
+	}
+
+	if t, ok := sch["type"]; ok && !matchesType(t, v) {
+		return []Error{{path, fmt.Sprintf("expected %s, found %s", describeType(t), typeOf(v))}}
+	}
+
+	if enum, ok := sch["enum"].([]interface{}); ok && !contains(enum, v) {
+		return []Error{{path, fmt.Sprintf("expected one of %s", describeValues(enum))}}
+	}
+
+	var errs []Error
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		errs = append(errs, s.validateObject(path, sch, v)...)
+	case []interface{}:
+		errs = append(errs, s.validateArray(path, sch, v)...)
+	case string:
+		errs = append(errs, s.validateString(path, sch, v)...)
+	case float64:
+		errs = append(errs, validateNumber(path, sch, v)...)
+	}
+
+	return append(errs, s.validateCombinators(path, sch, v)...)
+}
+
+func contains(values []interface{}, v interface{}) bool {
+	for _, e := range values {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateCombinators applies the allOf, anyOf, oneOf and not keywords.
+func (s *Schema) validateCombinators(path string, sch map[string]interface{}, v interface{}) []Error {
+	var errs []Error
+
+	if all, ok := sch["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			errs = append(errs, s.validate(path, sub, v)...)
+		}
+	}
+
+	if any, ok := sch["anyOf"].([]interface{}); ok {
+		if _, branchErrs := s.matching(path, any, v); branchErrs != nil {
+			errs = append(errs, branchErrs...)
+		}
+	}
+
+	if one, ok := sch["oneOf"].([]interface{}); ok {
+		n, branchErrs := s.matching(path, one, v)
+		if branchErrs != nil {
+			errs = append(errs, branchErrs...)
+		} else if n > 1 {
+			errs = append(errs, Error{path, "matches more than one of the allowed schemas"})
+		}
+	}
+
+	if not, ok := sch["not"]; ok && len(s.validate(path, not, v)) == 0 {
+		errs = append(errs, Error{path, "matches a schema it must not match"})
+	}
+
+	return errs
+}
+
+// matching returns how many of the schemas v matches. When it matches none,
+// it returns the errors of the closest one: the schema whose errors are the
+// deepest in the value, and the fewest of those.
+func (s *Schema) matching(path string, schemas []interface{}, v interface{}) (int, []Error) {
+	if types, ok := s.branchTypes(schemas); ok && !matchesType(types, v) {
+		return 0, []Error{{path, fmt.Sprintf("expected %s, found %s", describeType(types), typeOf(v))}}
+	}
+
+	n := 0
+	var best []Error
+	for _, sub := range schemas {
+		errs := s.validate(path, sub, v)
+		if len(errs) == 0 {
+			n++
+			continue
+		}
+
+		if best == nil || closer(errs, best) {
+			best = errs
+		}
+	}
+
+	if n > 0 {
+		return n, nil
+	}
+
+	return 0, best
+}
+
+// branchTypes returns the types the schemas allow, unless one of them
+// allows any type.
+func (s *Schema) branchTypes(schemas []interface{}) ([]interface{}, bool) {
+	var types []interface{}
+	seen := make(map[string]bool)
+	for _, sub := range schemas {
+		sch, ok := sub.(map[string]interface{})
+		for ok {
+			ref, isRef := sch["$ref"].(string)
+			if !isRef {
+				break
+			}
+
+			target, err := s.resolve(ref)
+			if err != nil {
+				return nil, false
+			}
+			sch, ok = target.(map[string]interface{})
+		}
+		if !ok {
+			return nil, false
+		}
+
+		var names []interface{}
+		switch t := sch["type"].(type) {
+		case string:
+			names = []interface{}{t}
+		case []interface{}:
+			names = t
+		default:
+			return nil, false
+		}
+
+		for _, name := range names {
+			if name, ok := name.(string); ok && !seen[name] {
+				seen[name] = true
+				types = append(types, name)
+			}
+		}
+	}
+
+	return types, len(types) > 0
+}
+
+func closer(a, b []Error) bool {
+	if da, db := depth(a), depth(b); da != db {
+		return da > db
+	}
+
+	return len(a) < len(b)
+}
+
+func depth(errs []Error) int {
+	d := 0
+	for _, e := range errs {
+		n := strings.Count(e.Path, ".") + strings.Count(e.Path, "[")
+		if e.Path != "" {
+			n++
+		}
+		if n > d {
+			d = n
+		}
+	}
+
+	return d
+}
+
+func (s *Schema) validateObject(path string, sch, v map[string]interface{}) []Error {
+	var errs []Error
+
+	if required, ok := sch["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, ok := v[name]; !ok {
+					errs = append(errs, Error{path, fmt.Sprintf("%q is required", name)})
+				}
+			}
+		}
+	}
+
+	props, _ := sch["properties"].(map[string]interface{})
+	additional, hasAdditional := sch["additionalProperties"]
+
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if p, ok := props[k]; ok {
+			errs = append(errs, s.validate(join(path, k), p, v[k])...)
+			continue
+		}
+
+		if !hasAdditional {
+			continue
+		}
+
+		if allowed, ok := additional.(bool); ok {
+			if !allowed {
+				errs = append(errs, Error{join(path, k), "is not allowed"})
+			}
+			continue
+		}
+
+		errs = append(errs, s.validate(join(path, k), additional, v[k])...)
+	}
+
+	return errs
+}
+
+func (s *Schema) validateArray(path string, sch map[string]interface{}, v []interface{}) []Error {
+	var errs []Error
+
+	if min, ok := sch["minItems"].(float64); ok && float64(len(v)) < min {
+		errs = append(errs, Error{path, fmt.Sprintf("expected at least %v items", min)})
+	}
+
+	if max, ok := sch["maxItems"].(float64); ok && float64(len(v)) > max {
+		errs = append(errs, Error{path, fmt.Sprintf("expected at most %v items", max)})
+	}
+
+	switch items := sch["items"].(type) {
+	case map[string]interface{}:
+		for i, item := range v {
+			errs = append(errs, s.validate(index(path, i), items, item)...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			if i < len(items) {
+				errs = append(errs, s.validate(index(path, i), items[i], item)...)
+			}
+		}
+	}
+
+	return errs
+}
+
+func (s *Schema) validateString(path string, sch map[string]interface{}, v string) []Error {
+	var errs []Error
+
+	n := float64(utf8.RuneCountInString(v))
+	if min, ok := sch["minLength"].(float64); ok && n < min {
+		errs = append(errs, Error{path, fmt.Sprintf("expected at least %v characters", min)})
+	}
+
+	if max, ok := sch["maxLength"].(float64); ok && n > max {
+		errs = append(errs, Error{path, fmt.Sprintf("expected at most %v characters", max)})
+	}
+
+	if pattern, ok := sch["pattern"].(string); ok {
+		if !s.patterns[pattern].MatchString(v) {
+			errs = append(errs, Error{path, fmt.Sprintf("expected to match %q", pattern)})
+		}
+	}
+
+	return errs
+}
+
+func validateNumber(path string, sch map[string]interface{}, v float64) []Error {
+	var errs []Error
+
+	if min, ok := sch["minimum"].(float64); ok {
+		if exclusive, _ := sch["exclusiveMinimum"].(bool); exclusive && v <= min {
+			errs = append(errs, Error{path, fmt.Sprintf("expected more than %v", min)})
+		} else if v < min {
+			errs = append(errs, Error{path, fmt.Sprintf("expected at least %v", min)})
+		}
+	}
+
+	if max, ok := sch["maximum"].(float64); ok {
+		if exclusive, _ := sch["exclusiveMaximum"].(bool); exclusive && v >= max {
+			errs = append(errs, Error{path, fmt.Sprintf("expected less than %v", max)})
+		} else if v > max {
+			errs = append(errs, Error{path, fmt.Sprintf("expected at most %v", max)})
+		}
+	}
+
+	return errs
+}
+
+func matchesType(t, v interface{}) bool {
+	switch t := t.(type) {
+	case string:
+		return isType(t, v)
+	case []interface{}:
+		for _, name := range t {
+			if name, ok := name.(string); ok && isType(name, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func isType(name string, v interface{}) bool {
+	switch name {
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == math.Trunc(f)
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	}
+
+	return typeOf(v) == name
+}
+
+func typeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+func describeType(t interface{}) string {
+	if names, ok := t.([]interface{}); ok {
+		s := make([]string, len(names))
+		for i, n := range names {
+			s[i] = fmt.Sprint(n)
+		}
+		return strings.Join(s, " or ")
+	}
+
+	return fmt.Sprint(t)
+}
+
+func describeValues(values []interface{}) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		b, _ := json.Marshal(v)
+		s[i] = string(b)
+	}
+
+	return strings.Join(s, ", ")
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
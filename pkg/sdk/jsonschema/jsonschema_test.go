@@ -0,0 +1,140 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonschema_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/jsonschema"
+)
+
+const schema = `{
+	"type": "object",
+	"required": ["name"],
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "maxLength": 5},
+		"age": {"type": "integer", "minimum": 0},
+		"tags": {"type": "array", "items": {"$ref": "#/definitions/tag"}, "maxItems": 2},
+		"kind": {"enum": ["cat", "dog"]},
+		"owner": {"oneOf": [{"type": "null"}, {"$ref": "#/definitions/owner"}]},
+		"code": {"type": "string", "pattern": "^[A-Z]+$"}
+	},
+	"additionalProperties": false,
+	"definitions": {
+		"tag": {"type": "string"},
+		"owner": {
+			"type": "object",
+			"required": ["id"],
+			"properties": {"id": {"type": "integer"}}
+		}
+	}
+}`
+
+func TestValidate(t *testing.T) {
+	s, err := jsonschema.Compile([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		doc  string
+		want []jsonschema.Error
+	}{
+		{`{"name": "Rex", "age": 3, "tags": ["a"], "kind": "dog", "owner": null, "code": "AB"}`, nil},
+		{`{"name": "Rex", "owner": {"id": 1}}`, nil},
+		{`[]`, []jsonschema.Error{{"", "expected object, found array"}}},
+		{`{"age": 1.5}`, []jsonschema.Error{
+			{"", `"name" is required`},
+			{"age", "expected integer, found number"},
+		}},
+		{`{"name": "Rexxxxx", "age": -1, "color": "red"}`, []jsonschema.Error{
+			{"age", "expected at least 0"},
+			{"color", "is not allowed"},
+			{"name", "expected at most 5 characters"},
+		}},
+		{`{"name": "Rex", "tags": ["a", 2, "c"]}`, []jsonschema.Error{
+			{"tags", "expected at most 2 items"},
+			{"tags[1]", "expected string, found number"},
+		}},
+		{`{"name": "Rex", "kind": "cow", "code": "ab"}`, []jsonschema.Error{
+			{"code", `expected to match "^[A-Z]+$"`},
+			{"kind", `expected one of "cat", "dog"`},
+		}},
+		{`{"name": "Rex", "owner": {"id": "x"}}`, []jsonschema.Error{
+			{"owner.id", "expected integer, found string"},
+		}},
+		{`{"name": "Rex", "owner": 1}`, []jsonschema.Error{
+			{"owner", "expected null or object, found number"},
+		}},
+	}
+
+	for _, tt := range tests {
+		have, err := s.ValidateJSON([]byte(tt.doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("%s: have errors %v, want %v", tt.doc, have, tt.want)
+		}
+	}
+}
+
+func TestValidateConcurrently(t *testing.T) {
+	s, err := jsonschema.Compile([]byte(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []jsonschema.Error{{"code", `expected to match "^[A-Z]+$"`}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			have := s.Validate(map[string]interface{}{"name": "Rex", "code": "ab"})
+			if !reflect.DeepEqual(have, want) {
+				t.Errorf("have errors %v, want %v", have, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, doc := range []string{`{`, `[]`, `{"properties": {"a": {"pattern": "["}}}`} {
+		if _, err := jsonschema.Compile([]byte(doc)); err == nil {
+			t.Errorf("%s: have no error", doc)
+		}
+	}
+}
+
+func TestUnresolvableRef(t *testing.T) {
+	s, err := jsonschema.Compile([]byte(`{"$ref": "#/definitions/missing"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []jsonschema.Error{{"", "reference #/definitions/missing not found"}}
+	if have := s.Validate(map[string]interface{}{}); !reflect.DeepEqual(have, want) {
+		t.Errorf("have errors %v, want %v", have, want)
+	}
+}
@@ -0,0 +1,422 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Rules for collections.
+var (
+	RequestTests = Rule{
+		ID:          "request-tests",
+		Description: "Requests have tests, or inherit them from a folder or the collection",
+		Severity:    Warning,
+	}
+	HardCodedHost = Rule{
+		ID:          "hard-coded-host",
+		Description: "Request URLs take their host from a variable, such as {{baseUrl}}",
+		Severity:    Warning,
+	}
+	Description = Rule{
+		ID:          "description",
+		Description: "The collection, its folders and requests have descriptions",
+		Severity:    Info,
+	}
+	DuplicateName = Rule{
+		ID:          "duplicate-name",
+		Description: "Requests in the same folder have different names",
+		Severity:    Error,
+	}
+	UndefinedVariable = Rule{
+		ID:          "undefined-variable",
+		Description: "Variables are defined in the collection, by a script or in the given environment",
+		Severity:    Warning,
+	}
+	UnusedVariable = Rule{
+		ID:          "unused-variable",
+		Description: "Collection variables are used",
+		Severity:    Warning,
+	}
+	EmptyFolder = Rule{
+		ID:          "empty-folder",
+		Description: "Folders contain requests or other folders",
+		Severity:    Warning,
+	}
+	RequestExamples = Rule{
+		ID:          "request-examples",
+		Description: "Requests have saved examples",
+		Severity:    Info,
+	}
+)
+
+// CollectionRules returns the rules checked by Linter.Collection.
+func CollectionRules() []Rule {
+	return []Rule{
+		RequestTests,
+		HardCodedHost,
+		Description,
+		DuplicateName,
+		UndefinedVariable,
+		UnusedVariable,
+		EmptyFolder,
+		RequestExamples,
+	}
+}
+
+// Linter checks resources against the rules.
+type Linter struct {
+	Config *Config
+
+	// Variables are the names of variables defined outside of the linted
+	// resource, such as in an environment.
+	Variables []string
+}
+
+var (
+	// variableRef matches references to variables, such as {{baseUrl}}.
+	variableRef = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+	// scriptGet and scriptSet match the names of variables read and
+	// written by scripts.
+	scriptGet = regexp.MustCompile(`pm\.(?:collectionVariables|environment|variables|globals|iterationData)\.get\(\s*["']([^"']+)["']`)
+	scriptSet = regexp.MustCompile(`pm\.(?:collectionVariables|environment|variables|globals)\.set\(\s*["']([^"']+)["']`)
+
+	// urlHost matches the host of a raw URL.
+	urlHost = regexp.MustCompile(`^(?:[A-Za-z][A-Za-z0-9+.-]*://)?([^/?#]*)`)
+)
+
+// collectionLint holds the state of linting one collection.
+type collectionLint struct {
+	reporter
+
+	// used maps the names of referenced variables to the location of
+	// their first use, in the order they were used.
+	used      map[string]string
+	usedOrder []string
+	defined   map[string]bool
+
+	// tested holds the folders with tests, by path.
+	tested map[string]bool
+
+	// names holds the names of requests by folder path.
+	names map[string]map[string]bool
+}
+
+// Collection lints a collection. The uri is the file the collection was
+// read from, if any.
+func (l *Linter) Collection(c *resources.Collection, uri string) ([]Problem, error) {
+	if c.Collection == nil || c.Info == nil {
+		return nil, fmt.Errorf("not a collection")
+	}
+
+	cl := &collectionLint{
+		reporter: reporter{config: l.Config, resource: c.Info.Name, uri: uri},
+		used:     make(map[string]string),
+		defined:  make(map[string]bool),
+		tested:   make(map[string]bool),
+		names:    make(map[string]map[string]bool),
+	}
+
+	for _, v := range l.Variables {
+		cl.defined[v] = true
+	}
+	for _, v := range c.Variable {
+		if v != nil {
+			cl.defined[variableName(v)] = true
+		}
+	}
+
+	if resources.DescriptionText(c.Info.Description) == "" {
+		cl.report(Description, "", "the collection has no description")
+	}
+
+	events := resources.CollectionEvents(c.Event)
+	cl.tested[""] = hasTests(events)
+	cl.events("", events)
+	if err := cl.auth("auth", c.Auth); err != nil {
+		return nil, err
+	}
+	cl.variables("", c.Variable)
+
+	if c.Items != nil {
+		if err := c.Items.Walk(cl.visit); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range cl.usedOrder {
+		if !cl.defined[name] {
+			cl.report(UndefinedVariable, cl.used[name], "variable %q is not defined", name)
+		}
+	}
+
+	for _, v := range c.Variable {
+		if v == nil || v.Disabled {
+			continue
+		}
+
+		name := variableName(v)
+		if _, ok := cl.used[name]; !ok {
+			cl.report(UnusedVariable, fmt.Sprintf("variable[%s]", name), "variable %q is never used", name)
+		}
+	}
+
+	sortProblems(cl.problems)
+
+	return cl.problems, nil
+}
+
+func (cl *collectionLint) visit(ref resources.ItemRef) error {
+	if ref.IsFolder() {
+		return cl.visitFolder(ref)
+	}
+
+	return cl.visitRequest(ref)
+}
+
+func (cl *collectionLint) visitFolder(ref resources.ItemRef) error {
+	path := ref.FullPath()
+	parent := resources.JoinItemPath(ref.Path...)
+
+	node := ref.Node
+	if (node.Branches == nil || len(*node.Branches) == 0) && (node.Items == nil || len(*node.Items) == 0) {
+		cl.report(EmptyFolder, path, "the folder is empty")
+	}
+
+	g := node.ItemGroup
+	if g == nil || g.ItemGroup == nil {
+		cl.tested[path] = cl.tested[parent]
+		return nil
+	}
+
+	cl.tested[path] = cl.tested[parent] || hasTests(g.Events)
+
+	if resources.DescriptionText(g.Description) == "" {
+		cl.report(Description, path, "the folder has no description")
+	}
+
+	cl.events(path+": ", g.Events)
+	cl.variables(path+": ", g.Variable)
+	return cl.auth(path+": auth", g.Auth)
+}
+
+func (cl *collectionLint) visitRequest(ref resources.ItemRef) error {
+	path := ref.FullPath()
+	parent := resources.JoinItemPath(ref.Path...)
+
+	item := ref.Item
+	if item.Item == nil {
+		return nil
+	}
+
+	if cl.names[parent] == nil {
+		cl.names[parent] = make(map[string]bool)
+	}
+	if cl.names[parent][item.Name] {
+		folder := "the collection"
+		if parent != "" {
+			folder = fmt.Sprintf("folder %q", parent)
+		}
+		cl.report(DuplicateName, path, "another request in %s is named %q", folder, item.Name)
+	}
+	cl.names[parent][item.Name] = true
+
+	if !cl.tested[parent] && !hasTests(item.Events) {
+		cl.report(RequestTests, path, "the request has no tests")
+	}
+
+	if len(item.Response) == 0 {
+		cl.report(RequestExamples, path, "the request has no saved examples")
+	}
+
+	cl.events(path+": ", item.Events)
+	cl.variables(path+": ", item.Variable)
+
+	if item.Request == nil {
+		return nil
+	}
+
+	r, err := item.ParseRequest()
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+
+	if resources.DescriptionText(item.Description) == "" && resources.DescriptionText(r.Description) == "" {
+		cl.report(Description, path, "the request has no description")
+	}
+
+	if host := requestHost(r.URL); host != "" && !strings.HasPrefix(host, "{{") {
+		cl.report(HardCodedHost, path+": request.url", "the request uses the hard-coded host %q", host)
+	}
+
+	cl.request(path+": request", r)
+
+	return nil
+}
+
+func (cl *collectionLint) request(loc string, r *resources.Request) {
+	cl.use(loc+".url", r.URL.String())
+	for _, v := range r.URL.Variable {
+		cl.use(loc+".url", v.Value)
+	}
+
+	for _, h := range r.Header {
+		if h.Disabled {
+			continue
+		}
+		cl.use(fmt.Sprintf("%s.header[%s]", loc, h.Key), h.Key+h.Value)
+	}
+
+	if b := r.Body; b != nil {
+		cl.use(loc+".body", b.Raw)
+		for _, p := range append(append([]resources.FormParameter{}, b.URLEncoded...), b.FormData...) {
+			if !p.Disabled {
+				cl.use(fmt.Sprintf("%s.body[%s]", loc, p.Key), p.Key+p.Value)
+			}
+		}
+		if b.GraphQL != nil {
+			cl.use(loc+".body", b.GraphQL.Query+b.GraphQL.Variables)
+		}
+	}
+
+	if r.Auth != nil {
+		cl.authValues(loc+".auth", r.Auth)
+	}
+}
+
+func (cl *collectionLint) auth(loc string, v interface{}) error {
+	auth, err := resources.ParseAuth(v)
+	if err != nil {
+		return fmt.Errorf("%s: %s", loc, err)
+	}
+
+	if auth != nil {
+		cl.authValues(loc, auth)
+	}
+
+	return nil
+}
+
+func (cl *collectionLint) authValues(loc string, auth *gen.Auth) {
+	values := resources.AuthValues(auth)
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		cl.use(fmt.Sprintf("%s.%s.%s", loc, auth.Type, k), values[k])
+	}
+}
+
+func (cl *collectionLint) variables(prefix string, vars []*gen.Variable) {
+	for _, v := range vars {
+		if v == nil {
+			continue
+		}
+
+		name := variableName(v)
+		cl.defined[name] = true
+
+		if s, ok := v.Value.(string); ok {
+			cl.use(fmt.Sprintf("%svariable[%s]", prefix, name), s)
+		}
+	}
+}
+
+func (cl *collectionLint) events(prefix string, events []resources.Event) {
+	for _, e := range events {
+		if e.Event == nil || e.Disabled {
+			continue
+		}
+
+		src := e.Source()
+		loc := fmt.Sprintf("%sevent[%s]", prefix, e.Listen)
+
+		for _, m := range scriptSet.FindAllStringSubmatch(src, -1) {
+			cl.defined[m[1]] = true
+		}
+
+		for _, m := range scriptGet.FindAllStringSubmatch(src, -1) {
+			cl.useName(loc, m[1])
+		}
+
+		cl.use(loc, src)
+	}
+}
+
+// use records the variables referenced in s.
+func (cl *collectionLint) use(loc, s string) {
+	for _, m := range variableRef.FindAllStringSubmatch(s, -1) {
+		cl.useName(loc, m[1])
+	}
+}
+
+func (cl *collectionLint) useName(loc, name string) {
+	// Dynamic variables, such as {{$guid}}, are provided by Postman.
+	if strings.HasPrefix(name, "$") {
+		return
+	}
+
+	if _, ok := cl.used[name]; !ok {
+		cl.used[name] = loc
+		cl.usedOrder = append(cl.usedOrder, name)
+	}
+}
+
+// hasTests reports whether any enabled event is a test script with code.
+func hasTests(events []resources.Event) bool {
+	for _, e := range events {
+		if e.Event != nil && !e.Disabled && e.Listen == "test" && strings.TrimSpace(e.Source()) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestHost returns the host of a request URL as written, which may be a
+// reference to a variable.
+func requestHost(u resources.URL) string {
+	if len(u.Host) > 0 {
+		return strings.Join(u.Host, ".")
+	}
+
+	m := urlHost.FindStringSubmatch(strings.TrimSpace(u.Raw))
+	if m == nil {
+		return ""
+	}
+
+	return m[1]
+}
+
+func variableName(v *gen.Variable) string {
+	if v.Key != "" {
+		return v.Key
+	}
+
+	return v.ID
+}
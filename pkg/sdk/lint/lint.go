@@ -0,0 +1,315 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint checks Postman resources against rules of good practice,
+// such as every request having tests and no request using a hard-coded
+// host.
+//
+// Each rule has a default severity, which a Config may change or turn off.
+// Configs are usually read from a ".postmanctl-lint.yaml" file, which may
+// also add custom rules for schemas:
+//
+//	rules:
+//	  request-examples: off
+//	  description: error
+//	custom:
+//	  - id: operation-summary
+//	    description: Operations have a summary
+//	    severity: warning
+//	    given: $.paths.*.get
+//	    field: summary
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/printers"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/sarif"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ConfigFile is the name of the file configs are read from by default.
+const ConfigFile = ".postmanctl-lint.yaml"
+
+// Severity is how serious a problem is.
+type Severity string
+
+// Severities, from the most serious. Rules with the severity Off aren't
+// checked.
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+	Off     Severity = "off"
+)
+
+func (s Severity) valid() bool {
+	switch s {
+	case Error, Warning, Info, Off:
+		return true
+	}
+
+	return false
+}
+
+func (s Severity) sarifLevel() string {
+	switch s {
+	case Error:
+		return sarif.LevelError
+	case Warning:
+		return sarif.LevelWarning
+	}
+
+	return sarif.LevelNote
+}
+
+// Rule describes a check.
+type Rule struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Severity    Severity `json:"severity"`
+}
+
+// Config changes the severity of rules and adds custom rules.
+type Config struct {
+	Rules map[string]Severity `yaml:"rules"`
+
+	// Custom rules are checked for schemas.
+	Custom []CustomRule `yaml:"custom"`
+}
+
+// LoadConfig reads a config file. Unknown rules and severities are errors,
+// so typos don't go unnoticed.
+func LoadConfig(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	ids := make(map[string]bool)
+	for _, r := range append(CollectionRules(), SchemaRules()...) {
+		ids[r.ID] = true
+	}
+
+	for i := range cfg.Custom {
+		if err := cfg.Custom[i].compile(); err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		if ids[cfg.Custom[i].ID] {
+			return nil, fmt.Errorf("%s: custom rule %q has the id of another rule", path, cfg.Custom[i].ID)
+		}
+		ids[cfg.Custom[i].ID] = true
+	}
+
+	for id, s := range cfg.Rules {
+		if !ids[id] {
+			return nil, fmt.Errorf("%s: unknown rule %q", path, id)
+		}
+
+		if !s.valid() {
+			return nil, fmt.Errorf("%s: rule %q: unknown severity %q, expected one of: error, warning, info, off", path, id, s)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// FindConfig reads ConfigFile from the current directory. It returns an
+// empty Config when there is none.
+func FindConfig() (*Config, error) {
+	if _, err := os.Stat(ConfigFile); os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+
+	return LoadConfig(ConfigFile)
+}
+
+// Severity returns the severity of the rule.
+func (c *Config) Severity(r Rule) Severity {
+	if c != nil {
+		if s, ok := c.Rules[r.ID]; ok {
+			return s
+		}
+	}
+
+	return r.Severity
+}
+
+// Problem is a violation of a rule.
+type Problem struct {
+	RuleID   string   `json:"ruleId"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+
+	// Resource is the name of the linted resource.
+	Resource string `json:"resource"`
+
+	// Location is the place within the resource, such as
+	// "Folder/Request: request.url".
+	Location string `json:"location,omitempty"`
+
+	// URI is the file the resource was read from, if any.
+	URI string `json:"uri,omitempty"`
+}
+
+// HasErrors reports whether any of the problems has the severity Error.
+func HasErrors(problems []Problem) bool {
+	for _, p := range problems {
+		if p.Severity == Error {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Formats returns the names of the supported output formats.
+func Formats() []string {
+	return []string{"json", "sarif", "text"}
+}
+
+// Write writes the problems in the given format. SARIF output describes the
+// rules as well.
+func Write(w io.Writer, problems []Problem, rules []Rule, format string) error {
+	switch format {
+	case "json":
+		if problems == nil {
+			problems = []Problem{}
+		}
+
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(problems)
+	case "sarif":
+		return writeSARIF(w, problems, rules)
+	case "text":
+		return writeText(w, problems)
+	}
+
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func writeText(w io.Writer, problems []Problem) error {
+	if len(problems) == 0 {
+		_, err := fmt.Fprintln(w, "No problems found.")
+		return err
+	}
+
+	tw := printers.GetNewTabWriter(w)
+	fmt.Fprintln(tw, "SEVERITY\tRULE\tLOCATION\tMESSAGE")
+	counts := make(map[Severity]int)
+	for _, p := range problems {
+		loc := p.Resource
+		if p.Location != "" {
+			loc += " > " + p.Location
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", p.Severity, p.RuleID, loc, p.Message)
+		counts[p.Severity]++
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	var summary []string
+	for _, s := range []Severity{Error, Warning, Info} {
+		if counts[s] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[s], plural(string(s), counts[s])))
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%s\n", strings.Join(summary, ", "))
+	return err
+}
+
+func plural(s string, n int) string {
+	if n == 1 || s == string(Info) {
+		return s
+	}
+
+	return s + "s"
+}
+
+func writeSARIF(w io.Writer, problems []Problem, rules []Rule) error {
+	sr := make([]sarif.Rule, len(rules))
+	for i, r := range rules {
+		sr[i] = sarif.Rule{ID: r.ID, Description: r.Description}
+	}
+
+	results := make([]sarif.Result, len(problems))
+	for i, p := range problems {
+		loc := p.Resource
+		if p.Location != "" {
+			loc += "/" + p.Location
+		}
+
+		results[i] = sarif.Result{
+			RuleID:   p.RuleID,
+			Level:    p.Severity.sarifLevel(),
+			Message:  p.Message,
+			Location: loc,
+			URI:      p.URI,
+		}
+	}
+
+	return sarif.Write(w, sr, results)
+}
+
+// reporter collects the problems of one resource.
+type reporter struct {
+	config   *Config
+	resource string
+	uri      string
+	problems []Problem
+}
+
+func (r *reporter) report(rule Rule, location, format string, args ...interface{}) {
+	s := r.config.Severity(rule)
+	if s == Off {
+		return
+	}
+
+	r.problems = append(r.problems, Problem{
+		RuleID:   rule.ID,
+		Severity: s,
+		Message:  fmt.Sprintf(format, args...),
+		Resource: r.resource,
+		Location: location,
+		URI:      r.uri,
+	})
+}
+
+// sortProblems orders problems by severity, keeping the order of rules and
+// locations within a severity.
+func sortProblems(problems []Problem) {
+	rank := map[Severity]int{Error: 0, Warning: 1, Info: 2}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return rank[problems[i].Severity] < rank[problems[j].Severity]
+	})
+}
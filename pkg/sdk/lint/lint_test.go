@@ -0,0 +1,325 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/lint"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func loadCollection(t *testing.T) *resources.Collection {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return &c
+}
+
+// locations returns the locations of the problems found by a rule, in
+// order.
+func locations(problems []lint.Problem, rule string) []string {
+	var locs []string
+	for _, p := range problems {
+		if p.RuleID == rule {
+			locs = append(locs, p.Location)
+		}
+	}
+
+	return locs
+}
+
+// severity returns the severity of the first problem found by a rule.
+func severity(t *testing.T, problems []lint.Problem, rule string) lint.Severity {
+	t.Helper()
+
+	for _, p := range problems {
+		if p.RuleID == rule {
+			return p.Severity
+		}
+	}
+
+	t.Fatalf("have no %s problem, want one", rule)
+	return lint.Off
+}
+
+func TestCollection(t *testing.T) {
+	l := &lint.Linter{Variables: []string{"user"}}
+
+	problems, err := l.Collection(loadCollection(t), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, rule string
+		want       []string
+	}{
+		{"requests with the same name in a folder", "duplicate-name", []string{"Pets/List pets"}},
+		{"folders without requests", "empty-folder", []string{"Empty"}},
+		{"hosts that aren't variables", "hard-coded-host", []string{"Login: request.url"}},
+		{"requests without tests of their own or from a folder", "request-tests", []string{"Me"}},
+		{"variables without a value, except the given ones", "undefined-variable", []string{"Pets/List pets: request.url"}},
+		{"collection variables that are never used", "unused-variable", []string{"variable[unused]"}},
+		{"requests without saved examples", "request-examples", []string{"Login", "Me"}},
+		{"requests and folders without a description", "description", []string{"Pets/List pets", "Me"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if have := locations(problems, tt.rule); !reflect.DeepEqual(have, tt.want) {
+				t.Errorf("have %s problems at %q, want %q", tt.rule, have, tt.want)
+			}
+		})
+	}
+
+	if !lint.HasErrors(problems) || severity(t, problems, "duplicate-name") != lint.Error {
+		t.Error("have no errors, want the duplicate name to be an error")
+	}
+}
+
+func TestCollectionConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, lint.ConfigFile)
+	config := "rules:\n  request-examples: off\n  description: off\n  duplicate-name: warning\n  undefined-variable: error\n"
+	if err := ioutil.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := lint.LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := &lint.Linter{Config: cfg}
+	problems, err := l.Collection(loadCollection(t), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rule := range []string{"request-examples", "description"} {
+		if have := locations(problems, rule); len(have) > 0 {
+			t.Errorf("have %s problems at %q, want the rule turned off", rule, have)
+		}
+	}
+
+	if have := severity(t, problems, "duplicate-name"); have != lint.Warning {
+		t.Errorf("have duplicate-name severity %s, want %s", have, lint.Warning)
+	}
+
+	if have := severity(t, problems, "undefined-variable"); have != lint.Error {
+		t.Errorf("have undefined-variable severity %s, want %s", have, lint.Error)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		config, want string
+	}{
+		{"rules:\n  no-such-rule: error\n", `unknown rule "no-such-rule"`},
+		{"rules:\n  description: fatal\n", `unknown severity "fatal"`},
+		{"rule:\n  description: error\n", "field rule not found"},
+		{"custom:\n  - id: description\n    given: $.info\n", `custom rule "description" has the id of another rule`},
+		{"custom:\n  - id: x\n    given: info\n", `given "info" must start with "$."`},
+		{"custom:\n  - id: x\n    given: $.info\n    pattern: \"(\"\n", "missing closing )"},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(dir, lint.ConfigFile)
+		if err := ioutil.WriteFile(path, []byte(tt.config), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err := lint.LoadConfig(path)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("have error %v, want it to contain %q", err, tt.want)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	l := &lint.Linter{}
+	problems, err := l.Collection(loadCollection(t), "collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := lint.Write(&buf, problems, lint.CollectionRules(), "text"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "Pets > Pets/List pets") || !strings.HasSuffix(buf.String(), "1 error, 6 warnings, 4 info\n") {
+		t.Errorf("have text output\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := lint.Write(&buf, problems, lint.CollectionRules(), "sarif"); err != nil {
+		t.Fatal(err)
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+
+	results := log.Runs[0].Results
+	if len(results) != len(problems) || results[0].Level != "error" || results[len(results)-1].Level != "note" {
+		t.Errorf("have SARIF results %+v, want one per problem with levels by severity", results)
+	}
+
+	if uri := results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "collection.json" {
+		t.Errorf("have URI %q, want collection.json", uri)
+	}
+}
+
+func loadSchema(t *testing.T) *openapi.Document {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := openapi.Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+func TestSchema(t *testing.T) {
+	l := &lint.Linter{}
+	problems := l.Schema(loadSchema(t), "openapi.yaml", "")
+
+	tests := []struct {
+		name, rule string
+		want       []string
+	}{
+		{"operation IDs used twice", "operation-operationid-unique", []string{"POST /pets"}},
+		{"responses without a description, or no responses", "operation-responses", []string{"POST /pets: responses.201", "DELETE /pets/{id}"}},
+		{"references to missing components", "ref-resolvable", []string{"paths./pets.post.requestBody.content.application/json.schema"}},
+		{"tags missing from the top-level tags", "operation-tags-defined", []string{"POST /pets"}},
+		{"operations without an ID", "operation-operationid", []string{"GET /pets/{id}"}},
+		{"operations without tags", "operation-tags", []string{"GET /pets/{id}"}},
+		{"components nothing refers to", "unused-component", []string{"components.schemas.Node", "components.securitySchemes.oauth"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if have := locations(problems, tt.rule); !reflect.DeepEqual(have, tt.want) {
+				t.Errorf("have %s problems at %q, want %q", tt.rule, have, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaCustomRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, lint.ConfigFile)
+	config := `rules:
+  unused-component: off
+  ref-resolvable: off
+  operation-responses: off
+custom:
+  - id: operation-summary
+    description: Operations have a summary
+    given: $.paths.*.*
+    field: summary
+  - id: operation-id-case
+    severity: error
+    given: $.paths.*.*
+    field: operationId
+    pattern: ^[a-z][a-zA-Z]*$
+`
+	if err := ioutil.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := lint.LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := &lint.Linter{Config: cfg}
+	problems := l.Schema(loadSchema(t), "openapi.yaml", "")
+
+	want := []string{"paths./pets.get.summary", "paths./pets.post.summary", "paths./pets/{id}.delete.summary"}
+	if have := locations(problems, "operation-summary"); !reflect.DeepEqual(have, want) {
+		t.Errorf("have operation-summary problems at %q, want %q", have, want)
+	}
+
+	want = []string{"paths./pets/{id}.get.operationId"}
+	if have := locations(problems, "operation-id-case"); !reflect.DeepEqual(have, want) {
+		t.Errorf("have operation-id-case problems at %q, want %q", have, want)
+	}
+
+	if have := severity(t, problems, "operation-id-case"); have != lint.Error {
+		t.Errorf("have operation-id-case severity %s, want %s", have, lint.Error)
+	}
+
+	if rules := cfg.SchemaRules(); rules[len(rules)-1].ID != "operation-id-case" {
+		t.Errorf("have last rule %q, want the custom rule", rules[len(rules)-1].ID)
+	}
+}
@@ -0,0 +1,466 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+)
+
+// Rules for OpenAPI schemas.
+var (
+	OperationID = Rule{
+		ID:          "operation-operationid",
+		Description: "Operations have an operationId",
+		Severity:    Warning,
+	}
+	OperationIDUnique = Rule{
+		ID:          "operation-operationid-unique",
+		Description: "Operations have different operationIds",
+		Severity:    Error,
+	}
+	OperationTags = Rule{
+		ID:          "operation-tags",
+		Description: "Operations have at least one tag",
+		Severity:    Warning,
+	}
+	OperationTagsDefined = Rule{
+		ID:          "operation-tags-defined",
+		Description: "Tags of operations are defined in the document's tags",
+		Severity:    Warning,
+	}
+	OperationResponses = Rule{
+		ID:          "operation-responses",
+		Description: "Operations document their responses, each with a description",
+		Severity:    Error,
+	}
+	RefResolvable = Rule{
+		ID:          "ref-resolvable",
+		Description: "References within the document point to existing values",
+		Severity:    Error,
+	}
+	UnusedComponent = Rule{
+		ID:          "unused-component",
+		Description: "Reusable components are referenced",
+		Severity:    Warning,
+	}
+)
+
+// SchemaRules returns the built-in rules checked by Linter.Schema.
+func SchemaRules() []Rule {
+	return []Rule{
+		OperationID,
+		OperationIDUnique,
+		OperationTags,
+		OperationTagsDefined,
+		OperationResponses,
+		RefResolvable,
+		UnusedComponent,
+	}
+}
+
+// CustomRule checks values selected from a schema.
+//
+// Given selects the values with a path of keys separated by dots, starting
+// at "$", the document; "*" selects every member or item. The rule checks
+// Field of each value, or the value itself when Field is empty. Without a
+// Pattern, it must be present and not empty; with one, it must be a string
+// matching the regular expression.
+type CustomRule struct {
+	ID          string   `yaml:"id"`
+	Description string   `yaml:"description"`
+	Severity    Severity `yaml:"severity"`
+	Given       string   `yaml:"given"`
+	Field       string   `yaml:"field"`
+	Pattern     string   `yaml:"pattern"`
+
+	pattern *regexp.Regexp
+}
+
+// Rule returns the description of the custom rule.
+func (r CustomRule) Rule() Rule {
+	s := r.Severity
+	if s == "" {
+		s = Warning
+	}
+
+	return Rule{ID: r.ID, Description: r.Description, Severity: s}
+}
+
+func (r *CustomRule) compile() error {
+	if r.ID == "" {
+		return fmt.Errorf("custom rule without an id")
+	}
+
+	if r.Severity != "" && !r.Severity.valid() {
+		return fmt.Errorf("custom rule %q: unknown severity %q, expected one of: error, warning, info, off", r.ID, r.Severity)
+	}
+
+	if r.Given != "$" && !strings.HasPrefix(r.Given, "$.") {
+		return fmt.Errorf("custom rule %q: given %q must start with \"$.\"", r.ID, r.Given)
+	}
+
+	if r.Pattern != "" {
+		p, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return fmt.Errorf("custom rule %q: %s", r.ID, err)
+		}
+		r.pattern = p
+	}
+
+	return nil
+}
+
+// SchemaRules returns the built-in rules for schemas followed by the custom
+// rules of the config.
+func (c *Config) SchemaRules() []Rule {
+	rules := SchemaRules()
+	if c != nil {
+		for _, r := range c.Custom {
+			rules = append(rules, r.Rule())
+		}
+	}
+
+	return rules
+}
+
+// schemaLint holds the state of linting one schema.
+type schemaLint struct {
+	reporter
+	doc *openapi.Document
+}
+
+// Schema lints an OpenAPI document. The name identifies the schema in
+// problems and the uri is the file it was read from, if any.
+func (l *Linter) Schema(d *openapi.Document, name, uri string) []Problem {
+	sl := &schemaLint{
+		reporter: reporter{config: l.Config, resource: name, uri: uri},
+		doc:      d,
+	}
+
+	sl.operations()
+	sl.refs()
+
+	if l.Config != nil {
+		for _, r := range l.Config.Custom {
+			sl.custom(r)
+		}
+	}
+
+	sortProblems(sl.problems)
+
+	return sl.problems
+}
+
+func (sl *schemaLint) operations() {
+	defined := make(map[string]bool)
+	if tags, ok := sl.doc.Root["tags"].([]interface{}); ok {
+		for _, t := range tags {
+			if t, ok := t.(map[string]interface{}); ok {
+				if name, ok := t["name"].(string); ok {
+					defined[name] = true
+				}
+			}
+		}
+	}
+
+	ids := make(map[string]string)
+	for _, op := range sl.doc.Operations() {
+		loc := fmt.Sprintf("%s %s", strings.ToUpper(op.Method), op.Path)
+
+		id, _ := op.Value["operationId"].(string)
+		if id == "" {
+			sl.report(OperationID, loc, "the operation has no operationId")
+		} else if other, ok := ids[id]; ok {
+			sl.report(OperationIDUnique, loc, "operationId %q is also used by %s", id, other)
+		} else {
+			ids[id] = loc
+		}
+
+		tags, _ := op.Value["tags"].([]interface{})
+		if len(tags) == 0 {
+			sl.report(OperationTags, loc, "the operation has no tags")
+		}
+		for _, t := range tags {
+			if name, ok := t.(string); ok && !defined[name] {
+				sl.report(OperationTagsDefined, loc, "tag %q is not defined in the document's tags", name)
+			}
+		}
+
+		responses, _ := op.Value["responses"].(map[string]interface{})
+		if len(responses) == 0 {
+			sl.report(OperationResponses, loc, "the operation documents no responses")
+		}
+
+		codes := make([]string, 0, len(responses))
+		for code := range responses {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+
+		for _, code := range codes {
+			r, ok := responses[code].(map[string]interface{})
+			if !ok || strings.HasPrefix(code, "x-") {
+				continue
+			}
+
+			if _, isRef := r["$ref"]; isRef {
+				continue
+			}
+
+			if d, _ := r["description"].(string); strings.TrimSpace(d) == "" {
+				sl.report(OperationResponses, fmt.Sprintf("%s: responses.%s", loc, code), "the response has no description")
+			}
+		}
+	}
+}
+
+// componentSections returns the paths of the members holding reusable
+// components.
+func (sl *schemaLint) componentSections() [][]string {
+	if sl.doc.IsV2() {
+		return [][]string{{"definitions"}, {"parameters"}, {"responses"}, {"securityDefinitions"}}
+	}
+
+	var sections [][]string
+	components, _ := sl.doc.Root["components"].(map[string]interface{})
+	for _, k := range []string{"schemas", "responses", "parameters", "examples", "requestBodies", "headers", "securitySchemes", "links", "callbacks"} {
+		if _, ok := components[k]; ok {
+			sections = append(sections, []string{"components", k})
+		}
+	}
+
+	return sections
+}
+
+func (sl *schemaLint) refs() {
+	// used holds the components referenced from outside of themselves.
+	used := make(map[string]bool)
+
+	sl.doc.Walk(func(path []string, v interface{}) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return
+		}
+
+		// References to other files or URLs can't be checked.
+		if !strings.HasPrefix(ref, "#") {
+			return
+		}
+
+		if _, err := sl.doc.Resolve(ref); err != nil {
+			sl.report(RefResolvable, strings.Join(path, "."), "%s can't be resolved", ref)
+			return
+		}
+
+		self := openapi.Pointer(path...)
+		if ref == self || strings.HasPrefix(self, ref+"/") {
+			return
+		}
+		used[ref] = true
+	})
+
+	security := sl.requiredSecurity()
+	for _, section := range sl.componentSections() {
+		sl.unusedComponents(section, used, security)
+	}
+}
+
+// requiredSecurity returns the names of the security schemes required by
+// the document or its operations. Security schemes are referenced by name
+// rather than with $ref.
+func (sl *schemaLint) requiredSecurity() map[string]bool {
+	security := make(map[string]bool)
+	add := func(v interface{}) {
+		reqs, _ := v.([]interface{})
+		for _, r := range reqs {
+			if r, ok := r.(map[string]interface{}); ok {
+				for name := range r {
+					security[name] = true
+				}
+			}
+		}
+	}
+
+	add(sl.doc.Root["security"])
+	for _, op := range sl.doc.Operations() {
+		add(op.Value["security"])
+	}
+
+	return security
+}
+
+// unusedComponents reports the components of a section that are neither
+// referenced nor, for security schemes, required.
+func (sl *schemaLint) unusedComponents(section []string, used, security map[string]bool) {
+	v, err := sl.doc.Resolve(openapi.Pointer(section...))
+	if err != nil {
+		return
+	}
+
+	members, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	isSecurity := section[len(section)-1] == "securitySchemes" || section[len(section)-1] == "securityDefinitions"
+
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		loc := strings.Join(append(section, name), ".")
+		if isSecurity {
+			if !security[name] {
+				sl.report(UnusedComponent, loc, "security scheme %q is never required", name)
+			}
+			continue
+		}
+
+		ref := openapi.Pointer(append(section, name)...)
+		if !used[ref] && !usedInside(used, ref) {
+			sl.report(UnusedComponent, loc, "%s is never referenced", ref)
+		}
+	}
+}
+
+// usedInside reports whether anything inside the component at ref is
+// referenced.
+func usedInside(used map[string]bool, ref string) bool {
+	for r := range used {
+		if strings.HasPrefix(r, ref+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (sl *schemaLint) custom(r CustomRule) {
+	rule := r.Rule()
+
+	for _, m := range selectPath(sl.doc.Root, r.Given) {
+		v := m.value
+		loc := strings.Join(m.path, ".")
+		if r.Field != "" {
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			v = obj[r.Field]
+			loc = strings.Join(append(m.path, r.Field), ".")
+		}
+
+		if r.pattern == nil {
+			if isEmpty(v) {
+				sl.report(rule, loc, "%s", customMessage(r, "is missing"))
+			}
+			continue
+		}
+
+		s, ok := v.(string)
+		if !ok || !r.pattern.MatchString(s) {
+			sl.report(rule, loc, "%s", customMessage(r, fmt.Sprintf("doesn't match %q", r.Pattern)))
+		}
+	}
+}
+
+func customMessage(r CustomRule, problem string) string {
+	subject := "the value"
+	if r.Field != "" {
+		subject = r.Field
+	}
+
+	if r.Description != "" {
+		return fmt.Sprintf("%s: %s %s", r.Description, subject, problem)
+	}
+
+	return fmt.Sprintf("%s %s", subject, problem)
+}
+
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+type selected struct {
+	path  []string
+	value interface{}
+}
+
+// selectPath returns the values given selects, such as "$.paths.*.*".
+func selectPath(root interface{}, given string) []selected {
+	current := []selected{{value: root}}
+
+	rest := strings.TrimPrefix(strings.TrimPrefix(given, "$"), ".")
+	if rest == "" {
+		return current
+	}
+
+	for _, key := range strings.Split(rest, ".") {
+		var next []selected
+		for _, s := range current {
+			switch node := s.value.(type) {
+			case map[string]interface{}:
+				if key == "*" {
+					keys := make([]string, 0, len(node))
+					for k := range node {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+
+					for _, k := range keys {
+						next = append(next, selected{append(s.path[:len(s.path):len(s.path)], k), node[k]})
+					}
+				} else if v, ok := node[key]; ok {
+					next = append(next, selected{append(s.path[:len(s.path):len(s.path)], key), v})
+				}
+			case []interface{}:
+				if key == "*" {
+					for i, v := range node {
+						next = append(next, selected{append(s.path[:len(s.path):len(s.path)], fmt.Sprint(i)), v})
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	return current
+}
@@ -0,0 +1,310 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// Penalties subtracted from the score of an example for each part of the
+// request that only matches loosely. The example with the highest score
+// answers the request, and the first one in collection order wins a tie.
+const (
+	scoreExact         = 100
+	penaltyCase        = 5
+	penaltyWildcard    = 10
+	penaltyQueryParams = 5
+)
+
+// Match returns the example that best answers a request, or nil when no
+// example matches. body is the request body, which has already been read
+// from r.
+//
+// An example matches when its method and path match the request, ignoring
+// case and trailing slashes. With MatchWildcards, path segments such as :id
+// and {{id}} match any value. With MatchQueryParams, query parameters that
+// differ from the request rule an example out, and missing or extra ones
+// lower its score. With MatchBody, the request body must equal the body of
+// the original request. The x-mock-response-name, x-mock-response-id and
+// x-mock-response-code headers select examples directly.
+func (s *Server) Match(r *http.Request, body []byte) *Example {
+	if id := r.Header.Get(HeaderResponseID); id != "" {
+		for _, e := range s.examples {
+			if e.ID == id {
+				return e
+			}
+		}
+
+		return nil
+	}
+
+	name := r.Header.Get(HeaderResponseName)
+	code, _ := strconv.Atoi(r.Header.Get(HeaderResponseCode))
+
+	matchBody := s.options.Config.MatchBody
+	if v := r.Header.Get(HeaderMatchRequestBody); v != "" {
+		matchBody = strings.EqualFold(v, "true")
+	}
+
+	headers := configHeaders(s.options.Config.Headers)
+	for _, h := range strings.Split(r.Header.Get(HeaderMatchRequestHeads), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			headers = append(headers, h)
+		}
+	}
+
+	path, _ := splitURL(r.URL.EscapedPath())
+
+	var (
+		best      *Example
+		bestScore int
+	)
+
+	for _, e := range s.examples {
+		if e.Request.Method != r.Method {
+			continue
+		}
+
+		if name != "" && e.Name != name {
+			continue
+		}
+
+		if code != 0 && e.Code() != code {
+			continue
+		}
+
+		penalty, ok := s.matchPath(e.path, path)
+		if !ok {
+			continue
+		}
+
+		if s.options.Config.MatchQueryParams {
+			p, ok := s.matchQuery(e.query, r.URL.Query())
+			if !ok {
+				continue
+			}
+			penalty += p
+		}
+
+		if matchBody && !s.matchBody(e.Request.Body, body) {
+			continue
+		}
+
+		if !matchHeaders(e.Request.Header, r.Header, headers) {
+			continue
+		}
+
+		if score := scoreExact - penalty; best == nil || score > bestScore {
+			best, bestScore = e, score
+		}
+	}
+
+	return best
+}
+
+func (s *Server) matchPath(expected, actual []string) (int, bool) {
+	if len(expected) != len(actual) {
+		return 0, false
+	}
+
+	penalty := 0
+	for i, segment := range expected {
+		switch {
+		case segment == actual[i]:
+		case strings.EqualFold(segment, actual[i]):
+			penalty += penaltyCase
+		case s.options.Config.MatchWildcards && isWildcard(segment):
+			penalty += penaltyWildcard
+		default:
+			return 0, false
+		}
+	}
+
+	return penalty, true
+}
+
+func (s *Server) matchQuery(expected, actual url.Values) (int, bool) {
+	penalty := 0
+	for key, values := range expected {
+		got, ok := actual[key]
+		if !ok {
+			penalty += penaltyQueryParams
+			continue
+		}
+
+		for i, v := range values {
+			if i < len(got) && got[i] == v {
+				continue
+			}
+
+			if s.options.Config.MatchWildcards && isWildcard(v) {
+				penalty += penaltyWildcard
+				continue
+			}
+
+			return 0, false
+		}
+	}
+
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			penalty += penaltyQueryParams
+		}
+	}
+
+	return penalty, true
+}
+
+// matchBody compares a request body with the body of an original request.
+// Examples saved without a body, or with a body that can't be compared such
+// as multipart form data, match any request body.
+func (s *Server) matchBody(expected *resources.RequestBody, actual []byte) bool {
+	if expected == nil || expected.Disabled {
+		return true
+	}
+
+	switch expected.Mode {
+	case "raw":
+		return equalBody([]byte(s.vars.Replace(expected.Raw)), actual)
+	case "urlencoded":
+		want := url.Values{}
+		for _, p := range expected.URLEncoded {
+			if !p.Disabled {
+				want.Add(s.vars.Replace(p.Key), s.vars.Replace(p.Value))
+			}
+		}
+
+		got, err := url.ParseQuery(string(actual))
+		return err == nil && reflect.DeepEqual(want, got)
+	case "graphql":
+		if expected.GraphQL == nil {
+			return true
+		}
+
+		var got struct {
+			Query     string      `json:"query"`
+			Variables interface{} `json:"variables"`
+		}
+		if err := json.Unmarshal(actual, &got); err != nil {
+			return false
+		}
+
+		if strings.TrimSpace(got.Query) != strings.TrimSpace(s.vars.Replace(expected.GraphQL.Query)) {
+			return false
+		}
+
+		variables, _ := json.Marshal(got.Variables)
+		return expected.GraphQL.Variables == "" ||
+			equalBody([]byte(s.vars.Replace(expected.GraphQL.Variables)), variables)
+	}
+
+	return true
+}
+
+// equalBody compares two bodies as JSON when both are valid JSON, and as
+// text otherwise.
+func equalBody(expected, actual []byte) bool {
+	var want, got interface{}
+	if json.Unmarshal(expected, &want) == nil && json.Unmarshal(actual, &got) == nil {
+		return reflect.DeepEqual(want, got)
+	}
+
+	return bytes.Equal(bytes.TrimSpace(expected), bytes.TrimSpace(actual))
+}
+
+func matchHeaders(expected resources.HeaderList, actual http.Header, keys []string) bool {
+	for _, key := range keys {
+		want, ok := expected.Get(key)
+		if !ok || actual.Get(key) != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+// configHeaders returns the header names of a mock configuration, which may
+// be given as names or as header objects.
+func configHeaders(headers []interface{}) []string {
+	var keys []string
+	for _, h := range headers {
+		switch v := h.(type) {
+		case string:
+			keys = append(keys, v)
+		case map[string]interface{}:
+			if key, ok := v["key"].(string); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
+func isWildcard(s string) bool {
+	return (strings.HasPrefix(s, ":") && len(s) > 1) ||
+		(strings.HasPrefix(s, "{{") && strings.HasSuffix(s, "}}"))
+}
+
+// splitURL returns the path segments and the query of a URL. The host is
+// dropped, including an unresolved {{variable}} standing in for it.
+func splitURL(raw string) ([]string, url.Values) {
+	if i := strings.Index(raw, "#"); i >= 0 {
+		raw = raw[:i]
+	}
+
+	var query url.Values
+	if i := strings.Index(raw, "?"); i >= 0 {
+		// Keep what parses, as Postman does with malformed query strings.
+		query, _ = url.ParseQuery(raw[i+1:])
+		raw = raw[:i]
+	}
+
+	if i := strings.Index(raw, "://"); i >= 0 {
+		raw = raw[i+3:]
+	}
+
+	if !strings.HasPrefix(raw, "/") {
+		if i := strings.Index(raw, "/"); i >= 0 {
+			raw = raw[i:]
+		} else {
+			raw = ""
+		}
+	}
+
+	var path []string
+	for _, segment := range strings.Split(raw, "/") {
+		if segment == "" {
+			continue
+		}
+
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		path = append(path, segment)
+	}
+
+	return path, query
+}
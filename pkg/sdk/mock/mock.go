@@ -0,0 +1,244 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock serves the saved example responses of a Postman collection
+// locally, matching requests the way Postman mock servers do.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+// Headers that select or filter examples, as understood by Postman mock
+// servers.
+const (
+	HeaderResponseName      = "x-mock-response-name"
+	HeaderResponseID        = "x-mock-response-id"
+	HeaderResponseCode      = "x-mock-response-code"
+	HeaderMatchRequestBody  = "x-mock-match-request-body"
+	HeaderMatchRequestHeads = "x-mock-match-request-headers"
+)
+
+// Options configures a mock server.
+type Options struct {
+	// Config holds the matching rules, as set on a Postman mock server.
+	Config resources.MockConfig
+
+	// Environment provides variables for example URLs and response bodies.
+	Environment *resources.Environment
+
+	// OnRequest is called after each request is answered. The example is nil
+	// when no example matched.
+	OnRequest func(r *http.Request, e *Example)
+}
+
+// DefaultConfig returns the matching rules of a new Postman mock server.
+func DefaultConfig() resources.MockConfig {
+	return resources.MockConfig{
+		MatchQueryParams: true,
+		MatchWildcards:   true,
+	}
+}
+
+// Example is a saved response that the server can answer with.
+type Example struct {
+	// Item is the path of the request the example is saved on.
+	Item string
+
+	// Name and ID identify the example for the x-mock-response-name and
+	// x-mock-response-id headers.
+	Name string
+	ID   string
+
+	// Request is the original request of the example, or the request of the
+	// item when the example has none.
+	Request *resources.Request
+
+	// Response is the saved response.
+	Response *gen.Response
+
+	path  []string
+	query url.Values
+}
+
+// Code returns the status code of the saved response. It falls back to the
+// code in the status text and then to 200.
+func (e *Example) Code() int {
+	if e.Response.Code != 0 {
+		return e.Response.Code
+	}
+
+	if fields := strings.Fields(e.Response.Status); len(fields) > 0 {
+		if code, err := strconv.Atoi(fields[0]); err == nil {
+			return code
+		}
+	}
+
+	return http.StatusOK
+}
+
+// Server answers requests with the saved examples of a collection.
+type Server struct {
+	examples []*Example
+	options  Options
+	vars     *runner.Variables
+}
+
+// New creates a mock server for the examples saved in a collection.
+func New(c *resources.Collection, options Options) (*Server, error) {
+	vars := runner.NewVariables()
+	vars.Environment = runner.NewEnvironmentScope(options.Environment)
+	if c.Collection != nil {
+		vars.Collection = runner.NewCollectionScope(c.Variable)
+	}
+
+	s := &Server{options: options, vars: vars}
+	if c.Items == nil {
+		return s, nil
+	}
+
+	for _, ref := range c.Items.Requests() {
+		names := ref.Item.ResponseNames()
+		for i, resp := range ref.Item.Response {
+			if resp == nil {
+				continue
+			}
+
+			original := resp.OriginalRequest
+			if original == nil {
+				original = ref.Item.Request
+			}
+
+			req, err := resources.ParseRequest(original)
+			if err != nil {
+				return nil, fmt.Errorf("%s: example %q: %s", ref.FullPath(), names[i], err)
+			}
+
+			path, query := splitURL(vars.Replace(req.URL.String()))
+
+			s.examples = append(s.examples, &Example{
+				Item:     ref.FullPath(),
+				Name:     names[i],
+				ID:       resp.ID,
+				Request:  req,
+				Response: resp,
+				path:     path,
+				query:    query,
+			})
+		}
+	}
+
+	return s, nil
+}
+
+// Examples returns the examples the server answers with, in collection
+// order.
+func (s *Server) Examples() []*Example {
+	return append([]*Example{}, s.examples...)
+}
+
+// ServeHTTP answers a request with the best matching example, or with a 404
+// error when no example matches.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "mockRequestBodyError", err.Error())
+		return
+	}
+
+	e := s.Match(r, body)
+	if s.options.OnRequest != nil {
+		defer s.options.OnRequest(r, e)
+	}
+
+	if e == nil {
+		writeError(w, http.StatusNotFound, "mockRequestNotFoundError",
+			"Double check your method and the request path and try again.")
+		return
+	}
+
+	var headers resources.HeaderList
+	if e.Response.Header != nil {
+		b, err := json.Marshal(e.Response.Header)
+		if err == nil {
+			err = json.Unmarshal(b, &headers)
+		}
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "mockResponseError", err.Error())
+			return
+		}
+	}
+
+	for _, h := range headers {
+		if h.Disabled || skipResponseHeader(h.Key) {
+			continue
+		}
+		w.Header().Add(h.Key, s.vars.Replace(h.Value))
+	}
+
+	w.WriteHeader(e.Code())
+	fmt.Fprint(w, s.vars.Replace(responseBody(e.Response.Body)))
+}
+
+// skipResponseHeader reports whether a saved header no longer applies,
+// since the saved body is stored decoded and the server sets its own
+// framing.
+func skipResponseHeader(key string) bool {
+	switch strings.ToLower(key) {
+	case "content-length", "content-encoding", "transfer-encoding", "connection":
+		return true
+	}
+
+	return false
+}
+
+func writeError(w http.ResponseWriter, code int, name, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	b, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]string{
+			"name":    name,
+			"message": message,
+		},
+	})
+	_, _ = w.Write(b)
+}
+
+func responseBody(v interface{}) string {
+	switch body := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return body
+	default:
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Sprint(body)
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/mock"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func newServer(t *testing.T, config resources.MockConfig) *httptest.Server {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/mock.postman_collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	env := &resources.Environment{
+		Values: []resources.KeyValuePair{
+			{Key: "server", Value: "local", Enabled: true},
+		},
+	}
+
+	s, err := mock.New(&c, mock.Options{Config: config, Environment: env})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestMatch(t *testing.T) {
+	matchBody := func(c *resources.MockConfig) { c.MatchBody = true }
+
+	cases := []struct {
+		name    string
+		config  func(*resources.MockConfig)
+		method  string
+		path    string
+		headers map[string]string
+		body    string
+		code    int
+		want    string
+	}{
+		{name: "exact path", method: "GET", path: "/users", code: 200, want: `[{"id":1},{"id":2}]`},
+		{name: "query params", method: "GET", path: "/users?status=active", code: 200, want: `[{"id":1}]`},
+		{name: "different query param value", method: "GET", path: "/users?status=inactive", code: 200, want: `[{"id":1},{"id":2}]`},
+		{name: "case and trailing slash", method: "GET", path: "/Users/", code: 200, want: `[{"id":1},{"id":2}]`},
+		{name: "exact beats wildcard", method: "GET", path: "/users/999", code: 404},
+		{name: "wildcard", method: "GET", path: "/users/42", code: 200, want: `{"id":1,"server":"local"}`},
+		{name: "wildcards disabled", config: func(c *resources.MockConfig) { c.MatchWildcards = false }, method: "GET", path: "/users/42", code: 404,
+			want: `{"error":{"message":"Double check your method and the request path and try again.","name":"mockRequestNotFoundError"}}`},
+		{name: "response code header", method: "GET", path: "/users/999",
+			headers: map[string]string{"x-mock-response-code": "200"}, code: 200, want: `{"id":1,"server":"local"}`},
+		{name: "response name header", method: "POST", path: "/users",
+			headers: map[string]string{"x-mock-response-name": "Invalid"}, code: 400, want: "invalid"},
+		{name: "response id header", method: "GET", path: "/ignored",
+			headers: map[string]string{"x-mock-response-id": "active-users"}, code: 200, want: `[{"id":1}]`},
+		{name: "first example without body matching", method: "POST", path: "/users", body: `{"name":""}`, code: 201, want: "created"},
+		{name: "body", config: matchBody, method: "POST", path: "/users", body: `{"name":""}`, code: 400, want: "invalid"},
+		{name: "body header", method: "POST", path: "/users", body: `{"name": "Ada"}`,
+			headers: map[string]string{"x-mock-match-request-body": "true"}, code: 201, want: "created"},
+		{name: "body mismatch", config: matchBody, method: "POST", path: "/users", body: `{"name":"Bob"}`, code: 404},
+		{name: "request headers", config: func(c *resources.MockConfig) { c.Headers = []interface{}{"X-Tenant"} }, method: "POST", path: "/users",
+			headers: map[string]string{"X-Tenant": "other"}, code: 400, want: "invalid"},
+		{name: "request headers header", method: "POST", path: "/users",
+			headers: map[string]string{"X-Tenant": "nobody", "x-mock-match-request-headers": "x-tenant"}, code: 404},
+		{name: "method", method: "DELETE", path: "/users", code: 404},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := mock.DefaultConfig()
+			if c.config != nil {
+				c.config(&config)
+			}
+
+			server := newServer(t, config)
+
+			req, err := http.NewRequest(c.method, server.URL+c.path, strings.NewReader(c.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			b, err := ioutil.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.StatusCode != c.code {
+				t.Errorf("have status %d, want %d", res.StatusCode, c.code)
+			}
+
+			if c.want != "" && string(b) != c.want {
+				t.Errorf("have body %s, want %s", b, c.want)
+			}
+		})
+	}
+}
+
+func TestResponseHeaders(t *testing.T) {
+	server := newServer(t, mock.DefaultConfig())
+
+	res, err := http.Get(server.URL + "/users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if have := res.Header.Get("Content-Type"); have != "application/json" {
+		t.Errorf("have Content-Type %q, want application/json", have)
+	}
+
+	if have := res.Header.Get("Content-Length"); have != "19" {
+		t.Errorf("have Content-Length %q, want the length of the served body", have)
+	}
+}
+
+func TestExamples(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/mock.postman_collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := mock.New(&c, mock.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, e := range s.Examples() {
+		names = append(names, e.Item+"/"+e.Name)
+	}
+
+	want := "Users/List users/All users,Users/List users/Active users,Users/Get user/Found,Users/Get user/Not found,Users/Create user/Created,Users/Create user/Invalid"
+	if have := strings.Join(names, ","); have != want {
+		t.Errorf("have examples %s, want %s", have, want)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openapi reads OpenAPI documents, version 2 (Swagger) and 3, in
+// JSON or YAML.
+//
+// Documents are kept as the generic values they decode to, maps with string
+// keys, slices and scalars, so every part of them can be inspected.
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Methods are the HTTP methods operations can be defined for, in the order
+// they are listed.
+var Methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Document is an OpenAPI document.
+type Document struct {
+	// Version is the value of the "openapi" member, or of "swagger" for
+	// version 2 documents.
+	Version string
+
+	// Root is the decoded document.
+	Root map[string]interface{}
+
+	// Paths holds the keys of the "paths" member in document order.
+	Paths []string
+}
+
+// Parse reads a JSON or YAML document.
+func Parse(b []byte) (*Document, error) {
+	var root yaml.MapSlice
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+
+	d := &Document{}
+
+	v, err := convert(root)
+	if err != nil {
+		return nil, err
+	}
+	d.Root = v.(map[string]interface{})
+
+	for _, item := range root {
+		if item.Key != "paths" {
+			continue
+		}
+
+		if paths, ok := item.Value.(yaml.MapSlice); ok {
+			for _, p := range paths {
+				d.Paths = append(d.Paths, fmt.Sprint(p.Key))
+			}
+		}
+	}
+
+	// An unquoted "swagger: 2.0" is decoded as a number.
+	if v, ok := d.Root["openapi"]; ok && v != nil {
+		d.Version = fmt.Sprint(v)
+	} else if v, ok := d.Root["swagger"]; ok && v != nil {
+		d.Version = fmt.Sprint(v)
+	} else {
+		return nil, errors.New(`not an OpenAPI document, "openapi" or "swagger" is missing`)
+	}
+
+	return d, nil
+}
+
+// convert turns decoded YAML into the values encoding/json decodes to.
+func convert(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		m := make(map[string]interface{}, len(v))
+		for _, item := range v {
+			value, err := convert(item.Value)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(item.Key)] = value
+		}
+		return m, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			value, err := convert(item)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(k)] = value
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, item := range v {
+			value, err := convert(item)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = value
+		}
+		return s, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	}
+
+	return v, nil
+}
+
+// IsV2 reports whether the document is an OpenAPI 2 (Swagger) document.
+func (d *Document) IsV2() bool {
+	return strings.HasPrefix(d.Version, "2")
+}
+
+// Operation is an operation of a path.
+type Operation struct {
+	Path   string
+	Method string
+	Value  map[string]interface{}
+}
+
+// Operations returns the operations in document order.
+func (d *Document) Operations() []Operation {
+	paths, _ := d.Root["paths"].(map[string]interface{})
+
+	var ops []Operation
+	for _, p := range d.Paths {
+		item, ok := paths[p].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, m := range Methods {
+			if op, ok := item[m].(map[string]interface{}); ok {
+				ops = append(ops, Operation{Path: p, Method: m, Value: op})
+			}
+		}
+	}
+
+	return ops
+}
+
+// Resolve returns the value a local reference, such as
+// "#/components/schemas/Pet", points to.
+func (d *Document) Resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("%s: only references within the document are supported", ref)
+	}
+
+	pointer, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ref, err)
+	}
+
+	var v interface{} = d.Root
+	if pointer == "" {
+		return v, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%s: not a JSON pointer", ref)
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+
+		switch node := v.(type) {
+		case map[string]interface{}:
+			next, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%s: %q not found", ref, token)
+			}
+			v = next
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("%s: index %q not found", ref, token)
+			}
+			v = node[i]
+		default:
+			return nil, fmt.Errorf("%s: %q not found", ref, token)
+		}
+	}
+
+	return v, nil
+}
+
+// Walk calls fn for every value in the document, depth-first. The path
+// holds the keys and indexes leading to the value. Map keys are visited in
+// sorted order.
+func (d *Document) Walk(fn func(path []string, v interface{})) {
+	walk(nil, d.Root, fn)
+}
+
+func walk(path []string, v interface{}, fn func(path []string, v interface{})) {
+	fn(path, v)
+
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(node) {
+			walk(append(path[:len(path):len(path)], k), node[k], fn)
+		}
+	case []interface{}:
+		for i, item := range node {
+			walk(append(path[:len(path):len(path)], strconv.Itoa(i)), item, fn)
+		}
+	}
+}
+
+// Pointer returns the local reference to the value at path.
+func Pointer(path ...string) string {
+	var b strings.Builder
+	b.WriteString("#")
+	for _, p := range path {
+		b.WriteString("/")
+		b.WriteString(strings.Replace(strings.Replace(p, "~", "~0", -1), "/", "~1", -1))
+	}
+
+	return b.String()
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
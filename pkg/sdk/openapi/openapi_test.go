@@ -0,0 +1,136 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/openapi"
+)
+
+const swagger = `swagger: 2.0
+info:
+  title: Pets
+paths:
+  /pets/{id}:
+    get:
+      responses:
+        200:
+          schema:
+            $ref: '#/definitions/Pet'
+  /pets:
+    post:
+      responses: {}
+    get:
+      responses: {}
+definitions:
+  Pet:
+    type: object
+    properties:
+      a/b:
+        type: string
+`
+
+func TestParse(t *testing.T) {
+	d, err := openapi.Parse([]byte(swagger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Version != "2" || !d.IsV2() {
+		t.Errorf("have version %q, want a version 2 document", d.Version)
+	}
+
+	if want := []string{"/pets/{id}", "/pets"}; !reflect.DeepEqual(d.Paths, want) {
+		t.Errorf("have paths %v, want %v", d.Paths, want)
+	}
+
+	var ops []string
+	for _, op := range d.Operations() {
+		ops = append(ops, op.Method+" "+op.Path)
+	}
+	if want := []string{"get /pets/{id}", "get /pets", "post /pets"}; !reflect.DeepEqual(ops, want) {
+		t.Errorf("have operations %v, want %v", ops, want)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	d, err := openapi.Parse([]byte(`{"openapi": "3.0.3", "paths": {"/b": {}, "/a": {}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Version != "3.0.3" || d.IsV2() {
+		t.Errorf("have version %q, want 3.0.3", d.Version)
+	}
+
+	if want := []string{"/b", "/a"}; !reflect.DeepEqual(d.Paths, want) {
+		t.Errorf("have paths %v, want %v", d.Paths, want)
+	}
+
+	if _, err := openapi.Parse([]byte(`{"info": {}}`)); err == nil {
+		t.Error("have no error for a document without a version")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	d, err := openapi.Parse([]byte(swagger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref := openapi.Pointer("definitions", "Pet", "properties", "a/b")
+	if ref != "#/definitions/Pet/properties/a~1b" {
+		t.Errorf("have pointer %q", ref)
+	}
+
+	v, err := d.Resolve(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]interface{}{"type": "string"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("have %v, want %v", v, want)
+	}
+
+	for _, ref := range []string{"#/definitions/Owner", "#/info/title/x", "other.yaml#/Pet"} {
+		if _, err := d.Resolve(ref); err == nil {
+			t.Errorf("have no error resolving %s", ref)
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	d, err := openapi.Parse([]byte(swagger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var refs []string
+	d.Walk(func(path []string, v interface{}) {
+		if m, ok := v.(map[string]interface{}); ok {
+			if ref, ok := m["$ref"].(string); ok {
+				refs = append(refs, openapi.Pointer(path...)+" "+ref)
+			}
+		}
+	})
+
+	want := []string{"#/paths/~1pets~1{id}/get/responses/200/schema #/definitions/Pet"}
+	if !reflect.DeepEqual(refs, want) {
+		t.Errorf("have refs %v, want %v", refs, want)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package patch changes the JSON representation of Postman resources. It
+// supports JSON Patch (RFC 6902), JSON Merge Patch (RFC 7386) and a
+// "key.path=value" shorthand.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+)
+
+// Func changes a JSON document. It may be called more than once, so it
+// must not have side effects.
+type Func func(doc []byte) ([]byte, error)
+
+// JSONPatch returns a Func that applies a JSON Patch document, which is an
+// array of operations such as {"op": "replace", "path": "/name", "value": "x"}.
+func JSONPatch(p []byte) (Func, error) {
+	ops, err := jsonpatch.DecodePatch(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %s", err)
+	}
+
+	return func(doc []byte) ([]byte, error) {
+		return ops.Apply(doc)
+	}, nil
+}
+
+// MergePatch returns a Func that applies a JSON Merge Patch document. Members
+// of the patch replace those of the document and members set to null are
+// removed.
+func MergePatch(p []byte) (Func, error) {
+	var v map[string]interface{}
+	if err := json.Unmarshal(p, &v); err != nil {
+		return nil, fmt.Errorf("invalid merge patch, it must be a JSON object: %s", err)
+	}
+
+	return func(doc []byte) ([]byte, error) {
+		return jsonpatch.MergePatch(doc, p)
+	}, nil
+}
+
+// Set returns a Func that sets values by path, given assignments such as
+// "schedule.cron=0 0 * * *" or "values.0.value=example.com". Numbers in a
+// path index arrays, and a dot that's part of a name is escaped as "\.".
+//
+// A value replacing a string is always set as a string. Otherwise it's
+// decoded as JSON when it's valid JSON and set as a string when it's not.
+func Set(assignments []string) (Func, error) {
+	type assignment struct {
+		path  []string
+		value string
+	}
+
+	parsed := make([]assignment, len(assignments))
+	for i, a := range assignments {
+		eq := strings.Index(a, "=")
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid assignment %q, want key.path=value", a)
+		}
+
+		parsed[i] = assignment{path: splitPath(a[:eq]), value: a[eq+1:]}
+	}
+
+	return func(doc []byte) ([]byte, error) {
+		d := json.NewDecoder(bytes.NewReader(doc))
+		d.UseNumber()
+
+		var v interface{}
+		if err := d.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		for _, a := range parsed {
+			var err error
+			if v, err = set(v, a.path, a.value); err != nil {
+				return nil, fmt.Errorf("unable to set %s: %s", strings.Join(a.path, "."), err)
+			}
+		}
+
+		return json.Marshal(v)
+	}, nil
+}
+
+func set(node interface{}, path []string, value string) (interface{}, error) {
+	if len(path) == 0 {
+		return decodeValue(node, value), nil
+	}
+
+	key, rest := path[0], path[1:]
+	switch n := node.(type) {
+	case map[string]interface{}:
+		v, err := set(n[key], rest, value)
+		if err != nil {
+			return nil, err
+		}
+
+		n[key] = v
+		return n, nil
+	case []interface{}:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i > len(n) {
+			return nil, fmt.Errorf("%q is not an index of an array of %d elements", key, len(n))
+		}
+
+		if i == len(n) {
+			n = append(n, nil)
+		}
+
+		v, err := set(n[i], rest, value)
+		if err != nil {
+			return nil, err
+		}
+
+		n[i] = v
+		return n, nil
+	case nil:
+		v, err := set(map[string]interface{}{}, path, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("%q is not an object or an array", key)
+}
+
+func decodeValue(current interface{}, value string) interface{} {
+	if _, ok := current.(string); ok {
+		return value
+	}
+
+	d := json.NewDecoder(strings.NewReader(value))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil || d.More() {
+		return value
+	}
+
+	return v
+}
+
+func splitPath(s string) []string {
+	var (
+		path []string
+		b    strings.Builder
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == '.':
+			b.WriteByte('.')
+			i++
+		case s[i] == '.':
+			path = append(path, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return append(path, b.String())
+}
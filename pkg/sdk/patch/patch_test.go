@@ -0,0 +1,145 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patch_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+)
+
+const monitor = `{
+  "name": "Nightly",
+  "schedule": {"cron": "0 0 * * *", "timezone": "UTC"},
+  "options": {"requestTimeout": 5000, "strictSSL": true},
+  "values": [{"key": "port", "value": "8080"}]
+}`
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		assignments []string
+		want        string
+	}{
+		{
+			"string",
+			[]string{"schedule.cron=0 6 * * *"},
+			`{"name":"Nightly","schedule":{"cron":"0 6 * * *","timezone":"UTC"},"options":{"requestTimeout":5000,"strictSSL":true},"values":[{"key":"port","value":"8080"}]}`,
+		},
+		{
+			"keeps the type of the value it replaces",
+			[]string{"options.requestTimeout=1000", "options.strictSSL=false", "values.0.value=9090"},
+			`{"name":"Nightly","schedule":{"cron":"0 0 * * *","timezone":"UTC"},"options":{"requestTimeout":1000,"strictSSL":false},"values":[{"key":"port","value":"9090"}]}`,
+		},
+		{
+			"new members and elements",
+			[]string{"options.followRedirects=true", "values.1.key=host", "a\\.b.c=x"},
+			`{"name":"Nightly","schedule":{"cron":"0 0 * * *","timezone":"UTC"},"options":{"requestTimeout":5000,"strictSSL":true,"followRedirects":true},"values":[{"key":"port","value":"8080"},{"key":"host"}],"a.b":{"c":"x"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := patch.Set(tt.assignments)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			have, err := fn([]byte(monitor))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertJSONEqual(t, have, tt.want)
+		})
+	}
+}
+
+func TestSetErrors(t *testing.T) {
+	if _, err := patch.Set([]string{"name"}); err == nil {
+		t.Error("have no error for an assignment without a value, want one")
+	}
+
+	for _, a := range []string{"values.5.key=host", "values.key=host", "name.first=x"} {
+		fn, err := patch.Set([]string{a})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := fn([]byte(monitor)); err == nil {
+			t.Errorf("have no error for %s, want one", a)
+		}
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	fn, err := patch.JSONPatch([]byte(`[
+		{"op": "replace", "path": "/schedule/cron", "value": "0 6 * * *"},
+		{"op": "remove", "path": "/options"},
+		{"op": "add", "path": "/values/-", "value": {"key": "host", "value": "localhost"}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := fn([]byte(monitor))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertJSONEqual(t, have, `{"name":"Nightly","schedule":{"cron":"0 6 * * *","timezone":"UTC"},"values":[{"key":"port","value":"8080"},{"key":"host","value":"localhost"}]}`)
+
+	if _, err := patch.JSONPatch([]byte(`{"op": "remove"}`)); err == nil {
+		t.Error("have no error for a patch that isn't an array, want one")
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	fn, err := patch.MergePatch([]byte(`{"schedule": {"cron": "0 6 * * *"}, "options": null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := fn([]byte(monitor))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertJSONEqual(t, have, `{"name":"Nightly","schedule":{"cron":"0 6 * * *","timezone":"UTC"},"values":[{"key":"port","value":"8080"}]}`)
+
+	if _, err := patch.MergePatch([]byte(`[]`)); err == nil {
+		t.Error("have no error for a patch that isn't an object, want one")
+	}
+}
+
+func assertJSONEqual(t *testing.T, have []byte, want string) {
+	t.Helper()
+
+	var h, w interface{}
+	if err := json.Unmarshal(have, &h); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(h, w) {
+		t.Errorf("have %s, want %s", have, want)
+	}
+}
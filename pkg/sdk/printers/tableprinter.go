@@ -73,8 +73,12 @@ func (p *TablePrinter) PrintResource(r resources.Formatter, output io.Writer) {
 		vals := make([]string, len(cols))
 		for i, c := range cols {
 			rVal := reflect.ValueOf(obj)
-			f := reflect.Indirect(rVal).FieldByName(c).String()
-			vals[i] = f
+			f := reflect.Indirect(rVal).FieldByName(c)
+			if f.Kind() == reflect.String {
+				vals[i] = f.String()
+			} else {
+				vals[i] = fmt.Sprint(f.Interface())
+			}
 		}
 
 		fmt.Fprintln(w, strings.Join(vals, "\t"))
@@ -0,0 +1,139 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/printers"
+)
+
+// CLIReporter writes a human-readable report like the one printed by
+// newman.
+type CLIReporter struct{}
+
+// Report writes the report.
+func (r *CLIReporter) Report(w io.Writer, run *Run) error {
+	s := NewCLIStream(w, run.Name, run.Iterations)
+	for _, e := range run.Executions {
+		s.Execution(e)
+	}
+
+	return WriteSummary(w, run)
+}
+
+// CLIStream writes the cli report while a run progresses, one execution at
+// a time. The report ends with WriteSummary once the run is over.
+type CLIStream struct {
+	w          io.Writer
+	iterations int
+	iteration  int
+}
+
+// NewCLIStream writes the name of the run and returns a stream for its
+// executions.
+func NewCLIStream(w io.Writer, name string, iterations int) *CLIStream {
+	if name != "" {
+		fmt.Fprintln(w, name)
+	}
+
+	return &CLIStream{w: w, iterations: iterations, iteration: -1}
+}
+
+// Execution writes an execution, preceded by the number of its iteration
+// when it starts one.
+func (s *CLIStream) Execution(e Execution) {
+	if s.iterations > 1 && e.Iteration != s.iteration {
+		s.iteration = e.Iteration
+		fmt.Fprintf(s.w, "\nIteration %d/%d\n", s.iteration+1, s.iterations)
+	}
+
+	writeExecution(s.w, e)
+}
+
+// WriteSummary writes a table of the executed and failed requests and
+// assertions of a run.
+func WriteSummary(w io.Writer, run *Run) error {
+	s := run.Stats
+	tw := printers.GetNewTabWriter(w)
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "\tEXECUTED\tFAILED")
+	if run.Iterations > 0 {
+		fmt.Fprintf(tw, "iterations\t%d\t%d\n", run.Iterations, s.FailedIterations)
+	}
+	fmt.Fprintf(tw, "requests\t%d\t%d\n", s.Requests, s.FailedRequests)
+	fmt.Fprintf(tw, "assertions\t%d\t%d\n", s.Assertions, s.FailedAssertions)
+	if s.ScriptErrors > 0 {
+		fmt.Fprintf(tw, "script errors\t%d\t%d\n", s.ScriptErrors, s.ScriptErrors)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	if run.Status != "" {
+		fmt.Fprintf(w, "status: %s\n", run.Status)
+	}
+
+	_, err := fmt.Fprintf(w, "total run duration: %s\n", run.Duration.Round(time.Millisecond))
+	return err
+}
+
+func writeExecution(w io.Writer, e Execution) {
+	fmt.Fprintf(w, "\n→ %s\n", e.Name)
+
+	for _, m := range e.Console {
+		fmt.Fprintf(w, "  [%s] %s\n", m.Level, m.Message)
+	}
+
+	if e.Error != "" {
+		fmt.Fprintf(w, "  %s %s [errored]\n", e.Method, e.URL)
+		fmt.Fprintf(w, "  error: %s\n", e.Error)
+	} else {
+		status := fmt.Sprint(e.Code)
+		if e.Status != "" {
+			status += " " + e.Status
+		}
+		fmt.Fprintf(w, "  %s %s [%s, %s, %s]\n", e.Method, e.URL, status,
+			formatBytes(e.ResponseSize), e.ResponseTime.Round(time.Millisecond))
+	}
+
+	for _, err := range e.ScriptErrors {
+		fmt.Fprintf(w, "  ✗ %s\n", err)
+	}
+
+	for _, a := range e.Assertions {
+		switch {
+		case a.Skipped:
+			fmt.Fprintf(w, "  - %s (skipped)\n", a.Name)
+		case a.Error == "":
+			fmt.Fprintf(w, "  ✓ %s\n", a.Name)
+		default:
+			fmt.Fprintf(w, "  ✗ %s: %s\n", a.Name, a.Error)
+		}
+	}
+}
+
+func formatBytes(n int) string {
+	if n < 1024 {
+		return fmt.Sprintf("%dB", n)
+	}
+
+	return fmt.Sprintf("%.2fKB", float64(n)/1024)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+// FromSummary converts the result of a local collection run.
+func FromSummary(s *runner.Summary) *Run {
+	run := &Run{
+		Name:       s.Collection,
+		Started:    s.Started,
+		Duration:   s.Duration,
+		Iterations: s.Iterations,
+		Executions: make([]Execution, len(s.Executions)),
+	}
+
+	for i, e := range s.Executions {
+		run.Executions[i] = FromExecution(e)
+	}
+
+	run.Stats = computeStats(run.Executions)
+
+	return run
+}
+
+// FromExecution converts a request executed by a local collection run.
+func FromExecution(e *runner.Execution) Execution {
+	x := Execution{
+		Name:         e.Name(),
+		Iteration:    e.Iteration,
+		ResponseTime: e.Duration,
+	}
+
+	if e.Item.Item != nil && e.Item.Item.Item != nil {
+		x.ID = e.Item.Item.ID
+	}
+
+	if e.Request != nil {
+		x.Method, x.URL = e.Request.Method, e.Request.URL.String()
+	}
+
+	if e.Response != nil {
+		x.Code = e.Response.Code
+		x.Status = strings.TrimSpace(strings.TrimPrefix(e.Response.Status, strconv.Itoa(e.Response.Code)))
+		x.ResponseSize = len(e.Response.Body)
+	}
+
+	if e.Error != nil {
+		x.Error = e.Error.Error()
+	}
+
+	for _, err := range e.ScriptErrors {
+		x.ScriptErrors = append(x.ScriptErrors, err.Error())
+	}
+
+	for _, t := range e.Tests {
+		x.Assertions = append(x.Assertions, Assertion{Name: t.Name, Skipped: t.Skipped, Error: t.Error})
+	}
+
+	for _, m := range e.Console {
+		x.Console = append(x.Console, ConsoleMessage{Level: m.Level, Message: m.Message})
+	}
+
+	return x
+}
+
+// FromMonitorRun converts the result of a monitor run. Monitor runs only
+// report failed assertions, so the assertion totals are taken from the run
+// stats.
+func FromMonitorRun(m *resources.MonitorRun) *Run {
+	run := &Run{
+		Name:       m.Info.Name,
+		Status:     m.Info.Status,
+		Started:    m.Info.StartedAt,
+		Iterations: 1,
+		Executions: make([]Execution, 0, len(m.Executions)),
+	}
+
+	if !m.Info.FinishedAt.IsZero() && !m.Info.StartedAt.IsZero() {
+		run.Duration = m.Info.FinishedAt.Sub(m.Info.StartedAt)
+	}
+
+	known := make(map[int]bool)
+	for _, e := range m.Executions {
+		known[e.ID] = true
+
+		x := Execution{
+			ID:           e.Item.ID,
+			Name:         e.Item.Name,
+			Method:       e.Request.Method,
+			URL:          e.Request.URL,
+			Code:         e.Response.Code,
+			ResponseTime: time.Duration(e.Response.ResponseTime) * time.Millisecond,
+			ResponseSize: e.Response.ResponseSize,
+		}
+
+		for _, f := range m.ExecutionFailures(e.ID) {
+			x.Assertions = append(x.Assertions, monitorAssertion(f))
+		}
+
+		run.Executions = append(run.Executions, x)
+	}
+
+	for _, f := range m.Failures {
+		if known[f.ExecutionID] {
+			continue
+		}
+
+		run.Executions = append(run.Executions, Execution{
+			Name:       fmt.Sprintf("execution %d", f.ExecutionID),
+			Assertions: []Assertion{monitorAssertion(f)},
+		})
+	}
+
+	run.Stats = computeStats(run.Executions)
+	run.Stats.Requests = m.Stats.Requests.Total
+	run.Stats.FailedRequests = m.Stats.Requests.Failed
+	if m.Stats.Assertions.Total > 0 {
+		run.Stats.Assertions = m.Stats.Assertions.Total
+		run.Stats.FailedAssertions = m.Stats.Assertions.Failed
+	}
+
+	return run
+}
+
+func monitorAssertion(f resources.MonitorRunFailure) Assertion {
+	a := Assertion{Name: f.Assertion, Error: f.Message}
+	if a.Name == "" {
+		a.Name = f.Name
+	}
+	if a.Error == "" {
+		a.Error = f.Name
+	}
+	if a.Error == "" {
+		a.Error = "failed"
+	}
+
+	return a
+}
@@ -0,0 +1,86 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"html/template"
+	"io"
+	"time"
+)
+
+// HTMLReporter writes a self-contained HTML page.
+type HTMLReporter struct{}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"ms": func(d time.Duration) string {
+		return d.Round(time.Millisecond).String()
+	},
+	"name": executionName,
+	"inc": func(i int) int {
+		return i + 1
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{with .Name}}{{.}} - {{end}}postmanctl run report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ddd; padding: 4px 10px; text-align: left; }
+th { background: #f5f5f5; }
+.execution { border: 1px solid #ddd; border-left-width: 6px; border-left-color: #2e7d32; padding: 0.5em 1em; margin-bottom: 1em; }
+.execution.failed { border-left-color: #c62828; }
+.pass { color: #2e7d32; }
+.fail { color: #c62828; }
+.skip { color: #888; }
+code { font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>{{with .Name}}{{.}}{{else}}Run report{{end}}</h1>
+<p>{{if not .Started.IsZero}}Started {{.Started.UTC.Format "2006-01-02 15:04:05 MST"}}, {{end}}total run duration {{ms .Duration}}</p>
+<table>
+<tr><th></th><th>Executed</th><th>Failed</th></tr>
+{{if gt .Iterations 0}}<tr><td>Iterations</td><td>{{.Iterations}}</td><td>{{.Stats.FailedIterations}}</td></tr>{{end}}
+<tr><td>Requests</td><td>{{.Stats.Requests}}</td><td>{{.Stats.FailedRequests}}</td></tr>
+<tr><td>Assertions</td><td>{{.Stats.Assertions}}</td><td>{{.Stats.FailedAssertions}}</td></tr>
+<tr><td>Skipped tests</td><td>{{.Stats.SkippedTests}}</td><td></td></tr>
+<tr><td>Script errors</td><td>{{.Stats.ScriptErrors}}</td><td>{{.Stats.ScriptErrors}}</td></tr>
+</table>
+{{$run := .}}{{range .Executions}}
+<div class="execution{{if .Failed}} failed{{end}}">
+<h2>{{name $run .}}</h2>
+<p><code>{{.Method}} {{.URL}}</code></p>
+{{if .Error}}<p class="fail">Error: {{.Error}}</p>{{else}}<p>{{.Code}} {{.Status}}, {{.ResponseSize}} B, {{ms .ResponseTime}}</p>{{end}}
+{{range .ScriptErrors}}<p class="fail">{{.}}</p>
+{{end}}{{if .Assertions}}<table>
+<tr><th>#</th><th>Assertion</th><th>Result</th></tr>
+{{range $i, $a := .Assertions}}<tr><td>{{inc $i}}</td><td>{{$a.Name}}</td>{{if $a.Skipped}}<td class="skip">skipped</td>{{else if $a.Error}}<td class="fail">{{$a.Error}}</td>{{else}}<td class="pass">passed</td>{{end}}</tr>
+{{end}}</table>{{end}}
+{{if .Console}}<pre>{{range .Console}}[{{.Level}}] {{.Message}}
+{{end}}</pre>{{end}}
+</div>
+{{end}}
+</body>
+</html>
+`))
+
+// Report writes the report.
+func (r *HTMLReporter) Report(w io.Writer, run *Run) error {
+	return htmlTemplate.Execute(w, run)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// JSONReporter writes the run as JSON. Durations are in milliseconds.
+type JSONReporter struct{}
+
+type jsonRun struct {
+	Name       string          `json:"name"`
+	Started    time.Time       `json:"started"`
+	Duration   int64           `json:"duration"`
+	Iterations int             `json:"iterations"`
+	Stats      jsonStats       `json:"stats"`
+	Executions []jsonExecution `json:"executions"`
+}
+
+type jsonStats struct {
+	Requests     jsonCount `json:"requests"`
+	Assertions   jsonCount `json:"assertions"`
+	Skipped      int       `json:"skipped"`
+	ScriptErrors int       `json:"scriptErrors"`
+}
+
+type jsonCount struct {
+	Total  int `json:"total"`
+	Failed int `json:"failed"`
+}
+
+type jsonExecution struct {
+	ID           string          `json:"id,omitempty"`
+	Name         string          `json:"name"`
+	Iteration    int             `json:"iteration"`
+	Method       string          `json:"method"`
+	URL          string          `json:"url"`
+	Code         int             `json:"code,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	ResponseTime int64           `json:"responseTime"`
+	ResponseSize int             `json:"responseSize"`
+	Error        string          `json:"error,omitempty"`
+	ScriptErrors []string        `json:"scriptErrors,omitempty"`
+	Assertions   []jsonAssertion `json:"assertions"`
+}
+
+type jsonAssertion struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Report writes the report.
+func (r *JSONReporter) Report(w io.Writer, run *Run) error {
+	out := jsonRun{
+		Name:       run.Name,
+		Started:    run.Started,
+		Duration:   run.Duration.Milliseconds(),
+		Iterations: run.Iterations,
+		Stats: jsonStats{
+			Requests:     jsonCount{Total: run.Stats.Requests, Failed: run.Stats.FailedRequests},
+			Assertions:   jsonCount{Total: run.Stats.Assertions, Failed: run.Stats.FailedAssertions},
+			Skipped:      run.Stats.SkippedTests,
+			ScriptErrors: run.Stats.ScriptErrors,
+		},
+		Executions: make([]jsonExecution, len(run.Executions)),
+	}
+
+	for i, e := range run.Executions {
+		x := jsonExecution{
+			ID:           e.ID,
+			Name:         e.Name,
+			Iteration:    e.Iteration,
+			Method:       e.Method,
+			URL:          e.URL,
+			Code:         e.Code,
+			Status:       e.Status,
+			ResponseTime: e.ResponseTime.Milliseconds(),
+			ResponseSize: e.ResponseSize,
+			Error:        e.Error,
+			ScriptErrors: e.ScriptErrors,
+			Assertions:   make([]jsonAssertion, len(e.Assertions)),
+		}
+
+		for j, a := range e.Assertions {
+			x.Assertions[j] = jsonAssertion{
+				Name:    a.Name,
+				Passed:  !a.Skipped && a.Error == "",
+				Skipped: a.Skipped,
+				Error:   a.Error,
+			}
+		}
+
+		out.Executions[i] = x
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// JUnitReporter writes a JUnit XML report. Each execution is a test suite
+// and each assertion a test case, following newman's JUnit reporter.
+type JUnitReporter struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	ID        string          `xml:"id,attr,omitempty"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Type    string `xml:"type,attr"`
+	Message string `xml:"message,attr"`
+	Text    string `xml:",cdata"`
+}
+
+// Report writes the report.
+func (r *JUnitReporter) Report(w io.Writer, run *Run) error {
+	out := junitTestSuites{
+		Name: run.Name,
+		Time: junitTime(run.Duration),
+	}
+
+	for _, e := range run.Executions {
+		suite := junitTestSuite{
+			Name: executionName(run, e),
+			ID:   e.ID,
+			Time: junitTime(e.ResponseTime),
+		}
+		if !run.Started.IsZero() {
+			suite.Timestamp = run.Started.UTC().Format(time.RFC3339)
+		}
+
+		className := run.Name
+		if className == "" {
+			className = e.Name
+		}
+
+		if e.Error != "" {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      e.Name,
+				ClassName: className,
+				Time:      junitTime(e.ResponseTime),
+				Error:     &junitMessage{Type: "RequestError", Message: e.Error, Text: e.Error},
+			})
+			suite.Errors++
+		}
+
+		for _, err := range e.ScriptErrors {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      e.Name,
+				ClassName: className,
+				Time:      junitTime(0),
+				Error:     &junitMessage{Type: "ScriptError", Message: err, Text: err},
+			})
+			suite.Errors++
+		}
+
+		for _, a := range e.Assertions {
+			c := junitTestCase{
+				Name:      a.Name,
+				ClassName: className,
+				Time:      junitTime(e.ResponseTime),
+			}
+
+			switch {
+			case a.Skipped:
+				c.Skipped = &struct{}{}
+				suite.Skipped++
+			case a.Error != "":
+				c.Failure = &junitMessage{Type: "AssertionFailure", Message: a.Error, Text: a.Error}
+				suite.Failures++
+			}
+
+			suite.Cases = append(suite.Cases, c)
+		}
+
+		if len(suite.Cases) == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      e.Name,
+				ClassName: className,
+				Time:      junitTime(e.ResponseTime),
+			})
+		}
+
+		suite.Tests = len(suite.Cases)
+		out.Tests += suite.Tests
+		out.Failures += suite.Failures
+		out.Errors += suite.Errors
+		out.Suites = append(out.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,87 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// Redact replaces every occurrence of the secrets in the run with
+// resources.SecretMask. Secrets end up in URLs, errors and console output
+// once variables are resolved, so reports are redacted before they are
+// written. URLs are also searched for the escaped form of each secret.
+func (r *Run) Redact(secrets []string) {
+	replacer := newRedactor(secrets)
+	if replacer == nil {
+		return
+	}
+
+	for i := range r.Executions {
+		r.Executions[i].redact(replacer)
+	}
+}
+
+// Redact replaces the secrets in a single execution, the way Run.Redact
+// does, for reports written while a run progresses.
+func (e *Execution) Redact(secrets []string) {
+	if replacer := newRedactor(secrets); replacer != nil {
+		e.redact(replacer)
+	}
+}
+
+func newRedactor(secrets []string) *strings.Replacer {
+	var oldnew []string
+	for _, s := range secrets {
+		if s == "" {
+			continue
+		}
+		oldnew = append(oldnew, s, resources.SecretMask)
+		if q := url.QueryEscape(s); q != s {
+			oldnew = append(oldnew, q, resources.SecretMask)
+		}
+		if p := url.PathEscape(s); p != s && p != url.QueryEscape(s) {
+			oldnew = append(oldnew, p, resources.SecretMask)
+		}
+	}
+
+	if len(oldnew) == 0 {
+		return nil
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+func (e *Execution) redact(replacer *strings.Replacer) {
+	e.URL = replacer.Replace(e.URL)
+	e.Error = replacer.Replace(e.Error)
+
+	for j := range e.ScriptErrors {
+		e.ScriptErrors[j] = replacer.Replace(e.ScriptErrors[j])
+	}
+
+	for j := range e.Assertions {
+		e.Assertions[j].Name = replacer.Replace(e.Assertions[j].Name)
+		e.Assertions[j].Error = replacer.Replace(e.Assertions[j].Error)
+	}
+
+	for j := range e.Console {
+		e.Console[j].Message = replacer.Replace(e.Console[j].Message)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reporters writes the results of collection and monitor runs in
+// formats such as JUnit XML, TAP and HTML.
+package reporters
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Reporter writes a report of a run.
+type Reporter interface {
+	Report(w io.Writer, run *Run) error
+}
+
+var reporters = map[string]func() Reporter{
+	"cli":   func() Reporter { return &CLIReporter{} },
+	"html":  func() Reporter { return &HTMLReporter{} },
+	"json":  func() Reporter { return &JSONReporter{} },
+	"junit": func() Reporter { return &JUnitReporter{} },
+	"tap":   func() Reporter { return &TAPReporter{} },
+}
+
+// New returns the reporter with the given name.
+func New(name string) (Reporter, error) {
+	r, ok := reporters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown reporter: %s", name)
+	}
+
+	return r(), nil
+}
+
+// Names returns the names of the available reporters.
+func Names() []string {
+	names := make([]string, 0, len(reporters))
+	for k := range reporters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Run is the result of running a collection, locally or as a monitor.
+type Run struct {
+	Name string
+
+	// Status is the status reported for a monitor run, such as "success",
+	// "failed" or "error".
+	Status     string
+	Started    time.Time
+	Duration   time.Duration
+	Iterations int
+	Executions []Execution
+	Stats      Stats
+}
+
+// Stats holds the totals of a run.
+type Stats struct {
+	FailedIterations int
+	Requests         int
+	FailedRequests   int
+	Assertions       int
+	FailedAssertions int
+	SkippedTests     int
+	ScriptErrors     int
+}
+
+// Failed reports whether any request, assertion or script failed.
+func (s Stats) Failed() bool {
+	return s.FailedRequests > 0 || s.FailedAssertions > 0 || s.ScriptErrors > 0
+}
+
+// Thresholds decide whether a run counts as failed.
+type Thresholds struct {
+	// MaxFailures is the number of failed assertions tolerated.
+	MaxFailures int
+
+	// FailOnError fails the run when a request could not be sent, a script
+	// threw an error or the run itself errored.
+	FailOnError bool
+}
+
+// Check returns an error describing why the run failed, or nil when it
+// passed.
+func (t Thresholds) Check(run *Run) error {
+	s := run.Stats
+
+	if t.FailOnError {
+		if run.Status == "error" {
+			return errors.New("the run errored")
+		}
+
+		if s.FailedRequests > 0 {
+			return fmt.Errorf("%d of %d requests errored", s.FailedRequests, s.Requests)
+		}
+
+		if s.ScriptErrors > 0 {
+			return fmt.Errorf("%d scripts errored", s.ScriptErrors)
+		}
+	}
+
+	if s.FailedAssertions > t.MaxFailures {
+		return fmt.Errorf("%d of %d assertions failed, the maximum is %d", s.FailedAssertions, s.Assertions, t.MaxFailures)
+	}
+
+	return nil
+}
+
+// Execution is a single request sent during a run.
+type Execution struct {
+	ID           string
+	Name         string
+	Iteration    int
+	Method       string
+	URL          string
+	Code         int
+	Status       string
+	ResponseTime time.Duration
+	ResponseSize int
+
+	// Error is set when the request could not be sent.
+	Error        string
+	ScriptErrors []string
+	Assertions   []Assertion
+	Console      []ConsoleMessage
+}
+
+// Failed reports whether the request, a script or an assertion failed.
+func (e Execution) Failed() bool {
+	if e.Error != "" || len(e.ScriptErrors) > 0 {
+		return true
+	}
+
+	for _, a := range e.Assertions {
+		if a.Error != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Assertion is the outcome of a single test.
+type Assertion struct {
+	Name    string
+	Skipped bool
+
+	// Error is the failure message of a failed assertion.
+	Error string
+}
+
+// ConsoleMessage is a message logged by a script.
+type ConsoleMessage struct {
+	Level   string
+	Message string
+}
+
+// computeStats totals the executions of a run.
+func computeStats(executions []Execution) Stats {
+	var s Stats
+	failed := make(map[int]bool)
+	for _, e := range executions {
+		if e.Failed() && !failed[e.Iteration] {
+			failed[e.Iteration] = true
+			s.FailedIterations++
+		}
+
+		s.Requests++
+		if e.Error != "" {
+			s.FailedRequests++
+		}
+		s.ScriptErrors += len(e.ScriptErrors)
+
+		for _, a := range e.Assertions {
+			if a.Skipped {
+				s.SkippedTests++
+				continue
+			}
+
+			s.Assertions++
+			if a.Error != "" {
+				s.FailedAssertions++
+			}
+		}
+	}
+
+	return s
+}
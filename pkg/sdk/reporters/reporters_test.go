@@ -0,0 +1,230 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/reporters"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func testRun() *reporters.Run {
+	executions := []reporters.Execution{
+		{
+			ID:           "a7e3c1f4-8b2d-4c59-b6e0-2f9d1a3c5e87",
+			Name:         "pets/Create a pet",
+			Method:       "POST",
+			URL:          "http://localhost/pets",
+			Code:         201,
+			Status:       "Created",
+			ResponseTime: 42 * time.Millisecond,
+			ResponseSize: 2048,
+			Assertions: []reporters.Assertion{
+				{Name: "status is 201"},
+				{Name: "has an <id> & name", Error: "expected { name: 'Rex' } to have property 'id'"},
+				{Name: "later", Skipped: true},
+			},
+			Console: []reporters.ConsoleMessage{{Level: "log", Message: "created"}},
+		},
+		{
+			Name:         "List all pets",
+			Method:       "GET",
+			URL:          "http://localhost/pets",
+			Error:        "dial tcp: connection refused",
+			ScriptErrors: []string{"prerequest script: ReferenceError: token is not defined"},
+		},
+		{
+			Name:         "pets/{petId}/Info for a specific pet",
+			Method:       "GET",
+			URL:          "http://localhost/pets/1",
+			Code:         200,
+			Status:       "OK",
+			ResponseTime: 7 * time.Millisecond,
+			ResponseSize: 17,
+		},
+	}
+
+	return &reporters.Run{
+		Name:       "Petstore",
+		Started:    time.Date(2020, 5, 13, 10, 0, 0, 0, time.UTC),
+		Duration:   1500 * time.Millisecond,
+		Iterations: 1,
+		Executions: executions,
+		Stats: reporters.Stats{
+			FailedIterations: 1,
+			Requests:         3,
+			FailedRequests:   1,
+			Assertions:       2,
+			FailedAssertions: 1,
+			SkippedTests:     1,
+			ScriptErrors:     1,
+		},
+	}
+}
+
+func assertGolden(t *testing.T, path string, have []byte) {
+	t.Helper()
+
+	if *update {
+		if err := ioutil.WriteFile(path, have, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(have, want) {
+		t.Errorf("%s does not match, have:\n%s", path, have)
+	}
+}
+
+func TestReporters(t *testing.T) {
+	for _, name := range reporters.Names() {
+		t.Run(name, func(t *testing.T) {
+			r, err := reporters.New(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := r.Report(&buf, testRun()); err != nil {
+				t.Fatal(err)
+			}
+
+			assertGolden(t, "testdata/report."+name+".golden", buf.Bytes())
+		})
+	}
+}
+
+func TestNewUnknownReporter(t *testing.T) {
+	if _, err := reporters.New("xml"); err == nil {
+		t.Error("An unknown reporter should return an error.")
+	}
+}
+
+func TestFromMonitorRun(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/monitor-run.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp resources.MonitorRunResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	run := reporters.FromMonitorRun(&resp.Run)
+
+	if run.Name != "Echo monitor" || run.Duration != 2122*time.Millisecond {
+		t.Errorf("Run is incorrect: %+v", run)
+	}
+
+	if run.Stats.Assertions != 4 || run.Stats.FailedAssertions != 2 || run.Stats.Requests != 2 || run.Stats.FailedIterations != 1 {
+		t.Errorf("Stats are incorrect: %+v", run.Stats)
+	}
+
+	if len(run.Executions) != 2 {
+		t.Fatalf("Executions are incorrect: %+v", run.Executions)
+	}
+
+	get := run.Executions[1]
+	if get.Name != "Sample GET Request" || get.Code != 404 || get.ResponseTime != 30*time.Millisecond {
+		t.Errorf("Execution is incorrect: %+v", get)
+	}
+
+	want := []reporters.Assertion{
+		{Name: "Status code is 200", Error: "expected response to have status code 200 but got 404"},
+		{Name: "Body has args", Error: "expected '' to include 'args'"},
+	}
+	if len(get.Assertions) != len(want) || get.Assertions[0] != want[0] || get.Assertions[1] != want[1] {
+		t.Errorf("Assertions are incorrect, have: %+v, want: %+v", get.Assertions, want)
+	}
+
+	if run.Executions[0].Failed() || !get.Failed() {
+		t.Error("Only the GET request should fail.")
+	}
+}
+
+func TestThresholdsCheck(t *testing.T) {
+	cases := []struct {
+		name       string
+		thresholds reporters.Thresholds
+		stats      reporters.Stats
+		status     string
+		fails      bool
+	}{
+		{"passed", reporters.Thresholds{FailOnError: true}, reporters.Stats{Requests: 2, Assertions: 4}, "success", false},
+		{"failed assertion", reporters.Thresholds{}, reporters.Stats{Assertions: 4, FailedAssertions: 1}, "failed", true},
+		{"tolerated failures", reporters.Thresholds{MaxFailures: 2}, reporters.Stats{Assertions: 4, FailedAssertions: 2}, "failed", false},
+		{"errored request", reporters.Thresholds{FailOnError: true}, reporters.Stats{Requests: 2, FailedRequests: 1}, "failed", true},
+		{"ignored request error", reporters.Thresholds{}, reporters.Stats{Requests: 2, FailedRequests: 1}, "failed", false},
+		{"script error", reporters.Thresholds{FailOnError: true}, reporters.Stats{ScriptErrors: 1}, "", true},
+		{"errored run", reporters.Thresholds{FailOnError: true}, reporters.Stats{}, "error", true},
+	}
+
+	for _, c := range cases {
+		err := c.thresholds.Check(&reporters.Run{Status: c.status, Stats: c.stats})
+		if (err != nil) != c.fails {
+			t.Errorf("%s: have error %v, want failure: %t", c.name, err, c.fails)
+		}
+	}
+}
+
+func TestRunRedact(t *testing.T) {
+	run := &reporters.Run{
+		Executions: []reporters.Execution{
+			{
+				URL:          "http://localhost/pets?key=s3cr%2Ft",
+				Error:        "dial tcp: token s3cr/t refused",
+				ScriptErrors: []string{"bad s3cr/t"},
+				Assertions:   []reporters.Assertion{{Name: "uses s3cr/t", Error: "want s3cr/t"}},
+				Console:      []reporters.ConsoleMessage{{Level: "log", Message: "token=s3cr/t"}},
+			},
+		},
+	}
+
+	run.Redact([]string{"s3cr/t", ""})
+
+	x := run.Executions[0]
+	mask := resources.SecretMask
+	have := []string{x.URL, x.Error, x.ScriptErrors[0], x.Assertions[0].Name, x.Assertions[0].Error, x.Console[0].Message}
+	want := []string{
+		"http://localhost/pets?key=" + mask,
+		"dial tcp: token " + mask + " refused",
+		"bad " + mask,
+		"uses " + mask,
+		"want " + mask,
+		"token=" + mask,
+	}
+
+	for i := range want {
+		if have[i] != want[i] {
+			t.Errorf("have %q, want %q", have[i], want[i])
+		}
+	}
+}
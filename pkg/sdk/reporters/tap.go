@@ -0,0 +1,97 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TAPReporter writes a Test Anything Protocol (version 13) report. Each
+// assertion is a test point; requests without assertions are a test point of
+// their own.
+type TAPReporter struct{}
+
+type tapPoint struct {
+	description string
+	skipped     bool
+	failure     string
+}
+
+// Report writes the report.
+func (r *TAPReporter) Report(w io.Writer, run *Run) error {
+	var points []tapPoint
+	for _, e := range run.Executions {
+		name := executionName(run, e)
+
+		if e.Error != "" {
+			points = append(points, tapPoint{description: name, failure: e.Error})
+		}
+
+		for _, err := range e.ScriptErrors {
+			points = append(points, tapPoint{description: name, failure: err})
+		}
+
+		for _, a := range e.Assertions {
+			points = append(points, tapPoint{
+				description: name + " - " + a.Name,
+				skipped:     a.Skipped,
+				failure:     a.Error,
+			})
+		}
+
+		if e.Error == "" && len(e.ScriptErrors) == 0 && len(e.Assertions) == 0 {
+			points = append(points, tapPoint{description: name})
+		}
+	}
+
+	fmt.Fprintln(w, "TAP version 13")
+	fmt.Fprintf(w, "1..%d\n", len(points))
+
+	for i, p := range points {
+		switch {
+		case p.skipped:
+			fmt.Fprintf(w, "ok %d - %s # SKIP\n", i+1, tapEscape(p.description))
+		case p.failure == "":
+			fmt.Fprintf(w, "ok %d - %s\n", i+1, tapEscape(p.description))
+		default:
+			fmt.Fprintf(w, "not ok %d - %s\n", i+1, tapEscape(p.description))
+			fmt.Fprintln(w, "  ---")
+			fmt.Fprintf(w, "  message: %q\n", p.failure)
+			fmt.Fprintln(w, "  ...")
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "# requests %d, failed %d\n# assertions %d, failed %d\n",
+		run.Stats.Requests, run.Stats.FailedRequests, run.Stats.Assertions, run.Stats.FailedAssertions)
+	return err
+}
+
+func tapEscape(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "#", "\\#", "\n", " ").Replace(s)
+}
+
+// executionName names an execution, including its iteration when the run
+// has several.
+func executionName(run *Run, e Execution) string {
+	if run.Iterations > 1 {
+		return fmt.Sprintf("%s [iteration %d]", e.Name, e.Iteration+1)
+	}
+
+	return e.Name
+}
@@ -0,0 +1,296 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resolver replaces references to secrets kept outside of Postman,
+// such as "${env:TOKEN}" or "${file:/run/secrets/token}", with their values.
+//
+// A reference has the form "${kind:argument}". The kind selects a Resolver
+// registered with a Registry, which is given the argument. "$${" is written
+// as a literal "${", and "${" that isn't followed by a kind and a colon is
+// left as it is.
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// Resolver returns the value a reference of one kind points to.
+type Resolver interface {
+	Resolve(ctx context.Context, arg string) (string, error)
+}
+
+// Func is a function used as a Resolver.
+type Func func(ctx context.Context, arg string) (string, error)
+
+// Resolve calls f(ctx, arg).
+func (f Func) Resolve(ctx context.Context, arg string) (string, error) {
+	return f(ctx, arg)
+}
+
+// Error is returned when a reference can't be resolved.
+type Error struct {
+	// Key is the environment variable holding the reference, if known.
+	Key string
+
+	// Ref is the reference as written, such as "${env:TOKEN}".
+	Ref string
+
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Key != "" {
+		return fmt.Sprintf("variable %q: unable to resolve %s: %s", e.Key, e.Ref, e.Err)
+	}
+
+	return fmt.Sprintf("unable to resolve %s: %s", e.Ref, e.Err)
+}
+
+// Unwrap returns the error of the resolver.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Registry holds the resolvers by kind.
+type Registry struct {
+	resolvers map[string]Resolver
+}
+
+// New returns a Registry without any resolvers.
+func New() *Registry {
+	return &Registry{resolvers: make(map[string]Resolver)}
+}
+
+// Default returns a Registry with the "env" and "file" resolvers. Exec runs
+// commands written in the input, so it has to be registered explicitly, for
+// input that is trusted.
+func Default() *Registry {
+	r := New()
+	r.Register("env", Func(Env))
+	r.Register("file", Func(File))
+
+	return r
+}
+
+// Register adds a resolver for references of the given kind, replacing any
+// resolver already registered for it.
+func (r *Registry) Register(kind string, resolver Resolver) {
+	r.resolvers[kind] = resolver
+}
+
+// Kinds returns the registered kinds in order.
+func (r *Registry) Kinds() []string {
+	kinds := make([]string, 0, len(r.resolvers))
+	for k := range r.resolvers {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// Expand replaces the references in s with their values.
+func (r *Registry) Expand(ctx context.Context, s string) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+
+		if i > 0 && s[i-1] == '$' {
+			b.WriteString(s[:i])
+			b.WriteString("{")
+			s = s[i+2:]
+			continue
+		}
+
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		kind, ok := parseKind(s[2:])
+		if !ok {
+			b.WriteString("${")
+			s = s[2:]
+			continue
+		}
+
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return "", &Error{Ref: s, Err: errors.New("missing closing brace")}
+		}
+
+		ref := s[:end+1]
+		s = s[end+1:]
+
+		resolver, ok := r.resolvers[kind]
+		if !ok {
+			return "", &Error{Ref: ref, Err: fmt.Errorf("unknown kind %q, expected one of: %s", kind, strings.Join(r.Kinds(), ", "))}
+		}
+
+		v, err := resolver.Resolve(ctx, ref[len(kind)+3:len(ref)-1])
+		if err != nil {
+			return "", &Error{Ref: ref, Err: err}
+		}
+
+		b.WriteString(v)
+	}
+}
+
+// parseKind returns the kind at the start of s when it's followed by a
+// colon. Kinds start with a letter, followed by letters, digits, "-" or "_".
+func parseKind(s string) (string, bool) {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-' || c == '_'):
+		case i > 0 && c == ':':
+			return s[:i], true
+		default:
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+// Environment replaces the references in the values of env.
+func (r *Registry) Environment(ctx context.Context, env *resources.Environment) error {
+	for i, v := range env.Values {
+		value, err := r.Expand(ctx, v.Value)
+		if err != nil {
+			return withKey(err, v.Key)
+		}
+
+		env.Values[i].Value = value
+	}
+
+	return nil
+}
+
+// EnvironmentJSON replaces the references in the values of an environment
+// in its JSON representation. Members other than the values are kept.
+func (r *Registry) EnvironmentJSON(ctx context.Context, b []byte) ([]byte, error) {
+	var env map[string]json.RawMessage
+	if err := json.Unmarshal(b, &env); err != nil {
+		return nil, err
+	}
+
+	raw, ok := env["values"]
+	if !ok {
+		return b, nil
+	}
+
+	var values []map[string]interface{}
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("values: %s", err)
+	}
+
+	for _, v := range values {
+		value, ok := v["value"].(string)
+		if !ok {
+			continue
+		}
+
+		expanded, err := r.Expand(ctx, value)
+		if err != nil {
+			key, _ := v["key"].(string)
+			return nil, withKey(err, key)
+		}
+
+		v["value"] = expanded
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	env["values"] = raw
+
+	return json.Marshal(env)
+}
+
+func withKey(err error, key string) error {
+	var e *Error
+	if errors.As(err, &e) {
+		e.Key = key
+	}
+
+	return err
+}
+
+// Env resolves the name of an environment variable of the process.
+func Env(ctx context.Context, name string) (string, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+
+	return v, nil
+}
+
+// File resolves the path of a file to its contents, without a trailing
+// newline.
+func File(ctx context.Context, path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// Exec runs a command with the shell and resolves to its output, without a
+// trailing newline. The command fails when it exits with a non-zero status.
+func Exec(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resolver"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func TestExpand(t *testing.T) {
+	os.Setenv("POSTMANCTL_TEST_TOKEN", "t0ken")
+	defer os.Unsetenv("POSTMANCTL_TEST_TOKEN")
+
+	dir, err := ioutil.TempDir("", "resolver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	secret := filepath.Join(dir, "secret")
+	if err := ioutil.WriteFile(secret, []byte("s3cret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := resolver.Default()
+	r.Register("upper", resolver.Func(func(ctx context.Context, arg string) (string, error) {
+		return strings.ToUpper(arg), nil
+	}))
+
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"${env:POSTMANCTL_TEST_TOKEN}", "t0ken"},
+		{"Bearer ${env:POSTMANCTL_TEST_TOKEN}!", "Bearer t0ken!"},
+		{"${file:" + secret + "}", "s3cret"},
+		{"${upper:a}-${upper:b}", "A-B"},
+		{"$${env:POSTMANCTL_TEST_TOKEN}", "${env:POSTMANCTL_TEST_TOKEN}"},
+		{"`${name}` {{var}} ${1:x}", "`${name}` {{var}} ${1:x}"},
+	}
+
+	for _, tt := range tests {
+		have, err := r.Expand(context.Background(), tt.in)
+		if err != nil {
+			t.Errorf("%s: %s", tt.in, err)
+			continue
+		}
+
+		if have != tt.want {
+			t.Errorf("%s: have %q, want %q", tt.in, have, tt.want)
+		}
+	}
+}
+
+func TestExpandExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a POSIX shell")
+	}
+
+	r := resolver.Default()
+	if _, err := r.Expand(context.Background(), "${exec:echo hello}"); err == nil {
+		t.Error("have no error for exec with the default resolvers, want one")
+	}
+
+	r.Register("exec", resolver.Func(resolver.Exec))
+
+	have, err := r.Expand(context.Background(), "${exec:echo hello}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have != "hello" {
+		t.Errorf("have %q, want hello", have)
+	}
+
+	_, err = r.Expand(context.Background(), "${exec:echo oops >&2; exit 3}")
+	if err == nil || !strings.Contains(err.Error(), "oops") {
+		t.Errorf("have error %v, want it to contain the output on stderr", err)
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	os.Unsetenv("POSTMANCTL_TEST_MISSING")
+
+	r := resolver.Default()
+
+	tests := []struct {
+		in, want string
+	}{
+		{"${env:POSTMANCTL_TEST_MISSING}", "unable to resolve ${env:POSTMANCTL_TEST_MISSING}: environment variable POSTMANCTL_TEST_MISSING is not set"},
+		{"${vault:secret/x}", `unable to resolve ${vault:secret/x}: unknown kind "vault", expected one of: env, file`},
+		{"${env:TOKEN", "unable to resolve ${env:TOKEN: missing closing brace"},
+	}
+
+	for _, tt := range tests {
+		_, err := r.Expand(context.Background(), tt.in)
+		if err == nil {
+			t.Errorf("%s: have no error, want %q", tt.in, tt.want)
+			continue
+		}
+
+		var e *resolver.Error
+		if !errors.As(err, &e) {
+			t.Errorf("%s: have %T, want *resolver.Error", tt.in, err)
+		}
+
+		if err.Error() != tt.want {
+			t.Errorf("%s: have %q, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	os.Setenv("POSTMANCTL_TEST_TOKEN", "t0ken")
+	defer os.Unsetenv("POSTMANCTL_TEST_TOKEN")
+	os.Unsetenv("POSTMANCTL_TEST_MISSING")
+
+	r := resolver.Default()
+
+	env := &resources.Environment{
+		Values: []resources.KeyValuePair{
+			{Key: "host", Value: "localhost"},
+			{Key: "token", Value: "${env:POSTMANCTL_TEST_TOKEN}", Type: resources.SecretValueType},
+		},
+	}
+
+	if err := r.Environment(context.Background(), env); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := env.Value("token"); v.Value != "t0ken" {
+		t.Errorf("have token %q, want t0ken", v.Value)
+	}
+
+	env.SetValue(resources.KeyValuePair{Key: "missing", Value: "${env:POSTMANCTL_TEST_MISSING}"})
+
+	err := r.Environment(context.Background(), env)
+	want := `variable "missing": unable to resolve ${env:POSTMANCTL_TEST_MISSING}: environment variable POSTMANCTL_TEST_MISSING is not set`
+	if err == nil || err.Error() != want {
+		t.Errorf("have error %v, want %q", err, want)
+	}
+}
+
+func TestEnvironmentJSON(t *testing.T) {
+	os.Setenv("POSTMANCTL_TEST_TOKEN", "t0ken")
+	defer os.Unsetenv("POSTMANCTL_TEST_TOKEN")
+
+	r := resolver.Default()
+
+	in := `{"name":"dev","values":[{"key":"token","value":"${env:POSTMANCTL_TEST_TOKEN}","enabled":true,"extra":1},{"key":"n","value":2}],"other":true}`
+
+	out, err := r.EnvironmentJSON(context.Background(), []byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have, want interface{}
+	if err := json.Unmarshal(out, &have); err != nil {
+		t.Fatal(err)
+	}
+	json.Unmarshal([]byte(`{"name":"dev","values":[{"key":"token","value":"t0ken","enabled":true,"extra":1},{"key":"n","value":2}],"other":true}`), &want)
+
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("have %s, want the token resolved and everything else kept", out)
+	}
+}
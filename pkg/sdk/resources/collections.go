@@ -18,17 +18,21 @@ package resources
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
 )
 
 //go:generate sh -c "schema-generate -p gen ../../../schema/collection.schema.json  | sed 's/Id/ID/g' > ./gen/collection.go"
+//go:generate go run embedschema.go gen CollectionSchema ../../../schema/collection.schema.json ./gen/collectionschema.go
 
 // Collection represents a Postman Collection.
 type Collection struct {
 	*gen.Collection
 	Items *ItemTree
+
+	raw json.RawMessage
 }
 
 // UnmarshalJSON converts JSON to a struct.
@@ -38,9 +42,17 @@ func (c *Collection) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	var members struct {
+		Item []json.RawMessage `json:"item"`
+	}
+	if err := json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+
 	c.Collection = &genC
+	c.raw = append(json.RawMessage(nil), b...)
 	node := ItemTreeNode{}
-	if err := populateItemGroup(&node, c.Collection.Item); err != nil {
+	if err := populateItemGroup(&node, members.Item); err != nil {
 		return err
 	}
 
@@ -51,6 +63,29 @@ func (c *Collection) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON converts the collection to JSON, rebuilding the "item" member
+// from Items. Members that postmanctl doesn't model are carried over from the
+// JSON the collection was decoded from.
+func (c Collection) MarshalJSON() ([]byte, error) {
+	if c.Collection == nil {
+		return []byte("null"), nil
+	}
+
+	current := *c.Collection
+
+	var replace map[string]json.RawMessage
+	if c.Items != nil {
+		items, err := json.Marshal(c.Items)
+		if err != nil {
+			return nil, err
+		}
+
+		replace = map[string]json.RawMessage{"item": items}
+	}
+
+	return marshalMerged(c.raw, &current, &gen.Collection{}, replace)
+}
+
 // CollectionListResponse is the top-level struct representation of a collection
 // list response in the Postman API.
 type CollectionListResponse struct {
@@ -117,12 +152,16 @@ func (r CollectionSlice) Format() ([]string, []interface{}) {
 type Item struct {
 	*gen.Item
 	Events []Event
+
+	raw json.RawMessage
 }
 
 // ItemGroup represents a folder in a Collection.
 type ItemGroup struct {
 	*gen.ItemGroup
 	Events []Event
+
+	raw json.RawMessage
 }
 
 // Event represents an item event.
@@ -130,6 +169,40 @@ type Event struct {
 	*gen.Event
 }
 
+// Source returns the source of the event's script. Collections store it as
+// a string or as a list of lines.
+func (e Event) Source() string {
+	if e.Event == nil || e.Script == nil {
+		return ""
+	}
+
+	switch exec := e.Script.Exec.(type) {
+	case string:
+		return exec
+	case []interface{}:
+		lines := make([]string, len(exec))
+		for i, line := range exec {
+			lines[i], _ = line.(string)
+		}
+		return strings.Join(lines, "\n")
+	case []string:
+		return strings.Join(exec, "\n")
+	}
+
+	return ""
+}
+
+// CollectionEvents wraps the events of a collection, which are kept as
+// generated gen.Events, as Events.
+func CollectionEvents(events []*gen.Event) []Event {
+	e := make([]Event, len(events))
+	for i, v := range events {
+		e[i] = Event{Event: v}
+	}
+
+	return e
+}
+
 // UnmarshalJSON converts JSON to a struct.
 func (item *Item) UnmarshalJSON(b []byte) error {
 	var genItem gen.Item
@@ -139,6 +212,7 @@ func (item *Item) UnmarshalJSON(b []byte) error {
 
 	item.Item = &genItem
 	item.Events = make([]Event, len(item.Item.Event))
+	item.raw = append(json.RawMessage(nil), b...)
 
 	for i, genEvent := range item.Item.Event {
 		item.Events[i] = Event{Event: genEvent}
@@ -147,62 +221,135 @@ func (item *Item) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-func populateItemGroup(b *ItemTreeNode, item []interface{}) error {
-	if len(item) == 0 {
+// MarshalJSON converts the item to JSON. Events takes precedence over the
+// embedded gen.Item events when it is set.
+func (item Item) MarshalJSON() ([]byte, error) {
+	if item.Item == nil {
+		return []byte("null"), nil
+	}
+
+	current := *item.Item
+	current.Event = genEvents(item.Events, current.Event)
+
+	return marshalMerged(item.raw, &current, &gen.Item{}, nil)
+}
+
+// UnmarshalJSON converts JSON to a struct.
+func (group *ItemGroup) UnmarshalJSON(b []byte) error {
+	var genGroup gen.ItemGroup
+	if err := json.Unmarshal(b, &genGroup); err != nil {
+		return err
+	}
+
+	group.ItemGroup = &genGroup
+	group.Events = make([]Event, len(group.ItemGroup.Event))
+	group.raw = append(json.RawMessage(nil), b...)
+
+	for i, genEvent := range group.ItemGroup.Event {
+		group.Events[i] = Event{Event: genEvent}
+	}
+
+	return nil
+}
+
+// id returns the ID of the folder. The collection schema doesn't define one,
+// but the Postman API includes it when returning a collection.
+func (group *ItemGroup) id() string {
+	var ids struct {
+		ID        string `json:"id"`
+		PostmanID string `json:"_postman_id"`
+	}
+	if group.raw == nil || json.Unmarshal(group.raw, &ids) != nil {
+		return ""
+	}
+
+	if ids.ID != "" {
+		return ids.ID
+	}
+
+	return ids.PostmanID
+}
+
+// ResponseNames returns the names of the saved responses of the item, in the
+// order of Response. The collection schema doesn't define a name for
+// responses, but Postman stores one for every saved example.
+func (item Item) ResponseNames() []string {
+	if item.Item == nil {
 		return nil
 	}
 
-	for _, v := range item {
-		var m map[string]interface{} = v.(map[string]interface{})
+	names := make([]string, len(item.Response))
 
-		if v2, ok := m["item"]; ok {
-			branch := ItemTreeNode{}
+	var saved struct {
+		Response []struct {
+			Name string `json:"name"`
+		} `json:"response"`
+	}
+	if item.raw == nil || json.Unmarshal(item.raw, &saved) != nil {
+		return names
+	}
+
+	for i := range names {
+		if i < len(saved.Response) {
+			names[i] = saved.Response[i].Name
+		}
+	}
 
-			if _, ok := m["name"]; ok {
-				data, err := json.Marshal(m)
-				if err != nil {
-					return err
-				}
+	return names
+}
 
-				var ig gen.ItemGroup
-				if err := json.Unmarshal(data, &ig); err != nil {
-					return err
-				}
+// genEvents returns the gen.Event values wrapped by events, or fallback when
+// events hasn't been populated.
+func genEvents(events []Event, fallback []*gen.Event) []*gen.Event {
+	if events == nil || (len(events) == 0 && len(fallback) == 0) {
+		return fallback
+	}
 
-				branch.MakeGroup(ItemGroup{
-					ItemGroup: &ig,
-				})
+	e := make([]*gen.Event, 0, len(events))
+	for _, ev := range events {
+		if ev.Event != nil {
+			e = append(e, ev.Event)
+		}
+	}
+
+	return e
+}
+
+func populateItemGroup(b *ItemTreeNode, item []json.RawMessage) error {
+	for _, v := range item {
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(v, &members); err != nil {
+			return err
+		}
+
+		if children, ok := members["item"]; ok {
+			var ig ItemGroup
+			if err := json.Unmarshal(v, &ig); err != nil {
+				return err
 			}
 
-			if err := populateItemGroup(&branch, v2.([]interface{})); err != nil {
+			var childItems []json.RawMessage
+			if err := json.Unmarshal(children, &childItems); err != nil {
+				return err
+			}
+
+			branch := ItemTreeNode{}
+			branch.MakeGroup(ig)
+
+			if err := populateItemGroup(&branch, childItems); err != nil {
 				return err
 			}
 
 			b.AddBranch(branch)
 		} else {
-			it, err := populateItem(m["name"].(string), m)
-
-			if err != nil {
+			var it Item
+			if err := json.Unmarshal(v, &it); err != nil {
 				return err
 			}
 
-			b.AddItem(*it)
+			b.AddItem(it)
 		}
 	}
 
 	return nil
 }
-
-func populateItem(name string, item map[string]interface{}) (*Item, error) {
-	data, err := json.Marshal(item)
-	if err != nil {
-		return nil, err
-	}
-
-	var it Item
-	if err := json.Unmarshal(data, &it); err != nil {
-		return nil, err
-	}
-
-	return &it, nil
-}
@@ -0,0 +1,191 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func readCollection(t *testing.T, path string) ([]byte, *resources.Collection) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return b, &c
+}
+
+func assertGolden(t *testing.T, path string, have []byte) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, have, "", "\t"); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n")
+
+	if *update {
+		if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("%s does not match, have:\n%s", path, buf.String())
+	}
+}
+
+func TestCollectionMarshalRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.postman_collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			original, c := readCollection(t, f)
+
+			have, err := json.Marshal(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := json.Marshal(json.RawMessage(original))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(have, want) {
+				t.Errorf("Round trip is not lossless, have: %s, want: %s", have, want)
+			}
+		})
+	}
+}
+
+func TestCollectionMarshalAfterEdit(t *testing.T) {
+	_, c := readCollection(t, "testdata/echo.postman_collection.json")
+
+	folder := &(*c.Items.Root.Branches)[0]
+	folder.AddItem(resources.Item{
+		Item: &gen.Item{
+			Name: "DELETE Request",
+			Request: map[string]interface{}{
+				"method": "DELETE",
+				"url":    "{{baseUrl}}/delete",
+			},
+		},
+	})
+
+	get := &(*folder.Items)[0]
+	get.Events[0].Script.Exec = []interface{}{
+		"pm.test(\"response is ok\", function () {",
+		"    pm.response.to.have.status(201);",
+		"});",
+	}
+	get.Events = append(get.Events, resources.Event{
+		Event: &gen.Event{
+			Listen: "prerequest",
+			Script: &gen.Script{Exec: []interface{}{"pm.variables.set(\"foo1\", \"bar1\");"}},
+		},
+	})
+
+	c.Items.Root.AddBranch(resources.ItemTreeNode{
+		ItemGroup: &resources.ItemGroup{
+			ItemGroup: &gen.ItemGroup{Name: "Empty"},
+		},
+	})
+
+	c.Variable = append(c.Variable, &gen.Variable{Key: "token", Value: "abc"})
+
+	have, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertGolden(t, "testdata/echo.edited.golden.json", have)
+}
+
+func TestCollectionMarshalZeroValues(t *testing.T) {
+	original := `{"info":{"name":"Zero","schema":"https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},"item":[],"variable":[{"key":"a","value":1,"disabled":true}]}`
+
+	var c resources.Collection
+	if err := json.Unmarshal([]byte(original), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Variable[0].Disabled = false
+	c.Variable[0].Value = 0
+
+	have, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Replace(strings.Replace(original, `"value":1`, `"value":0`, 1), `"disabled":true`, `"disabled":false`, 1)
+	if string(have) != want {
+		t.Errorf("Zero values should be written, have: %s, want: %s", have, want)
+	}
+}
+
+func TestItemTreeMarshalPreservesOrder(t *testing.T) {
+	_, c := readCollection(t, "testdata/petstore.postman_collection.json")
+
+	b, err := json.Marshal(c.Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var items []struct {
+		Name string          `json:"name"`
+		Item json.RawMessage `json:"item"`
+	}
+	if err := json.Unmarshal(b, &items); err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, len(items))
+	for i, it := range items {
+		names[i] = it.Name
+	}
+
+	if have, want := strings.Join(names, ","), "List all pets,pets"; have != want {
+		t.Errorf("Item order is incorrect, have: %s, want: %s", have, want)
+	}
+}
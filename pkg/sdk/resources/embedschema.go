@@ -0,0 +1,66 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// embedschema writes a JSON schema to a Go file as a string constant, so
+// the schema can be used without reading it at run time.
+//
+// Usage: go run embedschema.go <package> <constant> <schema> <output>
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) != 5 {
+		fmt.Fprintln(os.Stderr, "usage: embedschema <package> <constant> <schema> <output>")
+		os.Exit(2)
+	}
+	pkg, name, input, output := os.Args[1], os.Args[2], os.Args[3], os.Args[4]
+
+	b, err := ioutil.ReadFile(input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by embedschema.go. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	fmt.Fprintf(&buf, "// %s is the content of %s.\n", name, input[strings.LastIndex(input, "/")+1:])
+	fmt.Fprintf(&buf, "const %s = \"\" +\n", name)
+
+	lines := strings.SplitAfter(strings.TrimRight(string(b), "\n"), "\n")
+	for i, line := range lines {
+		sep := " +"
+		if i == len(lines)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", strconv.Quote(line), sep)
+	}
+
+	if err := ioutil.WriteFile(output, buf.Bytes(), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -78,9 +78,120 @@ func (r EnvironmentSlice) Format() ([]string, []interface{}) {
 	return []string{"ID", "Name"}, s
 }
 
+// Value returns the variable named key.
+func (r *Environment) Value(key string) (KeyValuePair, bool) {
+	for _, v := range r.Values {
+		if v.Key == key {
+			return v, true
+		}
+	}
+
+	return KeyValuePair{}, false
+}
+
+// SetValue sets a variable. A variable that's already set is updated in
+// place, keeping its position, and a new one is appended.
+func (r *Environment) SetValue(v KeyValuePair) {
+	for i := range r.Values {
+		if r.Values[i].Key == v.Key {
+			r.Values[i] = v
+			return
+		}
+	}
+
+	r.Values = append(r.Values, v)
+}
+
+// UnsetValue removes the variable named key and reports whether it was set.
+func (r *Environment) UnsetValue(key string) bool {
+	for i, v := range r.Values {
+		if v.Key == key {
+			r.Values = append(r.Values[:i], r.Values[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Redacted returns a copy of the environment with the values of secret
+// variables replaced by SecretMask.
+func (r *Environment) Redacted() *Environment {
+	c := *r
+	c.Values = KeyValuePairs(r.Values).Redacted()
+
+	return &c
+}
+
+// Secrets returns the values of the secret variables that are set.
+func (r *Environment) Secrets() []string {
+	var s []string
+	for _, v := range r.Values {
+		if v.Secret() && v.Value != "" {
+			s = append(s, v.Value)
+		}
+	}
+
+	return s
+}
+
+// Redacted returns a copy of the environments with the values of secret
+// variables replaced by SecretMask.
+func (r EnvironmentSlice) Redacted() EnvironmentSlice {
+	c := make(EnvironmentSlice, len(r))
+	for i, v := range r {
+		c[i] = v.Redacted()
+	}
+
+	return c
+}
+
 // KeyValuePair represents a key and value in the Postman API.
 type KeyValuePair struct {
 	Key     string `json:"key"`
 	Value   string `json:"value"`
 	Enabled bool   `json:"enabled"`
+	Type    string `json:"type,omitempty"`
+}
+
+// SecretValueType is the type of environment values that hold secrets.
+const SecretValueType = "secret"
+
+// SecretMask is shown in place of the value of a secret variable.
+const SecretMask = "********"
+
+// Secret reports whether the variable holds a secret.
+func (r KeyValuePair) Secret() bool {
+	return r.Type == SecretValueType
+}
+
+// KeyValuePairs is a slice of KeyValuePair.
+type KeyValuePairs []KeyValuePair
+
+// Format returns column headers and values for the resource.
+func (r KeyValuePairs) Format() ([]string, []interface{}) {
+	s := make([]interface{}, len(r))
+	for i, v := range r {
+		s[i] = v
+	}
+
+	return []string{"Key", "Value", "Type", "Enabled"}, s
+}
+
+// Redacted returns a copy of the variables with the values of secrets
+// replaced by SecretMask.
+func (r KeyValuePairs) Redacted() KeyValuePairs {
+	if r == nil {
+		return nil
+	}
+
+	c := make(KeyValuePairs, len(r))
+	for i, v := range r {
+		if v.Secret() {
+			v.Value = SecretMask
+		}
+		c[i] = v
+	}
+
+	return c
 }
@@ -0,0 +1,90 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func TestEnvironmentValues(t *testing.T) {
+	env := resources.Environment{
+		Values: []resources.KeyValuePair{
+			{Key: "host", Value: "localhost", Enabled: true},
+			{Key: "token", Value: "abc", Enabled: true, Type: resources.SecretValueType},
+			{Key: "port", Value: "8080", Enabled: true},
+		},
+	}
+
+	env.SetValue(resources.KeyValuePair{Key: "token", Value: "def", Enabled: true, Type: resources.SecretValueType})
+	env.SetValue(resources.KeyValuePair{Key: "scheme", Value: "https"})
+
+	if !env.UnsetValue("host") {
+		t.Error("have host unset, want it set")
+	}
+
+	if env.UnsetValue("missing") {
+		t.Error("have missing set, want it unset")
+	}
+
+	want := []resources.KeyValuePair{
+		{Key: "token", Value: "def", Enabled: true, Type: resources.SecretValueType},
+		{Key: "port", Value: "8080", Enabled: true},
+		{Key: "scheme", Value: "https"},
+	}
+
+	if !reflect.DeepEqual(env.Values, want) {
+		t.Errorf("have values %+v, want %+v", env.Values, want)
+	}
+
+	if v, ok := env.Value("port"); !ok || v.Value != "8080" {
+		t.Errorf("have port %+v, want 8080", v)
+	}
+}
+
+func TestEnvironmentRedacted(t *testing.T) {
+	env := &resources.Environment{
+		ID: "1",
+		Values: []resources.KeyValuePair{
+			{Key: "host", Value: "localhost", Enabled: true},
+			{Key: "token", Value: "abc", Enabled: true, Type: resources.SecretValueType},
+			{Key: "empty", Type: resources.SecretValueType},
+		},
+	}
+
+	redacted := resources.EnvironmentSlice{env}.Redacted()[0]
+
+	want := []resources.KeyValuePair{
+		{Key: "host", Value: "localhost", Enabled: true},
+		{Key: "token", Value: resources.SecretMask, Enabled: true, Type: resources.SecretValueType},
+		{Key: "empty", Value: resources.SecretMask, Type: resources.SecretValueType},
+	}
+
+	if !reflect.DeepEqual(redacted.Values, want) {
+		t.Errorf("have values %+v, want %+v", redacted.Values, want)
+	}
+
+	if v, _ := env.Value("token"); v.Value != "abc" {
+		t.Errorf("have token %q in the original, want abc", v.Value)
+	}
+
+	if s := env.Secrets(); !reflect.DeepEqual(s, []string{"abc"}) {
+		t.Errorf("have secrets %v, want [abc]", s)
+	}
+}
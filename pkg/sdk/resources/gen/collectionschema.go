@@ -0,0 +1,1181 @@
+// Code generated by embedschema.go. DO NOT EDIT.
+
+package gen
+
+// CollectionSchema is the content of collection.schema.json.
+const CollectionSchema = "" +
+	"{\n" +
+	"    \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"    \"id\": \"https://schema.getpostman.com/json/collection/v2.1.0/\",\n" +
+	"    \"type\": \"object\",\n" +
+	"    \"title\": \"collection\",\n" +
+	"    \"properties\": {\n" +
+	"        \"info\": {\n" +
+	"            \"$ref\": \"#/definitions/info\"\n" +
+	"        },\n" +
+	"        \"item\": {\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"description\": \"Items are the basic unit for a Postman collection. You can think of them as corresponding to a single API endpoint. Each Item has one request and may have multiple API responses associated with it.\",\n" +
+	"            \"items\": {\n" +
+	"                \"title\": \"Items\",\n" +
+	"                \"oneOf\": [\n" +
+	"                    {\n" +
+	"                        \"$ref\": \"#/definitions/item\"\n" +
+	"                    },\n" +
+	"                    {\n" +
+	"                        \"$ref\": \"#/definitions/item-group\"\n" +
+	"                    }\n" +
+	"                ]\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"event\": {\n" +
+	"            \"$ref\": \"#/definitions/event-list\"\n" +
+	"        },\n" +
+	"        \"variable\": {\n" +
+	"            \"$ref\": \"#/definitions/variable-list\"\n" +
+	"        },\n" +
+	"        \"auth\": {\n" +
+	"            \"oneOf\": [\n" +
+	"                {\n" +
+	"                    \"type\": \"null\"\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"$ref\": \"#/definitions/auth\"\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"protocolProfileBehavior\": {\n" +
+	"            \"$ref\": \"#/definitions/protocol-profile-behavior\"\n" +
+	"        }\n" +
+	"    },\n" +
+	"    \"required\": [\n" +
+	"        \"info\",\n" +
+	"        \"item\"\n" +
+	"    ],\n" +
+	"    \"definitions\": {\n" +
+	"        \"auth-attribute\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Auth\",\n" +
+	"            \"id\": \"#/definitions/auth-attribute\",\n" +
+	"            \"description\": \"Represents an attribute for any authorization method provided by Postman. For example `username` and `password` are set as auth attributes for Basic Authentication method.\",\n" +
+	"            \"properties\": {\n" +
+	"                \"key\": {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"value\": {},\n" +
+	"                \"type\": {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"key\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"auth\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Auth\",\n" +
+	"            \"id\": \"#/definitions/auth\",\n" +
+	"            \"description\": \"Represents authentication helpers provided by Postman\",\n" +
+	"            \"properties\": {\n" +
+	"                \"type\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"enum\": [\n" +
+	"                        \"apikey\",\n" +
+	"                        \"awsv4\",\n" +
+	"                        \"basic\",\n" +
+	"                        \"bearer\",\n" +
+	"                        \"digest\",\n" +
+	"                        \"hawk\",\n" +
+	"                        \"noauth\",\n" +
+	"                        \"oauth1\",\n" +
+	"                        \"oauth2\",\n" +
+	"                        \"ntlm\"\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"noauth\": {},\n" +
+	"                \"apikey\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"API Key Authentication\",\n" +
+	"                    \"description\": \"The attributes for API Key Authentication.\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"awsv4\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"AWS Signature v4\",\n" +
+	"                    \"description\": \"The attributes for [AWS Auth](http://docs.aws.amazon.com/AmazonS3/latest/dev/RESTAuthentication.html).\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"basic\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"Basic Authentication\",\n" +
+	"                    \"description\": \"The attributes for [Basic Authentication](https://en.wikipedia.org/wiki/Basic_access_authentication).\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"bearer\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"Bearer Token Authentication\",\n" +
+	"                    \"description\": \"The helper attributes for [Bearer Token Authentication](https://tools.ietf.org/html/rfc6750)\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"digest\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"Digest Authentication\",\n" +
+	"                    \"description\": \"The attributes for [Digest Authentication](https://en.wikipedia.org/wiki/Digest_access_authentication).\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"hawk\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"Hawk Authentication\",\n" +
+	"                    \"description\": \"The attributes for [Hawk Authentication](https://github.com/hueniverse/hawk)\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"ntlm\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"NTLM Authentication\",\n" +
+	"                    \"description\": \"The attributes for [NTLM Authentication](https://msdn.microsoft.com/en-us/library/cc237488.aspx)\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"oauth1\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"OAuth1\",\n" +
+	"                    \"description\": \"The attributes for [OAuth2](https://oauth.net/1/)\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"oauth2\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"OAuth2\",\n" +
+	"                    \"description\": \"Helper attributes for [OAuth2](https://oauth.net/2/)\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/auth-attribute\"\n" +
+	"                    }\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"type\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"certificate-list\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/certificate-list\",\n" +
+	"            \"title\": \"Certificate List\",\n" +
+	"            \"description\": \"A representation of a list of ssl certificates\",\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"items\": {\n" +
+	"                \"$ref\": \"#/definitions/certificate\"\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"certificate\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/certificate\",\n" +
+	"            \"title\": \"Certificate\",\n" +
+	"            \"description\": \"A representation of an ssl certificate\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"name\": {\n" +
+	"                    \"description\": \"A name for the certificate for user reference\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"matches\": {\n" +
+	"                    \"description\": \"A list of Url match pattern strings, to identify Urls this certificate can be used for.\",\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"item\": {\n" +
+	"                        \"type\": \"string\",\n" +
+	"                        \"description\": \"An Url match pattern string\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"key\": {\n" +
+	"                    \"description\": \"An object containing path to file containing private key, on the file system\",\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"src\": {\n" +
+	"                            \"description\": \"The path to file containing key for certificate, on the file system\"\n" +
+	"                        }\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"cert\": {\n" +
+	"                    \"description\": \"An object containing path to file certificate, on the file system\",\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"src\": {\n" +
+	"                            \"description\": \"The path to file containing key for certificate, on the file system\"\n" +
+	"                        }\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"passphrase\": {\n" +
+	"                    \"description\": \"The passphrase for the certificate\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"cookie-list\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/cookie-list\",\n" +
+	"            \"title\": \"Certificate List\",\n" +
+	"            \"description\": \"A representation of a list of cookies\",\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"items\": {\n" +
+	"                \"$ref\": \"#/definitions/cookie\"\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"cookie\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Cookie\",\n" +
+	"            \"id\": \"#/definitions/cookie\",\n" +
+	"            \"description\": \"A Cookie, that follows the [Google Chrome format](https://developer.chrome.com/extensions/cookies)\",\n" +
+	"            \"properties\": {\n" +
+	"                \"domain\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The domain for which this cookie is valid.\"\n" +
+	"                },\n" +
+	"                \"expires\": {\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"string\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"number\"\n" +
+	"                        }\n" +
+	"                    ],\n" +
+	"                    \"description\": \"When the cookie expires.\"\n" +
+	"                },\n" +
+	"                \"maxAge\": {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"hostOnly\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"description\": \"True if the cookie is a host-only cookie. (i.e. a request's URL domain must exactly match the domain of the cookie).\"\n" +
+	"                },\n" +
+	"                \"httpOnly\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"description\": \"Indicates if this cookie is HTTP Only. (if True, the cookie is inaccessible to client-side scripts)\"\n" +
+	"                },\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"This is the name of the Cookie.\"\n" +
+	"                },\n" +
+	"                \"path\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The path associated with the Cookie.\"\n" +
+	"                },\n" +
+	"                \"secure\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"description\": \"Indicates if the 'secure' flag is set on the Cookie, meaning that it is transmitted over secure connections only. (typically HTTPS)\"\n" +
+	"                },\n" +
+	"                \"session\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"description\": \"True if the cookie is a session cookie.\"\n" +
+	"                },\n" +
+	"                \"value\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The value of the Cookie.\"\n" +
+	"                },\n" +
+	"                \"extensions\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"description\": \"Custom attributes for a cookie go here, such as the [Priority Field](https://code.google.com/p/chromium/issues/detail?id=232693)\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"domain\",\n" +
+	"                \"path\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"description\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/description\",\n" +
+	"            \"description\": \"A Description can be a raw text, or be an object, which holds the description along with its format.\",\n" +
+	"            \"oneOf\": [\n" +
+	"                {\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"title\": \"Description\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"content\": {\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"description\": \"The content of the description goes here, as a raw string.\"\n" +
+	"                        },\n" +
+	"                        \"type\": {\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"description\": \"Holds the mime type of the raw description content. E.g: 'text/markdown' or 'text/html'.\\nThe type is used to correctly render the description when generating documentation, or in the Postman app.\"\n" +
+	"                        },\n" +
+	"                        \"version\": {\n" +
+	"                            \"description\": \"Description can have versions associated with it, which should be put in this property.\"\n" +
+	"                        }\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"type\": \"null\"\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"event-list\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/event-list\",\n" +
+	"            \"title\": \"Event List\",\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"description\": \"Postman allows you to configure scripts to run when specific events occur. These scripts are stored here, and can be referenced in the collection by their ID.\",\n" +
+	"            \"items\": {\n" +
+	"                \"$ref\": \"#/definitions/event\"\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"event\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/event\",\n" +
+	"            \"title\": \"Event\",\n" +
+	"            \"description\": \"Defines a script associated with an associated event name\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"id\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"A unique identifier for the enclosing event.\"\n" +
+	"                },\n" +
+	"                \"listen\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"Can be set to `test` or `prerequest` for test scripts or pre-request scripts respectively.\"\n" +
+	"                },\n" +
+	"                \"script\": {\n" +
+	"                    \"$ref\": \"#/definitions/script\"\n" +
+	"                },\n" +
+	"                \"disabled\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"default\": false,\n" +
+	"                    \"description\": \"Indicates whether the event is disabled. If absent, the event is assumed to be enabled.\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"listen\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"header-list\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/header-list\",\n" +
+	"            \"title\": \"Header List\",\n" +
+	"            \"description\": \"A representation for a list of headers\",\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"items\": {\n" +
+	"                \"$ref\": \"#/definitions/header\"\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"header\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Header\",\n" +
+	"            \"id\": \"#/definitions/header\",\n" +
+	"            \"description\": \"Represents a single HTTP Header\",\n" +
+	"            \"properties\": {\n" +
+	"                \"key\": {\n" +
+	"                    \"description\": \"This holds the LHS of the HTTP Header, e.g ``Content-Type`` or ``X-Custom-Header``\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"value\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The value (or the RHS) of the Header is stored in this field.\"\n" +
+	"                },\n" +
+	"                \"disabled\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"default\": false,\n" +
+	"                    \"description\": \"If set to true, the current header will not be sent with requests.\"\n" +
+	"                },\n" +
+	"                \"description\": {\n" +
+	"                    \"$ref\": \"#/definitions/description\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"key\",\n" +
+	"                \"value\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"info\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/info\",\n" +
+	"            \"title\": \"Information\",\n" +
+	"            \"description\": \"Detailed description of the info block\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"title\": \"Name of the collection\",\n" +
+	"                    \"description\": \"A collection's friendly name is defined by this field. You would want to set this field to a value that would allow you to easily identify this collection among a bunch of other collections, as such outlining its usage or content.\"\n" +
+	"                },\n" +
+	"                \"_postman_id\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"Every collection is identified by the unique value of this field. The value of this field is usually easiest to generate using a UID generator function. If you already have a collection, it is recommended that you maintain the same id since changing the id usually implies that is a different collection than it was originally.\\n *Note: This field exists for compatibility reasons with Collection Format V1.*\"\n" +
+	"                },\n" +
+	"                \"description\": {\n" +
+	"                    \"$ref\": \"#/definitions/description\"\n" +
+	"                },\n" +
+	"                \"version\": {\n" +
+	"                    \"$ref\": \"#/definitions/version\"\n" +
+	"                },\n" +
+	"                \"schema\": {\n" +
+	"                    \"description\": \"This should ideally hold a link to the Postman schema that is used to validate this collection. E.g: https://schema.getpostman.com/collection/v1\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"name\",\n" +
+	"                \"schema\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"item-group\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"title\": \"Folder\",\n" +
+	"            \"id\": \"#/definitions/item-group\",\n" +
+	"            \"description\": \"One of the primary goals of Postman is to organize the development of APIs. To this end, it is necessary to be able to group requests together. This can be achived using 'Folders'. A folder just is an ordered set of requests.\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"A folder's friendly name is defined by this field. You would want to set this field to a value that would allow you to easily identify this folder.\"\n" +
+	"                },\n" +
+	"                \"description\": {\n" +
+	"                    \"$ref\": \"#/definitions/description\"\n" +
+	"                },\n" +
+	"                \"variable\": {\n" +
+	"                    \"$ref\": \"#/definitions/variable-list\"\n" +
+	"                },\n" +
+	"                \"item\": {\n" +
+	"                    \"description\": \"Items are entities which contain an actual HTTP request, and sample responses attached to it. Folders may contain many items.\",\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"title\": \"Items\",\n" +
+	"                        \"anyOf\": [\n" +
+	"                            {\n" +
+	"                                \"$ref\": \"#/definitions/item\"\n" +
+	"                            },\n" +
+	"                            {\n" +
+	"                                \"$ref\": \"#/definitions/item-group\"\n" +
+	"                            }\n" +
+	"                        ]\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"event\": {\n" +
+	"                    \"$ref\": \"#/definitions/event-list\"\n" +
+	"                },\n" +
+	"                \"auth\": {\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"null\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"$ref\": \"#/definitions/auth\"\n" +
+	"                        }\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"protocolProfileBehavior\": {\n" +
+	"                    \"$ref\": \"#/definitions/protocol-profile-behavior\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"item\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"item\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Item\",\n" +
+	"            \"id\": \"#/definitions/item\",\n" +
+	"            \"description\": \"Items are entities which contain an actual HTTP request, and sample responses attached to it.\",\n" +
+	"            \"properties\": {\n" +
+	"                \"id\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"A unique ID that is used to identify collections internally\"\n" +
+	"                },\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"A human readable identifier for the current item.\"\n" +
+	"                },\n" +
+	"                \"description\": {\n" +
+	"                    \"$ref\": \"#/definitions/description\"\n" +
+	"                },\n" +
+	"                \"variable\": {\n" +
+	"                    \"$ref\": \"#/definitions/variable-list\"\n" +
+	"                },\n" +
+	"                \"event\": {\n" +
+	"                    \"$ref\": \"#/definitions/event-list\"\n" +
+	"                },\n" +
+	"                \"request\": {\n" +
+	"                    \"$ref\": \"#/definitions/request\"\n" +
+	"                },\n" +
+	"                \"response\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"title\": \"Responses\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/response\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"protocolProfileBehavior\": {\n" +
+	"                    \"$ref\": \"#/definitions/protocol-profile-behavior\"\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"required\": [\n" +
+	"                \"request\"\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"protocol-profile-behavior\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"title\": \"Protocol Profile Behavior\",\n" +
+	"            \"id\": \"#/definitions/protocol-profile-behavior\",\n" +
+	"            \"description\": \"Set of configurations used to alter the usual behavior of sending the request\"\n" +
+	"        },\n" +
+	"        \"proxy-config\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/proxy-config\",\n" +
+	"            \"title\": \"Proxy Config\",\n" +
+	"            \"description\": \"Using the Proxy, you can configure your custom proxy into the postman for particular url match\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"match\": {\n" +
+	"                    \"default\": \"http+https://*/*\",\n" +
+	"                    \"description\": \"The Url match for which the proxy config is defined\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"host\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The proxy server host\"\n" +
+	"                },\n" +
+	"                \"port\": {\n" +
+	"                    \"type\": \"integer\",\n" +
+	"                    \"minimum\": 0,\n" +
+	"                    \"default\": 8080,\n" +
+	"                    \"description\": \"The proxy server port\"\n" +
+	"                },\n" +
+	"                \"tunnel\": {\n" +
+	"                    \"description\": \"The tunneling details for the proxy config\",\n" +
+	"                    \"default\": false,\n" +
+	"                    \"type\": \"boolean\"\n" +
+	"                },\n" +
+	"                \"disabled\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"default\": false,\n" +
+	"                    \"description\": \"When set to true, ignores this proxy configuration entity\"\n" +
+	"                }\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"request\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/request\",\n" +
+	"            \"title\": \"Request\",\n" +
+	"            \"description\": \"A request represents an HTTP request. If a string, the string is assumed to be the request URL and the method is assumed to be 'GET'.\",\n" +
+	"            \"oneOf\": [\n" +
+	"                {\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"title\": \"Request\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"url\": {\n" +
+	"                            \"$ref\": \"#/definitions/url\"\n" +
+	"                        },\n" +
+	"                        \"auth\": {\n" +
+	"                            \"oneOf\": [\n" +
+	"                                {\n" +
+	"                                    \"type\": \"null\"\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"$ref\": \"#/definitions/auth\"\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        },\n" +
+	"                        \"proxy\": {\n" +
+	"                            \"$ref\": \"#/definitions/proxy-config\"\n" +
+	"                        },\n" +
+	"                        \"certificate\": {\n" +
+	"                            \"$ref\": \"#/definitions/certificate\"\n" +
+	"                        },\n" +
+	"                        \"method\": {\n" +
+	"                            \"anyOf\": [\n" +
+	"                                {\n" +
+	"                                    \"description\": \"The Standard HTTP method associated with this request.\",\n" +
+	"                                    \"type\": \"string\",\n" +
+	"                                    \"enum\": [\n" +
+	"                                        \"GET\",\n" +
+	"                                        \"PUT\",\n" +
+	"                                        \"POST\",\n" +
+	"                                        \"PATCH\",\n" +
+	"                                        \"DELETE\",\n" +
+	"                                        \"COPY\",\n" +
+	"                                        \"HEAD\",\n" +
+	"                                        \"OPTIONS\",\n" +
+	"                                        \"LINK\",\n" +
+	"                                        \"UNLINK\",\n" +
+	"                                        \"PURGE\",\n" +
+	"                                        \"LOCK\",\n" +
+	"                                        \"UNLOCK\",\n" +
+	"                                        \"PROPFIND\",\n" +
+	"                                        \"VIEW\"\n" +
+	"                                    ]\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"description\": \"The Custom HTTP method associated with this request.\",\n" +
+	"                                    \"type\": \"string\"\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        },\n" +
+	"                        \"description\": {\n" +
+	"                            \"$ref\": \"#/definitions/description\"\n" +
+	"                        },\n" +
+	"                        \"header\": {\n" +
+	"                            \"oneOf\": [\n" +
+	"                                {\n" +
+	"                                    \"$ref\": \"#/definitions/header-list\"\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"type\": \"string\"\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        },\n" +
+	"                        \"body\": {\n" +
+	"                            \"oneOf\": [\n" +
+	"                                {\n" +
+	"                                    \"type\": \"object\",\n" +
+	"                                    \"description\": \"This field contains the data usually contained in the request body.\",\n" +
+	"                                    \"properties\": {\n" +
+	"                                        \"mode\": {\n" +
+	"                                            \"description\": \"Postman stores the type of data associated with this request in this field.\",\n" +
+	"                                            \"enum\": [\n" +
+	"                                                \"raw\",\n" +
+	"                                                \"urlencoded\",\n" +
+	"                                                \"formdata\",\n" +
+	"                                                \"file\",\n" +
+	"                                                \"graphql\"\n" +
+	"                                            ]\n" +
+	"                                        },\n" +
+	"                                        \"raw\": {\n" +
+	"                                            \"type\": \"string\"\n" +
+	"                                        },\n" +
+	"                                        \"urlencoded\": {\n" +
+	"                                            \"type\": \"array\",\n" +
+	"                                            \"items\": {\n" +
+	"                                                \"type\": \"object\",\n" +
+	"                                                \"title\": \"UrlEncodedParameter\",\n" +
+	"                                                \"properties\": {\n" +
+	"                                                    \"key\": {\n" +
+	"                                                        \"type\": \"string\"\n" +
+	"                                                    },\n" +
+	"                                                    \"value\": {\n" +
+	"                                                        \"type\": \"string\"\n" +
+	"                                                    },\n" +
+	"                                                    \"disabled\": {\n" +
+	"                                                        \"type\": \"boolean\",\n" +
+	"                                                        \"default\": false\n" +
+	"                                                    },\n" +
+	"                                                    \"description\": {\n" +
+	"                                                        \"$ref\": \"#/definitions/description\"\n" +
+	"                                                    }\n" +
+	"                                                },\n" +
+	"                                                \"required\": [\n" +
+	"                                                    \"key\"\n" +
+	"                                                ]\n" +
+	"                                            }\n" +
+	"                                        },\n" +
+	"                                        \"formdata\": {\n" +
+	"                                            \"type\": \"array\",\n" +
+	"                                            \"items\": {\n" +
+	"                                                \"type\": \"object\",\n" +
+	"                                                \"title\": \"FormParameter\",\n" +
+	"                                                \"oneOf\": [\n" +
+	"                                                    {\n" +
+	"                                                        \"properties\": {\n" +
+	"                                                            \"key\": {\n" +
+	"                                                                \"type\": \"string\"\n" +
+	"                                                            },\n" +
+	"                                                            \"value\": {\n" +
+	"                                                                \"type\": \"string\"\n" +
+	"                                                            },\n" +
+	"                                                            \"disabled\": {\n" +
+	"                                                                \"type\": \"boolean\",\n" +
+	"                                                                \"default\": false,\n" +
+	"                                                                \"description\": \"When set to true, prevents this form data entity from being sent.\"\n" +
+	"                                                            },\n" +
+	"                                                            \"type\": {\n" +
+	"                                                                \"type\": \"string\",\n" +
+	"                                                                \"enum\": [\n" +
+	"                                                                    \"text\"\n" +
+	"                                                                ]\n" +
+	"                                                            },\n" +
+	"                                                            \"contentType\": {\n" +
+	"                                                                \"type\": \"string\",\n" +
+	"                                                                \"description\": \"Override Content-Type header of this form data entity.\"\n" +
+	"                                                            },\n" +
+	"                                                            \"description\": {\n" +
+	"                                                                \"$ref\": \"#/definitions/description\"\n" +
+	"                                                            }\n" +
+	"                                                        },\n" +
+	"                                                        \"required\": [\n" +
+	"                                                            \"key\"\n" +
+	"                                                        ]\n" +
+	"                                                    },\n" +
+	"                                                    {\n" +
+	"                                                        \"properties\": {\n" +
+	"                                                            \"key\": {\n" +
+	"                                                                \"type\": \"string\"\n" +
+	"                                                            },\n" +
+	"                                                            \"src\": {\n" +
+	"                                                                \"oneOf\": [\n" +
+	"                                                                    {\n" +
+	"                                                                        \"type\": \"string\"\n" +
+	"                                                                    },\n" +
+	"                                                                    {\n" +
+	"                                                                        \"type\": \"null\"\n" +
+	"                                                                    },\n" +
+	"                                                                    {\n" +
+	"                                                                        \"type\": \"array\"\n" +
+	"                                                                    }\n" +
+	"                                                                ]\n" +
+	"                                                            },\n" +
+	"                                                            \"disabled\": {\n" +
+	"                                                                \"type\": \"boolean\",\n" +
+	"                                                                \"default\": false,\n" +
+	"                                                                \"description\": \"When set to true, prevents this form data entity from being sent.\"\n" +
+	"                                                            },\n" +
+	"                                                            \"type\": {\n" +
+	"                                                                \"type\": \"string\",\n" +
+	"                                                                \"enum\": [\n" +
+	"                                                                    \"file\"\n" +
+	"                                                                ]\n" +
+	"                                                            },\n" +
+	"                                                            \"contentType\": {\n" +
+	"                                                                \"type\": \"string\",\n" +
+	"                                                                \"description\": \"Override Content-Type header of this form data entity.\"\n" +
+	"                                                            },\n" +
+	"                                                            \"description\": {\n" +
+	"                                                                \"$ref\": \"#/definitions/description\"\n" +
+	"                                                            }\n" +
+	"                                                        },\n" +
+	"                                                        \"required\": [\n" +
+	"                                                            \"key\"\n" +
+	"                                                        ]\n" +
+	"                                                    }\n" +
+	"                                                ]\n" +
+	"                                            }\n" +
+	"                                        },\n" +
+	"                                        \"file\": {\n" +
+	"                                            \"type\": \"object\",\n" +
+	"                                            \"properties\": {\n" +
+	"                                                \"src\": {\n" +
+	"                                                    \"oneOf\": [\n" +
+	"                                                        {\n" +
+	"                                                            \"type\": \"string\",\n" +
+	"                                                            \"description\": \"Contains the name of the file to upload. _Not the path_.\"\n" +
+	"                                                        },\n" +
+	"                                                        {\n" +
+	"                                                            \"type\": \"null\",\n" +
+	"                                                            \"description\": \"A null src indicates that no file has been selected as a part of the request body\"\n" +
+	"                                                        }\n" +
+	"                                                    ]\n" +
+	"                                                },\n" +
+	"                                                \"content\": {\n" +
+	"                                                    \"type\": \"string\"\n" +
+	"                                                }\n" +
+	"                                            }\n" +
+	"                                        },\n" +
+	"                                        \"graphql\": {\n" +
+	"                                            \"type\": \"object\"\n" +
+	"                                        },\n" +
+	"                                        \"disabled\": {\n" +
+	"                                            \"type\": \"boolean\",\n" +
+	"                                            \"default\": false,\n" +
+	"                                            \"description\": \"When set to true, prevents request body from being sent.\"\n" +
+	"                                        }\n" +
+	"                                    }\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"type\": \"null\"\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        }\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"response\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/response\",\n" +
+	"            \"title\": \"Response\",\n" +
+	"            \"description\": \"A response represents an HTTP response.\",\n" +
+	"            \"properties\": {\n" +
+	"                \"id\": {\n" +
+	"                    \"description\": \"A unique, user defined identifier that can  be used to refer to this response from requests.\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"originalRequest\": {\n" +
+	"                    \"$ref\": \"#/definitions/request\"\n" +
+	"                },\n" +
+	"                \"responseTime\": {\n" +
+	"                    \"title\": \"ResponseTime\",\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"null\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"string\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"number\"\n" +
+	"                        }\n" +
+	"                    ],\n" +
+	"                    \"description\": \"The time taken by the request to complete. If a number, the unit is milliseconds. If the response is manually created, this can be set to `null`.\"\n" +
+	"                },\n" +
+	"                \"timings\": {\n" +
+	"                    \"title\": \"Response Timings\",\n" +
+	"                    \"description\": \"Set of timing information related to request and response in milliseconds\",\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"object\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"null\"\n" +
+	"                        }\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"header\": {\n" +
+	"                    \"title\": \"Headers\",\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"array\",\n" +
+	"                            \"title\": \"Header\",\n" +
+	"                            \"description\": \"No HTTP request is complete without its headers, and the same is true for a Postman request. This field is an array containing all the headers.\",\n" +
+	"                            \"items\": {\n" +
+	"                                \"oneOf\": [\n" +
+	"                                    {\n" +
+	"                                        \"$ref\": \"#/definitions/header\"\n" +
+	"                                    },\n" +
+	"                                    {\n" +
+	"                                        \"title\": \"Header\",\n" +
+	"                                        \"type\": \"string\"\n" +
+	"                                    }\n" +
+	"                                ]\n" +
+	"                            }\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"string\"\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"null\"\n" +
+	"                        }\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"cookie\": {\n" +
+	"                    \"type\": \"array\",\n" +
+	"                    \"items\": {\n" +
+	"                        \"$ref\": \"#/definitions/cookie\"\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                \"body\": {\n" +
+	"                    \"type\": [\n" +
+	"                        \"null\",\n" +
+	"                        \"string\"\n" +
+	"                    ],\n" +
+	"                    \"description\": \"The raw text of the response.\"\n" +
+	"                },\n" +
+	"                \"status\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"The response status, e.g: '200 OK'\"\n" +
+	"                },\n" +
+	"                \"code\": {\n" +
+	"                    \"type\": \"integer\",\n" +
+	"                    \"description\": \"The numerical response code, example: 200, 201, 404, etc.\"\n" +
+	"                }\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"script\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/script\",\n" +
+	"            \"title\": \"Script\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"description\": \"A script is a snippet of Javascript code that can be used to to perform setup or teardown operations on a particular response.\",\n" +
+	"            \"properties\": {\n" +
+	"                \"id\": {\n" +
+	"                    \"description\": \"A unique, user defined identifier that can  be used to refer to this script from requests.\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"type\": {\n" +
+	"                    \"description\": \"Type of the script. E.g: 'text/javascript'\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"exec\": {\n" +
+	"                    \"oneOf\": [\n" +
+	"                        {\n" +
+	"                            \"type\": \"array\",\n" +
+	"                            \"description\": \"This is an array of strings, where each line represents a single line of code. Having lines separate makes it possible to easily track changes made to scripts.\",\n" +
+	"                            \"items\": {\n" +
+	"                                \"type\": \"string\"\n" +
+	"                            }\n" +
+	"                        },\n" +
+	"                        {\n" +
+	"                            \"type\": \"string\"\n" +
+	"                        }\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"src\": {\n" +
+	"                    \"$ref\": \"#/definitions/url\"\n" +
+	"                },\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"Script name\"\n" +
+	"                }\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"url\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"description\": \"If object, contains the complete broken-down URL for this request. If string, contains the literal request URL.\",\n" +
+	"            \"id\": \"#/definitions/url\",\n" +
+	"            \"title\": \"Url\",\n" +
+	"            \"oneOf\": [\n" +
+	"                {\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"raw\": {\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"description\": \"The string representation of the request URL, including the protocol, host, path, hash, query parameter(s) and path variable(s).\"\n" +
+	"                        },\n" +
+	"                        \"protocol\": {\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"description\": \"The protocol associated with the request, E.g: 'http'\"\n" +
+	"                        },\n" +
+	"                        \"host\": {\n" +
+	"                            \"title\": \"Host\",\n" +
+	"                            \"description\": \"The host for the URL, E.g: api.yourdomain.com. Can be stored as a string or as an array of strings.\",\n" +
+	"                            \"oneOf\": [\n" +
+	"                                {\n" +
+	"                                    \"type\": \"string\"\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"type\": \"array\",\n" +
+	"                                    \"items\": {\n" +
+	"                                        \"type\": \"string\"\n" +
+	"                                    },\n" +
+	"                                    \"description\": \"The host, split into subdomain strings.\"\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        },\n" +
+	"                        \"path\": {\n" +
+	"                            \"oneOf\": [\n" +
+	"                                {\n" +
+	"                                    \"type\": \"string\"\n" +
+	"                                },\n" +
+	"                                {\n" +
+	"                                    \"type\": \"array\",\n" +
+	"                                    \"description\": \"The complete path of the current url, broken down into segments. A segment could be a string, or a path variable.\",\n" +
+	"                                    \"items\": {\n" +
+	"                                        \"oneOf\": [\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"string\"\n" +
+	"                                            },\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"object\",\n" +
+	"                                                \"properties\": {\n" +
+	"                                                    \"type\": {\n" +
+	"                                                        \"type\": \"string\"\n" +
+	"                                                    },\n" +
+	"                                                    \"value\": {\n" +
+	"                                                        \"type\": \"string\"\n" +
+	"                                                    }\n" +
+	"                                                }\n" +
+	"                                            }\n" +
+	"                                        ]\n" +
+	"                                    }\n" +
+	"                                }\n" +
+	"                            ]\n" +
+	"                        },\n" +
+	"                        \"port\": {\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"description\": \"The port number present in this URL. An empty value implies 80/443 depending on whether the protocol field contains http/https.\"\n" +
+	"                        },\n" +
+	"                        \"query\": {\n" +
+	"                            \"type\": \"array\",\n" +
+	"                            \"description\": \"An array of QueryParams, which is basically the query string part of the URL, parsed into separate variables\",\n" +
+	"                            \"items\": {\n" +
+	"                                \"type\": \"object\",\n" +
+	"                                \"title\": \"QueryParam\",\n" +
+	"                                \"properties\": {\n" +
+	"                                    \"key\": {\n" +
+	"                                        \"oneOf\": [\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"string\"\n" +
+	"                                            },\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"null\"\n" +
+	"                                            }\n" +
+	"                                        ]\n" +
+	"                                    },\n" +
+	"                                    \"value\": {\n" +
+	"                                        \"oneOf\": [\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"string\"\n" +
+	"                                            },\n" +
+	"                                            {\n" +
+	"                                                \"type\": \"null\"\n" +
+	"                                            }\n" +
+	"                                        ]\n" +
+	"                                    },\n" +
+	"                                    \"disabled\": {\n" +
+	"                                        \"type\": \"boolean\",\n" +
+	"                                        \"default\": false,\n" +
+	"                                        \"description\": \"If set to true, the current query parameter will not be sent with the request.\"\n" +
+	"                                    },\n" +
+	"                                    \"description\": {\n" +
+	"                                        \"$ref\": \"#/definitions/description\"\n" +
+	"                                    }\n" +
+	"                                }\n" +
+	"                            }\n" +
+	"                        },\n" +
+	"                        \"hash\": {\n" +
+	"                            \"description\": \"Contains the URL fragment (if any). Usually this is not transmitted over the network, but it could be useful to store this in some cases.\",\n" +
+	"                            \"type\": \"string\"\n" +
+	"                        },\n" +
+	"                        \"variable\": {\n" +
+	"                            \"type\": \"array\",\n" +
+	"                            \"description\": \"Postman supports path variables with the syntax `/path/:variableName/to/somewhere`. These variables are stored in this field.\",\n" +
+	"                            \"items\": {\n" +
+	"                                \"$ref\": \"#/definitions/variable\"\n" +
+	"                            }\n" +
+	"                        }\n" +
+	"                    }\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"variable-list\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/variable-list\",\n" +
+	"            \"title\": \"Variable List\",\n" +
+	"            \"description\": \"Collection variables allow you to define a set of variables, that are a *part of the collection*, as opposed to environments, which are separate entities.\\n*Note: Collection variables must not contain any sensitive information.*\",\n" +
+	"            \"type\": \"array\",\n" +
+	"            \"items\": {\n" +
+	"                \"$ref\": \"#/definitions/variable\"\n" +
+	"            }\n" +
+	"        },\n" +
+	"        \"variable\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/variable\",\n" +
+	"            \"title\": \"Variable\",\n" +
+	"            \"description\": \"Using variables in your Postman requests eliminates the need to duplicate requests, which can save a lot of time. Variables can be defined, and referenced to from any part of a request.\",\n" +
+	"            \"type\": \"object\",\n" +
+	"            \"properties\": {\n" +
+	"                \"id\": {\n" +
+	"                    \"description\": \"A variable ID is a unique user-defined value that identifies the variable within a collection. In traditional terms, this would be a variable name.\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"key\": {\n" +
+	"                    \"description\": \"A variable key is a human friendly value that identifies the variable within a collection. In traditional terms, this would be a variable name.\",\n" +
+	"                    \"type\": \"string\"\n" +
+	"                },\n" +
+	"                \"value\": {\n" +
+	"                    \"description\": \"The value that a variable holds in this collection. Ultimately, the variables will be replaced by this value, when say running a set of requests from a collection\"\n" +
+	"                },\n" +
+	"                \"type\": {\n" +
+	"                    \"description\": \"A variable may have multiple types. This field specifies the type of the variable.\",\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"enum\": [\n" +
+	"                        \"string\",\n" +
+	"                        \"boolean\",\n" +
+	"                        \"any\",\n" +
+	"                        \"number\"\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                \"name\": {\n" +
+	"                    \"type\": \"string\",\n" +
+	"                    \"description\": \"Variable name\"\n" +
+	"                },\n" +
+	"                \"description\": {\n" +
+	"                    \"$ref\": \"#/definitions/description\"\n" +
+	"                },\n" +
+	"                \"system\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"default\": false,\n" +
+	"                    \"description\": \"When set to true, indicates that this variable has been set by Postman\"\n" +
+	"                },\n" +
+	"                \"disabled\": {\n" +
+	"                    \"type\": \"boolean\",\n" +
+	"                    \"default\": false\n" +
+	"                }\n" +
+	"            },\n" +
+	"            \"anyOf\": [\n" +
+	"                {\n" +
+	"                    \"required\": [\n" +
+	"                        \"id\"\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"required\": [\n" +
+	"                        \"key\"\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"required\": [\n" +
+	"                        \"id\",\n" +
+	"                        \"key\"\n" +
+	"                    ]\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        },\n" +
+	"        \"version\": {\n" +
+	"            \"$schema\": \"http://json-schema.org/draft-04/schema#\",\n" +
+	"            \"id\": \"#/definitions/version\",\n" +
+	"            \"title\": \"Collection Version\",\n" +
+	"            \"description\": \"Postman allows you to version your collections as they grow, and this field holds the version number. While optional, it is recommended that you use this field to its fullest extent!\",\n" +
+	"            \"oneOf\": [\n" +
+	"                {\n" +
+	"                    \"type\": \"object\",\n" +
+	"                    \"properties\": {\n" +
+	"                        \"major\": {\n" +
+	"                            \"description\": \"Increment this number if you make changes to the collection that changes its behaviour. E.g: Removing or adding new test scripts. (partly or completely).\",\n" +
+	"                            \"minimum\": 0,\n" +
+	"                            \"type\": \"integer\"\n" +
+	"                        },\n" +
+	"                        \"minor\": {\n" +
+	"                            \"description\": \"You should increment this number if you make changes that will not break anything that uses the collection. E.g: removing a folder.\",\n" +
+	"                            \"minimum\": 0,\n" +
+	"                            \"type\": \"integer\"\n" +
+	"                        },\n" +
+	"                        \"patch\": {\n" +
+	"                            \"description\": \"Ideally, minor changes to a collection should result in the increment of this number.\",\n" +
+	"                            \"minimum\": 0,\n" +
+	"                            \"type\": \"integer\"\n" +
+	"                        },\n" +
+	"                        \"identifier\": {\n" +
+	"                            \"description\": \"A human friendly identifier to make sense of the version numbers. E.g: 'beta-3'\",\n" +
+	"                            \"type\": \"string\",\n" +
+	"                            \"maxLength\": 10\n" +
+	"                        },\n" +
+	"                        \"meta\": {}\n" +
+	"                    },\n" +
+	"                    \"required\": [\n" +
+	"                        \"major\",\n" +
+	"                        \"minor\",\n" +
+	"                        \"patch\"\n" +
+	"                    ]\n" +
+	"                },\n" +
+	"                {\n" +
+	"                    \"type\": \"string\"\n" +
+	"                }\n" +
+	"            ]\n" +
+	"        }\n" +
+	"    }\n" +
+	"}"
@@ -16,11 +16,348 @@ limitations under the License.
 
 package resources
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// SkipFolder is used as a return value from a WalkFunc to indicate that the
+// contents of the folder named in the call are to be skipped. It is not
+// returned as an error by any function.
+var SkipFolder = errors.New("skip this folder")
+
 // ItemTree represents a folder/request structure in a Collection
 type ItemTree struct {
 	Root ItemTreeNode
 }
 
+// MarshalJSON converts the tree to the "item" array of a collection.
+func (t ItemTree) MarshalJSON() ([]byte, error) {
+	return t.Root.marshalChildren()
+}
+
+// ItemRef points to a folder or a request in an ItemTree. Exactly one of
+// Node and Item is set.
+type ItemRef struct {
+	// Path holds the names of the folders enclosing the folder or request.
+	Path []string
+	Node *ItemTreeNode
+	Item *Item
+}
+
+// Name returns the name of the folder or request.
+func (r ItemRef) Name() string {
+	if r.Item != nil && r.Item.Item != nil {
+		return r.Item.Name
+	}
+
+	if r.Node != nil && r.Node.ItemGroup != nil && r.Node.ItemGroup.ItemGroup != nil {
+		return r.Node.ItemGroup.Name
+	}
+
+	return ""
+}
+
+// FullPath returns the path of the folder or request, suitable for
+// FindByPath.
+func (r ItemRef) FullPath() string {
+	return JoinItemPath(append(append([]string{}, r.Path...), r.Name())...)
+}
+
+// IsFolder reports whether the reference points to a folder.
+func (r ItemRef) IsFolder() bool {
+	return r.Node != nil
+}
+
+// WalkFunc is the type of the function called by Walk for each folder and
+// request. If the function returns SkipFolder when invoked on a folder, Walk
+// skips the folder's contents. Any other error stops the walk and is
+// returned by Walk.
+type WalkFunc func(ref ItemRef) error
+
+// Walk visits every folder and request in the tree depth-first, in
+// collection order.
+func (t *ItemTree) Walk(fn WalkFunc) error {
+	return t.Root.Walk(fn)
+}
+
+// Walk visits every folder and request below the node depth-first, in
+// collection order.
+func (b *ItemTreeNode) Walk(fn WalkFunc) error {
+	return b.walk(nil, fn)
+}
+
+func (b *ItemTreeNode) walk(path []string, fn WalkFunc) error {
+	for _, c := range b.children() {
+		ref := ItemRef{Path: path, Node: c.node, Item: c.item}
+		err := fn(ref)
+
+		if c.node == nil {
+			if err != nil && err != SkipFolder {
+				return err
+			}
+			continue
+		}
+
+		if err == SkipFolder {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		childPath := append(append([]string{}, path...), ref.Name())
+		if err := c.node.walk(childPath, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByPath returns the folder or request at the given path, made of folder
+// names followed by a folder or request name and separated by "/", such as
+// "Folder/Sub/Request". A "/" within a name is escaped as "\/". When
+// siblings share a name the first one wins.
+func (t *ItemTree) FindByPath(path string) (ItemRef, bool) {
+	names := SplitItemPath(path)
+	if len(names) == 0 {
+		return ItemRef{}, false
+	}
+
+	node := &t.Root
+	for i, name := range names {
+		var next *itemTreeChild
+		children := node.children()
+		for j := range children {
+			if children[j].name() == name {
+				next = &children[j]
+				break
+			}
+		}
+
+		if next == nil {
+			return ItemRef{}, false
+		}
+
+		if i == len(names)-1 {
+			return ItemRef{Path: names[:i], Node: next.node, Item: next.item}, true
+		}
+
+		if next.node == nil {
+			return ItemRef{}, false
+		}
+
+		node = next.node
+	}
+
+	return ItemRef{}, false
+}
+
+// FindByID returns the folder or request with the given ID.
+func (t *ItemTree) FindByID(id string) (ItemRef, bool) {
+	var found ItemRef
+	err := t.Walk(func(ref ItemRef) error {
+		if ref.Item != nil && ref.Item.Item != nil && ref.Item.ID == id {
+			found = ref
+			return errItemFound
+		}
+
+		if ref.Node != nil && ref.Node.ItemGroup != nil && ref.Node.ItemGroup.id() == id {
+			found = ref
+			return errItemFound
+		}
+
+		return nil
+	})
+
+	return found, err == errItemFound
+}
+
+var errItemFound = errors.New("item found")
+
+// Requests returns every request in the tree in collection order, along
+// with the names of its enclosing folders.
+func (t *ItemTree) Requests() []ItemRef {
+	var refs []ItemRef
+	// The walk function never fails.
+	_ = t.Walk(func(ref ItemRef) error {
+		if ref.Item != nil {
+			refs = append(refs, ref)
+		}
+		return nil
+	})
+
+	return refs
+}
+
+// Remove removes the folder or request at the given path.
+func (t *ItemTree) Remove(path string) error {
+	_, err := t.remove(path)
+	return err
+}
+
+// Move moves the folder or request at the given path to the end of the
+// folder at the destination path. An empty destination moves it to the top
+// level of the tree.
+func (t *ItemTree) Move(path, destination string) error {
+	ref, ok := t.FindByPath(path)
+	if !ok {
+		return fmt.Errorf("item not found: %s", path)
+	}
+
+	if _, err := t.folder(destination); err != nil {
+		return err
+	}
+
+	if ref.IsFolder() {
+		full := SplitItemPath(path)
+		dest := SplitItemPath(destination)
+		if len(dest) >= len(full) && JoinItemPath(dest[:len(full)]...) == JoinItemPath(full...) {
+			return fmt.Errorf("cannot move %s into itself", path)
+		}
+	}
+
+	c, err := t.remove(path)
+	if err != nil {
+		return err
+	}
+
+	// Removing may shift the destination within its parent, so look it up
+	// again.
+	dest, err := t.folder(destination)
+	if err != nil {
+		return err
+	}
+
+	if c.node != nil {
+		dest.AddBranch(*c.node)
+	} else {
+		dest.AddItem(*c.item)
+	}
+
+	return nil
+}
+
+// Rename renames the folder or request at the given path.
+func (t *ItemTree) Rename(path, name string) error {
+	ref, ok := t.FindByPath(path)
+	if !ok {
+		return fmt.Errorf("item not found: %s", path)
+	}
+
+	if ref.Item != nil {
+		if ref.Item.Item == nil {
+			ref.Item.Item = &gen.Item{}
+		}
+		ref.Item.Name = name
+
+		return nil
+	}
+
+	if ref.Node.ItemGroup == nil {
+		ref.Node.ItemGroup = &ItemGroup{}
+	}
+	if ref.Node.ItemGroup.ItemGroup == nil {
+		ref.Node.ItemGroup.ItemGroup = &gen.ItemGroup{}
+	}
+	ref.Node.ItemGroup.Name = name
+
+	return nil
+}
+
+func (t *ItemTree) folder(path string) (*ItemTreeNode, error) {
+	if path == "" {
+		return &t.Root, nil
+	}
+
+	ref, ok := t.FindByPath(path)
+	if !ok || !ref.IsFolder() {
+		return nil, fmt.Errorf("folder not found: %s", path)
+	}
+
+	return ref.Node, nil
+}
+
+func (t *ItemTree) remove(path string) (itemTreeChild, error) {
+	names := SplitItemPath(path)
+	if len(names) == 0 {
+		return itemTreeChild{}, fmt.Errorf("item not found: %s", path)
+	}
+
+	parent, err := t.folder(JoinItemPath(names[:len(names)-1]...))
+	if err != nil {
+		return itemTreeChild{}, fmt.Errorf("item not found: %s", path)
+	}
+
+	for _, c := range parent.children() {
+		if c.name() != names[len(names)-1] {
+			continue
+		}
+
+		// Copy the value out before the backing slice is rearranged.
+		removed := itemTreeChild{entry: c.entry}
+		if c.node != nil {
+			node := *c.node
+			removed.node = &node
+		} else {
+			item := *c.item
+			removed.item = &item
+		}
+
+		parent.removeChild(c.entry)
+		return removed, nil
+	}
+
+	return itemTreeChild{}, fmt.Errorf("item not found: %s", path)
+}
+
+// JoinItemPath joins folder and request names into a path for FindByPath.
+func JoinItemPath(names ...string) string {
+	escaped := make([]string, len(names))
+	for i, n := range names {
+		escaped[i] = strings.ReplaceAll(strings.ReplaceAll(n, `\`, `\\`), "/", `\/`)
+	}
+
+	return strings.Join(escaped, "/")
+}
+
+// SplitItemPath splits a path created by JoinItemPath into names.
+func SplitItemPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	var (
+		names   []string
+		current strings.Builder
+		escaped bool
+	)
+
+	for _, r := range path {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/':
+			names = append(names, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return append(names, current.String())
+}
+
 // AddBranch adds a branch to a tree.
 func (b *ItemTreeNode) AddBranch(br ItemTreeNode) ItemTreeNode {
 	if b.Branches == nil {
@@ -30,6 +367,8 @@ func (b *ItemTreeNode) AddBranch(br ItemTreeNode) ItemTreeNode {
 		b.Branches = &branch
 	}
 
+	b.order = append(b.order, itemTreeEntry{branch: true, index: len(*b.Branches) - 1})
+
 	return br
 }
 
@@ -42,6 +381,8 @@ func (b *ItemTreeNode) AddItem(item Item) Item {
 		b.Items = &items
 	}
 
+	b.order = append(b.order, itemTreeEntry{index: len(*b.Items) - 1})
+
 	return item
 }
 
@@ -51,6 +392,138 @@ func (b *ItemTreeNode) MakeGroup(group ItemGroup) ItemGroup {
 	return *b.ItemGroup
 }
 
+// MarshalJSON converts the node to a folder in a collection.
+func (b ItemTreeNode) MarshalJSON() ([]byte, error) {
+	children, err := b.marshalChildren()
+	if err != nil {
+		return nil, err
+	}
+
+	if b.ItemGroup == nil || b.ItemGroup.ItemGroup == nil {
+		return json.Marshal(struct {
+			Item json.RawMessage `json:"item"`
+		}{
+			Item: children,
+		})
+	}
+
+	group := *b.ItemGroup.ItemGroup
+	group.Event = genEvents(b.ItemGroup.Events, group.Event)
+
+	replace := map[string]json.RawMessage{"item": children}
+	return marshalMerged(b.ItemGroup.raw, &group, &gen.ItemGroup{}, replace)
+}
+
+// marshalChildren writes branches and items in the order they were added,
+// followed by any that were appended to Branches or Items directly.
+func (b ItemTreeNode) marshalChildren() ([]byte, error) {
+	buf := bytes.NewBufferString("[")
+	for i, c := range b.children() {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		var (
+			v   []byte
+			err error
+		)
+		if c.node != nil {
+			v, err = json.Marshal(c.node)
+		} else {
+			v, err = json.Marshal(c.item)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteString("]")
+
+	return buf.Bytes(), nil
+}
+
+// itemTreeChild is a branch or an item of a node, pointing into the node's
+// Branches or Items.
+type itemTreeChild struct {
+	entry itemTreeEntry
+	node  *ItemTreeNode
+	item  *Item
+}
+
+func (c itemTreeChild) name() string {
+	return ItemRef{Node: c.node, Item: c.item}.Name()
+}
+
+// children returns branches and items in the order they were added,
+// followed by any that were appended to Branches or Items directly.
+func (b *ItemTreeNode) children() []itemTreeChild {
+	var branches []ItemTreeNode
+	if b.Branches != nil {
+		branches = *b.Branches
+	}
+
+	var items []Item
+	if b.Items != nil {
+		items = *b.Items
+	}
+
+	seenBranches := make([]bool, len(branches))
+	seenItems := make([]bool, len(items))
+	children := make([]itemTreeChild, 0, len(branches)+len(items))
+
+	for _, e := range b.order {
+		if e.branch && e.index < len(branches) && !seenBranches[e.index] {
+			seenBranches[e.index] = true
+			children = append(children, itemTreeChild{entry: e, node: &branches[e.index]})
+		} else if !e.branch && e.index < len(items) && !seenItems[e.index] {
+			seenItems[e.index] = true
+			children = append(children, itemTreeChild{entry: e, item: &items[e.index]})
+		}
+	}
+
+	for i := range branches {
+		if !seenBranches[i] {
+			e := itemTreeEntry{branch: true, index: i}
+			children = append(children, itemTreeChild{entry: e, node: &branches[i]})
+		}
+	}
+
+	for i := range items {
+		if !seenItems[i] {
+			e := itemTreeEntry{index: i}
+			children = append(children, itemTreeChild{entry: e, item: &items[i]})
+		}
+	}
+
+	return children
+}
+
+// removeChild removes a branch or item and shifts the recorded positions of
+// the ones after it.
+func (b *ItemTreeNode) removeChild(e itemTreeEntry) {
+	if e.branch {
+		branches := append((*b.Branches)[:e.index:e.index], (*b.Branches)[e.index+1:]...)
+		b.Branches = &branches
+	} else {
+		items := append((*b.Items)[:e.index:e.index], (*b.Items)[e.index+1:]...)
+		b.Items = &items
+	}
+
+	order := make([]itemTreeEntry, 0, len(b.order))
+	for _, o := range b.order {
+		if o == e {
+			continue
+		}
+
+		if o.branch == e.branch && o.index > e.index {
+			o.index--
+		}
+		order = append(order, o)
+	}
+	b.order = order
+}
+
 // NewItemTree creates a new tree for storing items.
 func NewItemTree() *ItemTree {
 	tree := &ItemTree{}
@@ -62,4 +535,13 @@ type ItemTreeNode struct {
 	*ItemGroup
 	Branches *[]ItemTreeNode
 	Items    *[]Item
+
+	order []itemTreeEntry
+}
+
+// itemTreeEntry records the position of a branch or item within its parent,
+// since a collection can interleave folders and requests.
+type itemTreeEntry struct {
+	branch bool
+	index  int
 }
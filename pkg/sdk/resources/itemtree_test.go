@@ -0,0 +1,260 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+func walkedPaths(t *testing.T, tree *resources.ItemTree) []string {
+	t.Helper()
+
+	var paths []string
+	if err := tree.Walk(func(ref resources.ItemRef) error {
+		paths = append(paths, ref.FullPath())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	return paths
+}
+
+func TestItemTreeWalk(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	have := walkedPaths(t, c.Items)
+	want := []string{
+		"List all pets",
+		"pets",
+		"pets/{petId}",
+		"pets/{petId}/Info for a specific pet",
+		"pets/Create a pet",
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("Walk order is incorrect, have: %v, want: %v", have, want)
+	}
+}
+
+func TestItemTreeWalkSkipFolder(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	var have []string
+	err := c.Items.Walk(func(ref resources.ItemRef) error {
+		have = append(have, ref.FullPath())
+		if ref.IsFolder() && ref.Name() == "{petId}" {
+			return resources.SkipFolder
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"List all pets", "pets", "pets/{petId}", "pets/Create a pet"}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("Walk order is incorrect, have: %v, want: %v", have, want)
+	}
+}
+
+func TestItemTreeWalkError(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	stop := errors.New("stop")
+	calls := 0
+	err := c.Items.Walk(func(ref resources.ItemRef) error {
+		calls++
+		return stop
+	})
+
+	if err != stop {
+		t.Errorf("Error is incorrect, have: %v, want: %v", err, stop)
+	}
+
+	if calls != 1 {
+		t.Errorf("Walk should stop after an error, calls: %d", calls)
+	}
+}
+
+func TestItemTreeFindByPath(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	ref, ok := c.Items.FindByPath("pets/{petId}/Info for a specific pet")
+	if !ok {
+		t.Fatal("Request should be found.")
+	}
+
+	if ref.Item == nil || ref.Name() != "Info for a specific pet" {
+		t.Errorf("Found the wrong item: %+v", ref)
+	}
+
+	if have, want := strings.Join(ref.Path, "/"), "pets/{petId}"; have != want {
+		t.Errorf("Path is incorrect, have: %s, want: %s", have, want)
+	}
+
+	folder, ok := c.Items.FindByPath("pets")
+	if !ok || !folder.IsFolder() {
+		t.Error("Folder should be found.")
+	}
+
+	for _, missing := range []string{"", "pets/nope", "List all pets/child"} {
+		if _, ok := c.Items.FindByPath(missing); ok {
+			t.Errorf("Path should not be found: %q", missing)
+		}
+	}
+}
+
+func TestItemTreeFindByPathEscaped(t *testing.T) {
+	tree := resources.NewItemTree()
+	tree.Root.AddItem(resources.Item{Item: &gen.Item{Name: "GET /pets"}})
+
+	path := resources.JoinItemPath("GET /pets")
+	if path != `GET \/pets` {
+		t.Errorf("Path is incorrect, have: %s", path)
+	}
+
+	if _, ok := tree.FindByPath(path); !ok {
+		t.Error("Request should be found.")
+	}
+}
+
+func TestItemTreeFindByID(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	ref, ok := c.Items.FindByID("a7e3c1f4-8b2d-4c59-b6e0-2f9d1a3c5e87")
+	if !ok || ref.FullPath() != "pets/Create a pet" {
+		t.Errorf("Request should be found, have: %+v", ref)
+	}
+
+	ref, ok = c.Items.FindByID("5d0b7c7e-3a8f-4f6e-9d2a-8e1c4b7a2f10")
+	if !ok || ref.FullPath() != "pets" {
+		t.Errorf("Folder should be found, have: %+v", ref)
+	}
+
+	if _, ok := c.Items.FindByID("nope"); ok {
+		t.Error("ID should not be found.")
+	}
+}
+
+func TestItemTreeRequests(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	refs := c.Items.Requests()
+	have := make([]string, len(refs))
+	for i, r := range refs {
+		have[i] = r.FullPath()
+	}
+
+	want := []string{"List all pets", "pets/{petId}/Info for a specific pet", "pets/Create a pet"}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("Requests are incorrect, have: %v, want: %v", have, want)
+	}
+}
+
+func TestItemTreeRemove(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	if err := c.Items.Remove("pets/{petId}"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"List all pets", "pets", "pets/Create a pet"}
+	if have := walkedPaths(t, c.Items); !reflect.DeepEqual(have, want) {
+		t.Errorf("Tree is incorrect, have: %v, want: %v", have, want)
+	}
+
+	if err := c.Items.Remove("pets/{petId}"); err == nil {
+		t.Error("Removing a missing item should return an error.")
+	}
+}
+
+func TestItemTreeMove(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	if err := c.Items.Move("List all pets", "pets"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Items.Move("pets/{petId}", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"pets",
+		"pets/Create a pet",
+		"pets/List all pets",
+		"{petId}",
+		"{petId}/Info for a specific pet",
+	}
+	if have := walkedPaths(t, c.Items); !reflect.DeepEqual(have, want) {
+		t.Errorf("Tree is incorrect, have: %v, want: %v", have, want)
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var moved resources.Collection
+	if err := json.Unmarshal(b, &moved); err != nil {
+		t.Fatal(err)
+	}
+
+	if have := walkedPaths(t, moved.Items); !reflect.DeepEqual(have, want) {
+		t.Errorf("Marshalled tree is incorrect, have: %v, want: %v", have, want)
+	}
+}
+
+func TestItemTreeMoveIntoItself(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	if err := c.Items.Move("pets", "pets/{petId}"); err == nil {
+		t.Error("Moving a folder into itself should return an error.")
+	}
+
+	if err := c.Items.Move("pets/Create a pet", "List all pets"); err == nil {
+		t.Error("Moving into a request should return an error.")
+	}
+}
+
+func TestItemTreeRename(t *testing.T) {
+	_, c := readCollection(t, "testdata/itemtree.postman_collection.json")
+
+	if err := c.Items.Rename("pets/{petId}", "by id"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Items.Rename("pets/by id/Info for a specific pet", "Show pet"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := c.Items.FindByPath("pets/by id/Show pet"); !ok {
+		t.Error("Renamed request should be found.")
+	}
+
+	if err := c.Items.Rename("nope", "x"); err == nil {
+		t.Error("Renaming a missing item should return an error.")
+	}
+}
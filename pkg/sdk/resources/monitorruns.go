@@ -0,0 +1,180 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MonitorRunResponse is the top-level response from running a monitor with
+// the Postman API.
+type MonitorRunResponse struct {
+	Run MonitorRun `json:"run"`
+}
+
+// MonitorRun is the result of running a monitor.
+type MonitorRun struct {
+	Info       MonitorRunInfo        `json:"info"`
+	Stats      MonitorRunStats       `json:"stats"`
+	Executions []MonitorRunExecution `json:"executions"`
+	Failures   []MonitorRunFailure   `json:"failures"`
+}
+
+// Format returns column headers and values for the resource.
+func (r MonitorRun) Format() ([]string, []interface{}) {
+	s := make([]interface{}, 1)
+	s[0] = r.Info
+
+	return []string{"MonitorID", "Name", "Status"}, s
+}
+
+// Failed reports whether the run had failed requests or assertions.
+func (r MonitorRun) Failed() bool {
+	return r.Info.Status == "failed" || r.Stats.Requests.Failed > 0 || r.Stats.Assertions.Failed > 0 || len(r.Failures) > 0
+}
+
+// ExecutionFailures returns the failures of an execution.
+func (r MonitorRun) ExecutionFailures(id int) []MonitorRunFailure {
+	var failures []MonitorRunFailure
+	for _, f := range r.Failures {
+		if f.ExecutionID == id {
+			failures = append(failures, f)
+		}
+	}
+
+	return failures
+}
+
+// MonitorRunInfo describes a monitor run.
+type MonitorRunInfo struct {
+	JobID          string    `json:"jobId"`
+	MonitorID      string    `json:"monitorId"`
+	Name           string    `json:"name"`
+	CollectionUID  string    `json:"collectionUid"`
+	EnvironmentUID string    `json:"environmentUid"`
+	Status         string    `json:"status"`
+	StartedAt      time.Time `json:"startedAt"`
+	FinishedAt     time.Time `json:"finishedAt"`
+}
+
+// MonitorRunStats holds the totals of a monitor run.
+type MonitorRunStats struct {
+	Assertions MonitorRunCount `json:"assertions"`
+	Requests   MonitorRunCount `json:"requests"`
+}
+
+// MonitorRunCount is the number of executed and failed assertions or
+// requests.
+type MonitorRunCount struct {
+	Total  int `json:"total"`
+	Failed int `json:"failed"`
+}
+
+// MonitorRunExecution is a single request sent during a monitor run.
+type MonitorRunExecution struct {
+	ID       int                       `json:"id"`
+	Item     MonitorRunItem            `json:"item"`
+	Request  MonitorRunRequest         `json:"request"`
+	Response MonitorRunResponseSummary `json:"response"`
+}
+
+// MonitorRunItem identifies the collection item of an execution.
+type MonitorRunItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// MonitorRunRequest describes the request sent by an execution.
+type MonitorRunRequest struct {
+	Method    string                 `json:"method"`
+	URL       string                 `json:"url"`
+	Headers   map[string]interface{} `json:"headers,omitempty"`
+	Body      MonitorRunBody         `json:"body"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// MonitorRunResponseSummary describes the response received by an
+// execution.
+type MonitorRunResponseSummary struct {
+	Code         int                    `json:"code"`
+	Body         MonitorRunBody         `json:"body"`
+	ResponseTime int                    `json:"responseTime"`
+	ResponseSize int                    `json:"responseSize"`
+	Headers      map[string]interface{} `json:"headers,omitempty"`
+}
+
+// MonitorRunBody describes a request or response body. Monitor runs report
+// only its size.
+type MonitorRunBody struct {
+	ContentLength int    `json:"contentLength"`
+	Mode          string `json:"mode,omitempty"`
+}
+
+// MonitorRunFailure is a failed assertion or request error in a monitor run.
+type MonitorRunFailure struct {
+	ExecutionID int    `json:"executionId"`
+	Name        string `json:"name"`
+	Message     string `json:"message"`
+	Assertion   string `json:"assertion,omitempty"`
+}
+
+// UnmarshalJSON converts JSON to a struct. The assertion may be given as its
+// name or as an object keyed by its name.
+func (f *MonitorRunFailure) UnmarshalJSON(b []byte) error {
+	var v struct {
+		ExecutionID int             `json:"executionId"`
+		Name        string          `json:"name"`
+		Message     string          `json:"message"`
+		Assertion   json.RawMessage `json:"assertion"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*f = MonitorRunFailure{
+		ExecutionID: v.ExecutionID,
+		Name:        v.Name,
+		Message:     v.Message,
+	}
+
+	var name string
+	if err := json.Unmarshal(v.Assertion, &name); err == nil {
+		f.Assertion = name
+		return nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(v.Assertion, &obj); err != nil {
+		return nil
+	}
+
+	for _, k := range []string{"name", "assertion"} {
+		if err := json.Unmarshal(obj[k], &name); err == nil {
+			f.Assertion = name
+			return nil
+		}
+	}
+
+	if len(obj) == 1 {
+		for k := range obj {
+			f.Assertion = k
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// jsonObject is a JSON object that remembers the order of its members.
+type jsonObject struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: make(map[string]json.RawMessage)}
+}
+
+func parseJSONObject(b []byte) (*jsonObject, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return nil, errors.New("expected a JSON object")
+	}
+
+	o := newJSONObject()
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		o.set(t.(string), v)
+	}
+
+	return o, nil
+}
+
+func (o *jsonObject) set(k string, v json.RawMessage) {
+	if _, ok := o.values[k]; !ok {
+		o.keys = append(o.keys, k)
+	}
+	o.values[k] = v
+}
+
+func (o *jsonObject) delete(k string) {
+	if _, ok := o.values[k]; !ok {
+		return
+	}
+
+	delete(o.values, k)
+	for i, key := range o.keys {
+		if key == k {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+func (o *jsonObject) copy() *jsonObject {
+	c := newJSONObject()
+	for _, k := range o.keys {
+		c.set(k, o.values[k])
+	}
+
+	return c
+}
+
+// MarshalJSON writes the members of the object in order.
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBufferString("{")
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteString(":")
+		buf.Write(o.values[k])
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
+
+// marshalMerged encodes current on top of the JSON it was originally decoded
+// from. The generated types in package gen drop members they don't know
+// about and emit every known field, so orig is decoded once more into
+// pristine to find out which values actually changed. Unchanged values keep
+// their original encoding and position, and members in replace are written
+// as given.
+func marshalMerged(orig json.RawMessage, current, pristine interface{}, replace map[string]json.RawMessage) ([]byte, error) {
+	cur, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded json.RawMessage
+	if orig != nil {
+		if err := json.Unmarshal(orig, pristine); err != nil {
+			return nil, err
+		}
+
+		if decoded, err = json.Marshal(pristine); err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeJSONObject(orig, decoded, cur, replace)
+}
+
+func mergeJSON(orig, decoded, current json.RawMessage) (json.RawMessage, error) {
+	if orig != nil && bytes.Equal(decoded, current) {
+		return orig, nil
+	}
+
+	switch firstByte(current) {
+	case '{':
+		return mergeJSONObject(orig, decoded, current, nil)
+	case '[':
+		return mergeJSONArray(orig, decoded, current)
+	}
+
+	return current, nil
+}
+
+func mergeJSONObject(orig, decoded, current json.RawMessage, replace map[string]json.RawMessage) (json.RawMessage, error) {
+	cur, err := parseJSONObject(current)
+	if err != nil {
+		return nil, err
+	}
+
+	o, d, err := parseOriginalObjects(orig, decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newJSONObject()
+	if o != nil {
+		result = o.copy()
+	}
+
+	for _, k := range cur.keys {
+		if v, ok := replace[k]; ok {
+			result.set(k, v)
+			continue
+		}
+
+		cv := cur.values[k]
+
+		var ov, dv json.RawMessage
+		if o != nil {
+			ov, dv = o.values[k], d.values[k]
+		}
+
+		// The generated types emit zero values for fields the original
+		// didn't have. Members that were there keep their new value, unless
+		// it's null.
+		if (ov == nil && isZeroJSON(cv)) || (string(bytes.TrimSpace(cv)) == "null" && !bytes.Equal(dv, cv)) {
+			result.delete(k)
+			continue
+		}
+
+		v, err := mergeJSON(ov, dv, cv)
+		if err != nil {
+			return nil, err
+		}
+
+		result.set(k, v)
+	}
+
+	if d != nil {
+		setDroppedZeroValues(result, d, cur, replace)
+	}
+
+	for k, v := range replace {
+		if _, ok := cur.values[k]; !ok {
+			result.set(k, v)
+		}
+	}
+
+	return result.MarshalJSON()
+}
+
+// parseOriginalObjects parses the original and decoded JSON when both are
+// objects, and returns nil objects otherwise.
+func parseOriginalObjects(orig, decoded json.RawMessage) (*jsonObject, *jsonObject, error) {
+	if firstByte(orig) != '{' || firstByte(decoded) != '{' {
+		return nil, nil, nil
+	}
+
+	o, err := parseJSONObject(orig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d, err := parseJSONObject(decoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return o, d, nil
+}
+
+// setDroppedZeroValues writes the members of decoded that current leaves
+// out. They were set to their zero value, which omitempty drops.
+func setDroppedZeroValues(result, decoded, current *jsonObject, replace map[string]json.RawMessage) {
+	for _, k := range decoded.keys {
+		if _, ok := current.values[k]; ok {
+			continue
+		}
+
+		if _, ok := replace[k]; ok {
+			continue
+		}
+
+		switch firstByte(decoded.values[k]) {
+		case 't', 'f':
+			result.set(k, json.RawMessage("false"))
+		case '"':
+			result.set(k, json.RawMessage(`""`))
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			result.set(k, json.RawMessage("0"))
+		default:
+			result.delete(k)
+		}
+	}
+}
+
+func mergeJSONArray(orig, decoded, current json.RawMessage) (json.RawMessage, error) {
+	var cur, o, d []json.RawMessage
+	if err := json.Unmarshal(current, &cur); err != nil {
+		return nil, err
+	}
+
+	if firstByte(orig) == '[' && firstByte(decoded) == '[' {
+		if err := json.Unmarshal(orig, &o); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(decoded, &d); err != nil {
+			return nil, err
+		}
+
+		if len(o) != len(d) {
+			o, d = nil, nil
+		}
+	}
+
+	used := make([]bool, len(d))
+	merged := make([]json.RawMessage, len(cur))
+	for i, cv := range cur {
+		var ov, dv json.RawMessage
+
+		// Prefer an untouched element from anywhere in the original array,
+		// then the element at the same position when nothing was added or
+		// removed, and finally an element that looks like the same entity.
+		match := -1
+		for j := range d {
+			if !used[j] && bytes.Equal(d[j], cv) {
+				match = j
+				break
+			}
+		}
+
+		if match < 0 && len(d) == len(cur) && !used[i] {
+			match = i
+		}
+
+		if match < 0 {
+			for j := range d {
+				if !used[j] && sameIdentity(d[j], cv) {
+					match = j
+					break
+				}
+			}
+		}
+
+		if match >= 0 {
+			ov, dv, used[match] = o[match], d[match], true
+		}
+
+		v, err := mergeJSON(ov, dv, cv)
+		if err != nil {
+			return nil, err
+		}
+
+		merged[i] = v
+	}
+
+	return json.Marshal(merged)
+}
+
+// sameIdentity reports whether two objects share a value for the members
+// collections use to identify variables, events, headers and the like.
+func sameIdentity(a, b json.RawMessage) bool {
+	if firstByte(a) != '{' || firstByte(b) != '{' {
+		return false
+	}
+
+	var ma, mb map[string]json.RawMessage
+	if json.Unmarshal(a, &ma) != nil || json.Unmarshal(b, &mb) != nil {
+		return false
+	}
+
+	same := false
+	for _, k := range []string{"id", "key", "name", "listen"} {
+		va, okA := ma[k]
+		vb, okB := mb[k]
+		if !okA || !okB || isZeroJSON(va) {
+			continue
+		}
+
+		if !bytes.Equal(va, vb) {
+			return false
+		}
+		same = true
+	}
+
+	return same
+}
+
+// isZeroJSON reports whether v is null or the zero value of its type.
+func isZeroJSON(v json.RawMessage) bool {
+	switch string(bytes.TrimSpace(v)) {
+	case "null", `""`, "false", "0", "{}":
+		return true
+	}
+
+	return false
+}
+
+func firstByte(v json.RawMessage) byte {
+	v = bytes.TrimSpace(v)
+	if len(v) == 0 {
+		return 0
+	}
+
+	return v[0]
+}
@@ -0,0 +1,500 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Request is the structured form of a request in a Collection. The
+// generated gen.Item keeps requests as an interface{} since the schema
+// allows either a URL string or an object.
+type Request struct {
+	Method      string       `json:"method,omitempty"`
+	URL         URL          `json:"url"`
+	Header      HeaderList   `json:"header,omitempty"`
+	Body        *RequestBody `json:"body,omitempty"`
+	Auth        *gen.Auth    `json:"auth,omitempty"`
+	Description interface{}  `json:"description,omitempty"`
+}
+
+// ParseRequest converts the request of an Item, or the original request of a
+// saved response, to a Request.
+func ParseRequest(v interface{}) (*Request, error) {
+	var r Request
+	if s, ok := v.(string); ok {
+		r.URL.Raw = s
+	} else if v != nil {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Method == "" {
+		r.Method = "GET"
+	}
+	r.Method = strings.ToUpper(r.Method)
+
+	return &r, nil
+}
+
+// ParseRequest converts the request of the item to a Request.
+func (item Item) ParseRequest() (*Request, error) {
+	if item.Item == nil {
+		return nil, fmt.Errorf("item has no request")
+	}
+
+	return ParseRequest(item.Item.Request)
+}
+
+// DescriptionText returns the text of a description, which collections
+// store as a string or as an object with the text as its content.
+func DescriptionText(v interface{}) string {
+	switch d := v.(type) {
+	case string:
+		return strings.TrimSpace(d)
+	case map[string]interface{}:
+		if s, ok := d["content"].(string); ok {
+			return strings.TrimSpace(s)
+		}
+	}
+
+	return ""
+}
+
+// ParseAuth converts the auth member of a collection or folder to a gen.Auth.
+// It returns nil when no auth is set.
+func ParseAuth(v interface{}) (*gen.Auth, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var auth gen.Auth
+	if err := json.Unmarshal(b, &auth); err != nil {
+		return nil, err
+	}
+
+	return &auth, nil
+}
+
+// AuthValues returns the attributes for the auth type in use, keyed by
+// attribute name.
+func AuthValues(auth *gen.Auth) map[string]string {
+	values := make(map[string]string)
+	if auth == nil {
+		return values
+	}
+
+	var attrs []*gen.AuthAttribute
+	switch auth.Type {
+	case "apikey":
+		attrs = auth.Apikey
+	case "awsv4":
+		attrs = auth.Awsv4
+	case "basic":
+		attrs = auth.Basic
+	case "bearer":
+		attrs = auth.Bearer
+	case "digest":
+		attrs = auth.Digest
+	case "hawk":
+		attrs = auth.Hawk
+	case "ntlm":
+		attrs = auth.Ntlm
+	case "oauth1":
+		attrs = auth.Oauth1
+	case "oauth2":
+		attrs = auth.Oauth2
+	}
+
+	for _, a := range attrs {
+		if a == nil {
+			continue
+		}
+
+		switch v := a.Value.(type) {
+		case nil:
+			values[a.Key] = ""
+		case string:
+			values[a.Key] = v
+		default:
+			b, _ := json.Marshal(v) // decoded from JSON, always marshals
+			values[a.Key] = string(b)
+		}
+	}
+
+	return values
+}
+
+// URL is the structured form of a request URL.
+type URL struct {
+	Raw      string        `json:"raw,omitempty"`
+	Protocol string        `json:"protocol,omitempty"`
+	Host     []string      `json:"host,omitempty"`
+	Port     string        `json:"port,omitempty"`
+	Path     []string      `json:"path,omitempty"`
+	Query    []QueryParam  `json:"query,omitempty"`
+	Hash     string        `json:"hash,omitempty"`
+	Variable []URLVariable `json:"variable,omitempty"`
+}
+
+// QueryParam is a single query string parameter.
+type QueryParam struct {
+	Key         string      `json:"key"`
+	Value       string      `json:"value"`
+	Disabled    bool        `json:"disabled,omitempty"`
+	Description interface{} `json:"description,omitempty"`
+}
+
+// URLVariable is the value of a path variable, such as :id.
+type URLVariable struct {
+	Key         string      `json:"key"`
+	Value       string      `json:"value"`
+	Description interface{} `json:"description,omitempty"`
+}
+
+// UnmarshalJSON converts JSON to a struct.
+func (u *URL) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*u = URL{Raw: s}
+		return nil
+	}
+
+	var v struct {
+		Raw      string          `json:"raw"`
+		Protocol string          `json:"protocol"`
+		Host     json.RawMessage `json:"host"`
+		Port     json.RawMessage `json:"port"`
+		Path     json.RawMessage `json:"path"`
+		Query    []struct {
+			Key         *string     `json:"key"`
+			Value       *string     `json:"value"`
+			Disabled    bool        `json:"disabled"`
+			Description interface{} `json:"description"`
+		} `json:"query"`
+		Hash     string `json:"hash"`
+		Variable []struct {
+			Key         string      `json:"key"`
+			ID          string      `json:"id"`
+			Value       interface{} `json:"value"`
+			Description interface{} `json:"description"`
+		} `json:"variable"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*u = URL{
+		Raw:      v.Raw,
+		Protocol: v.Protocol,
+		Port:     scalarString(v.Port),
+		Hash:     v.Hash,
+	}
+
+	host, err := stringOrList(v.Host, ".")
+	if err != nil {
+		return err
+	}
+	u.Host = host
+
+	path, err := stringOrList(v.Path, "/")
+	if err != nil {
+		return err
+	}
+	u.Path = path
+
+	for _, q := range v.Query {
+		p := QueryParam{Disabled: q.Disabled, Description: q.Description}
+		if q.Key != nil {
+			p.Key = *q.Key
+		}
+		if q.Value != nil {
+			p.Value = *q.Value
+		}
+		u.Query = append(u.Query, p)
+	}
+
+	for _, uv := range v.Variable {
+		key := uv.Key
+		if key == "" {
+			key = uv.ID
+		}
+		u.Variable = append(u.Variable, URLVariable{
+			Key:         key,
+			Value:       scalarString(mustMarshal(uv.Value)),
+			Description: uv.Description,
+		})
+	}
+
+	return nil
+}
+
+// String returns the URL as text. The raw form is preferred, since Postman
+// keeps it in sync with the structured parts.
+func (u URL) String() string {
+	if u.Raw != "" {
+		return u.Raw
+	}
+
+	var sb strings.Builder
+	if u.Protocol != "" {
+		sb.WriteString(u.Protocol)
+		sb.WriteString("://")
+	}
+
+	sb.WriteString(strings.Join(u.Host, "."))
+
+	if u.Port != "" {
+		sb.WriteString(":")
+		sb.WriteString(u.Port)
+	}
+
+	if len(u.Path) > 0 {
+		sb.WriteString("/")
+		sb.WriteString(strings.Join(u.Path, "/"))
+	}
+
+	var query []string
+	for _, q := range u.Query {
+		if q.Disabled {
+			continue
+		}
+
+		if q.Value == "" {
+			query = append(query, q.Key)
+		} else {
+			query = append(query, q.Key+"="+q.Value)
+		}
+	}
+
+	if len(query) > 0 {
+		sb.WriteString("?")
+		sb.WriteString(strings.Join(query, "&"))
+	}
+
+	if u.Hash != "" {
+		sb.WriteString("#")
+		sb.WriteString(u.Hash)
+	}
+
+	return sb.String()
+}
+
+// Header is a single HTTP header of a request or a saved response.
+type Header struct {
+	Key         string      `json:"key"`
+	Value       string      `json:"value"`
+	Disabled    bool        `json:"disabled,omitempty"`
+	Description interface{} `json:"description,omitempty"`
+}
+
+// HeaderList is a list of headers. Collections may also store headers as a
+// single string of "Key: Value" lines.
+type HeaderList []Header
+
+// UnmarshalJSON converts JSON to a struct.
+func (h *HeaderList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*h = nil
+		for _, line := range strings.Split(s, "\n") {
+			parts := strings.SplitN(line, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+
+			*h = append(*h, Header{
+				Key:   strings.TrimSpace(parts[0]),
+				Value: strings.TrimSpace(parts[1]),
+			})
+		}
+
+		return nil
+	}
+
+	var headers []struct {
+		Key         string      `json:"key"`
+		Value       interface{} `json:"value"`
+		Disabled    bool        `json:"disabled"`
+		Description interface{} `json:"description"`
+	}
+	if err := json.Unmarshal(b, &headers); err != nil {
+		return err
+	}
+
+	*h = make(HeaderList, len(headers))
+	for i, v := range headers {
+		(*h)[i] = Header{
+			Key:         v.Key,
+			Value:       scalarString(mustMarshal(v.Value)),
+			Disabled:    v.Disabled,
+			Description: v.Description,
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value of the first enabled header with the given key,
+// ignoring case.
+func (h HeaderList) Get(key string) (string, bool) {
+	for _, v := range h {
+		if !v.Disabled && strings.EqualFold(v.Key, key) {
+			return v.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// RequestBody is the body of a request.
+type RequestBody struct {
+	Mode       string          `json:"mode,omitempty"`
+	Raw        string          `json:"raw,omitempty"`
+	URLEncoded []FormParameter `json:"urlencoded,omitempty"`
+	FormData   []FormParameter `json:"formdata,omitempty"`
+	File       *BodyFile       `json:"file,omitempty"`
+	GraphQL    *GraphQLBody    `json:"graphql,omitempty"`
+	Options    *BodyOptions    `json:"options,omitempty"`
+	Disabled   bool            `json:"disabled,omitempty"`
+}
+
+// FormParameter is a single urlencoded or multipart form field.
+type FormParameter struct {
+	Key         string      `json:"key"`
+	Value       string      `json:"value,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Src         interface{} `json:"src,omitempty"`
+	ContentType string      `json:"contentType,omitempty"`
+	Disabled    bool        `json:"disabled,omitempty"`
+	Description interface{} `json:"description,omitempty"`
+}
+
+// Files returns the file paths of a multipart file field.
+func (p FormParameter) Files() []string {
+	switch v := p.Src.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var files []string
+		for _, f := range v {
+			if s, ok := f.(string); ok {
+				files = append(files, s)
+			}
+		}
+		return files
+	}
+
+	return nil
+}
+
+// BodyFile is a request body read from a file.
+type BodyFile struct {
+	Src     string `json:"src,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// GraphQLBody is a GraphQL query and its variables.
+type GraphQLBody struct {
+	Query     string `json:"query"`
+	Variables string `json:"variables,omitempty"`
+}
+
+// BodyOptions holds editor settings for a body, such as the language of a raw
+// body.
+type BodyOptions struct {
+	Raw *struct {
+		Language string `json:"language,omitempty"`
+	} `json:"raw,omitempty"`
+}
+
+// Language returns the language of a raw body, such as json or xml.
+func (b RequestBody) Language() string {
+	if b.Options == nil || b.Options.Raw == nil {
+		return ""
+	}
+
+	return b.Options.Raw.Language
+}
+
+func stringOrList(b json.RawMessage, sep string) ([]string, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s == "" {
+			return nil, nil
+		}
+		return strings.Split(strings.TrimPrefix(s, sep), sep), nil
+	}
+
+	var list []interface{}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, err
+	}
+
+	parts := make([]string, 0, len(list))
+	for _, v := range list {
+		switch p := v.(type) {
+		case string:
+			parts = append(parts, p)
+		case map[string]interface{}:
+			// Path segments can be objects such as {"type": "string", "value": "pets"}.
+			if value, ok := p["value"].(string); ok {
+				parts = append(parts, value)
+			}
+		}
+	}
+
+	return parts, nil
+}
+
+func scalarString(b json.RawMessage) string {
+	if len(b) == 0 || string(b) == "null" {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return s
+	}
+
+	return string(b)
+}
+
+func mustMarshal(v interface{}) json.RawMessage {
+	b, _ := json.Marshal(v) // only called with values decoded from JSON
+	return b
+}
@@ -0,0 +1,44 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+// Result is what the Postman API responds with after a resource is created,
+// replaced, deleted, forked or merged.
+type Result struct {
+	ID   string `json:"id"`
+	UID  string `json:"uid,omitempty"`
+	Name string `json:"name,omitempty"`
+	Fork *Fork  `json:"fork,omitempty"`
+}
+
+// PreferredID returns the UID of the resource, or its ID when the response
+// has no UID.
+func (r Result) PreferredID() string {
+	if r.UID != "" {
+		return r.UID
+	}
+
+	return r.ID
+}
+
+// Format returns column headers and values for the resource.
+func (r Result) Format() ([]string, []interface{}) {
+	s := make([]interface{}, 1)
+	s[0] = r
+
+	return []string{"ID", "UID", "Name"}, s
+}
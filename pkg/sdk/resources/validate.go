@@ -0,0 +1,66 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/jsonschema"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+var (
+	collectionSchema     *jsonschema.Schema
+	collectionSchemaErr  error
+	collectionSchemaOnce sync.Once
+)
+
+// ValidationError lists the ways a resource fails its schema.
+type ValidationError struct {
+	Type   ResourceType
+	Errors []jsonschema.Error
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid %s:", strings.ToLower(e.Type.String()))
+	for _, err := range e.Errors {
+		fmt.Fprintf(&b, "\n  %s", err.Error())
+	}
+
+	return b.String()
+}
+
+// ValidateCollection checks a collection, as decoded by encoding/json,
+// against the collection schema (v2.1.0). It returns a *ValidationError
+// when the collection is invalid.
+func ValidateCollection(v interface{}) error {
+	collectionSchemaOnce.Do(func() {
+		collectionSchema, collectionSchemaErr = jsonschema.Compile([]byte(gen.CollectionSchema))
+	})
+	if collectionSchemaErr != nil {
+		return collectionSchemaErr
+	}
+
+	if errs := collectionSchema.Validate(v); len(errs) > 0 {
+		return &ValidationError{Type: CollectionType, Errors: errs}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/jsonschema"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+func TestValidateCollection(t *testing.T) {
+	for _, path := range []string{"testdata/echo.postman_collection.json", "testdata/petstore.postman_collection.json"} {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := resources.ValidateCollection(v); err != nil {
+			t.Errorf("%s: %s", path, err)
+		}
+	}
+}
+
+func TestValidateCollectionErrors(t *testing.T) {
+	input := `{
+		"info": {"name": "Pets", "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+		"item": [
+			{"name": "One", "request": "https://example.com/1"},
+			{"name": "Two", "request": {"method": "GET", "url": "https://example.com/2"}},
+			{"name": "Three", "request": {"method": "GET", "url": {"raw": "https://example.com/3"}}},
+			{"name": "Pets", "item": [{"name": "List", "request": {"method": "GET", "url": 3}}]},
+			{"name": "Auth", "request": {"url": "https://example.com", "auth": {"type": "magic"}}}
+		]
+	}`
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	err := resources.ValidateCollection(v)
+	verr, ok := err.(*resources.ValidationError)
+	if !ok {
+		t.Fatalf("have error %v, want a *ValidationError", err)
+	}
+
+	want := []jsonschema.Error{
+		{Path: "item[3].item[0].request.url", Message: "expected object or string, found number"},
+		{Path: "item[4].request.auth.type", Message: `expected one of "apikey", "awsv4", "basic", "bearer", "digest", "hawk", "noauth", "oauth1", "oauth2", "ntlm"`},
+	}
+	if !reflect.DeepEqual(verr.Errors, want) {
+		t.Errorf("have errors\n%v\nwant\n%v", verr.Errors, want)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReadIterationDataFile reads iteration data from a CSV or JSON file, chosen
+// by the file extension.
+func ReadIterationDataFile(path string) ([]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return ReadIterationCSV(f)
+	}
+
+	return ReadIterationJSON(f)
+}
+
+// ReadIterationJSON reads iteration data from a JSON array of objects.
+func ReadIterationJSON(r io.Reader) ([]map[string]string, error) {
+	var rows []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, errors.New("iteration data must be a JSON array of objects")
+	}
+
+	data := make([]map[string]string, len(rows))
+	for i, row := range rows {
+		data[i] = make(map[string]string, len(row))
+		for k, v := range row {
+			data[i][k] = valueString(v)
+		}
+	}
+
+	return data, nil
+}
+
+// ReadIterationCSV reads iteration data from CSV. The first record holds the
+// variable names.
+func ReadIterationCSV(r io.Reader) ([]map[string]string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(b, []byte("\xef\xbb\xbf")))).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	data := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, k := range header {
+			if i < len(record) {
+				row[k] = record[i]
+			}
+		}
+		data = append(data, row)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,410 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// NewHTTPRequest builds an HTTP request from a collection request, resolving
+// variables and applying auth. Pass the auth inherited from enclosing folders
+// or the collection; the request's own auth takes precedence.
+func NewHTTPRequest(ctx context.Context, r *resources.Request, inherited *gen.Auth, vars *Variables) (*http.Request, error) {
+	if vars == nil {
+		vars = NewVariables()
+	}
+
+	u, err := requestURL(r.URL, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentType, err := requestBody(r.Body, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, vars.Replace(r.Method), u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range r.Header {
+		if h.Disabled || h.Key == "" {
+			continue
+		}
+
+		key, value := vars.Replace(h.Key), vars.Replace(h.Value)
+		if strings.EqualFold(key, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Add(key, value)
+	}
+
+	if contentType != "" && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	auth := r.Auth
+	if auth == nil || auth.Type == "inherit" {
+		auth = inherited
+	}
+
+	if err := applyAuth(req, auth, vars); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ResolvedRequest is a request with its variables resolved and its auth
+// applied, for writing it out rather than sending it.
+type ResolvedRequest struct {
+	Method string
+
+	// URL escapes the braces of the variables left in its path; pass its
+	// string to UnescapeVariables to write them out.
+	URL *url.URL
+
+	// Header holds the headers sorted by name, after a Host header when
+	// the host differs from the one in the URL.
+	Header []resources.Header
+	Body   string
+
+	// Unresolved holds the variables left in the URL, headers and the body
+	// because they have no value, sorted by name.
+	Unresolved []string
+}
+
+var escapedVariablePattern = regexp.MustCompile(`%7B%7B(.+?)%7D%7D`)
+
+// UnescapeVariables restores the variables of a URL escaped by url.URL,
+// which escapes the braces of those left in its path.
+func UnescapeVariables(s string) string {
+	return escapedVariablePattern.ReplaceAllStringFunc(s, func(m string) string {
+		name, err := url.PathUnescape(m[6 : len(m)-6])
+		if err != nil {
+			return m
+		}
+
+		return "{{" + name + "}}"
+	})
+}
+
+// ResolveRequest builds a collection request the way NewHTTPRequest does and
+// reads it back. Variables without a value are left in the URL, the headers
+// and the body, and reported in Unresolved. It fails when they leave the URL
+// without a host or the body isn't text.
+func ResolveRequest(r *resources.Request, inherited *gen.Auth, vars *Variables) (*ResolvedRequest, error) {
+	if vars == nil {
+		vars = NewVariables()
+	}
+
+	// Path variables hold their values apart from the raw URL.
+	rawURL := r.URL.String()
+	for _, v := range r.URL.Variable {
+		rawURL += " " + v.Value
+	}
+	urlVars := vars.Unresolved(rawURL)
+	if _, err := requestURL(r.URL, vars); err != nil && len(urlVars) > 0 {
+		return nil, fmt.Errorf("the URL uses variables without a value: %s", strings.Join(urlVars, ", "))
+	}
+
+	req, err := NewHTTPRequest(context.Background(), r, inherited, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ResolvedRequest{Method: req.Method, URL: req.URL}
+	unresolved := make(map[string]bool)
+	for _, name := range urlVars {
+		unresolved[name] = true
+	}
+
+	if req.Host != "" && req.Host != req.URL.Host {
+		out.Header = append(out.Header, resources.Header{Key: "Host", Value: req.Host})
+	}
+
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range req.Header[k] {
+			out.Header = append(out.Header, resources.Header{Key: k, Value: v})
+			for _, name := range vars.Unresolved(v) {
+				unresolved[name] = true
+			}
+		}
+	}
+
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		if !utf8.Valid(b) {
+			return nil, fmt.Errorf("binary bodies are not supported")
+		}
+
+		out.Body = string(b)
+		for _, name := range vars.Unresolved(out.Body) {
+			unresolved[name] = true
+		}
+	}
+
+	for name := range unresolved {
+		out.Unresolved = append(out.Unresolved, name)
+	}
+	sort.Strings(out.Unresolved)
+
+	return out, nil
+}
+
+func requestURL(u resources.URL, vars *Variables) (*url.URL, error) {
+	raw := vars.Replace(u.String())
+	if raw == "" {
+		return nil, fmt.Errorf("request has no URL")
+	}
+
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(u.Variable) > 0 {
+		segments := strings.Split(parsed.Path, "/")
+		for i, s := range segments {
+			if !strings.HasPrefix(s, ":") {
+				continue
+			}
+
+			for _, v := range u.Variable {
+				if s[1:] == v.Key {
+					segments[i] = vars.Replace(v.Value)
+					break
+				}
+			}
+		}
+		parsed.Path = strings.Join(segments, "/")
+		parsed.RawPath = ""
+	}
+
+	parsed.RawQuery = strings.ReplaceAll(parsed.RawQuery, " ", "%20")
+
+	return parsed, nil
+}
+
+var languageContentTypes = map[string]string{
+	"json":       "application/json",
+	"xml":        "application/xml",
+	"html":       "text/html",
+	"javascript": "application/javascript",
+	"text":       "text/plain",
+}
+
+func requestBody(b *resources.RequestBody, vars *Variables) (io.Reader, string, error) {
+	if b == nil || b.Disabled {
+		return nil, "", nil
+	}
+
+	switch b.Mode {
+	case "raw":
+		if b.Raw == "" {
+			return nil, "", nil
+		}
+		return strings.NewReader(vars.Replace(b.Raw)), languageContentTypes[b.Language()], nil
+	case "urlencoded":
+		var fields []string
+		for _, p := range b.URLEncoded {
+			if p.Disabled {
+				continue
+			}
+			fields = append(fields, url.QueryEscape(vars.Replace(p.Key))+"="+url.QueryEscape(vars.Replace(p.Value)))
+		}
+		return strings.NewReader(strings.Join(fields, "&")), "application/x-www-form-urlencoded", nil
+	case "formdata":
+		return multipartBody(b.FormData, vars)
+	case "file":
+		if b.File == nil {
+			return nil, "", nil
+		}
+
+		if b.File.Src == "" {
+			return strings.NewReader(b.File.Content), "", nil
+		}
+
+		data, err := ioutil.ReadFile(b.File.Src)
+		if err != nil {
+			return nil, "", err
+		}
+		return bytes.NewReader(data), "", nil
+	case "graphql":
+		if b.GraphQL == nil {
+			return nil, "", nil
+		}
+
+		payload := map[string]interface{}{"query": vars.Replace(b.GraphQL.Query)}
+		if v := strings.TrimSpace(vars.Replace(b.GraphQL.Variables)); v != "" {
+			payload["variables"] = json.RawMessage(v)
+		}
+
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid GraphQL variables: %s", err)
+		}
+		return bytes.NewReader(data), "application/json", nil
+	case "":
+		return nil, "", nil
+	}
+
+	return nil, "", fmt.Errorf("unsupported body mode: %s", b.Mode)
+}
+
+func multipartBody(params []resources.FormParameter, vars *Variables) (io.Reader, string, error) {
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+
+	for _, p := range params {
+		if p.Disabled {
+			continue
+		}
+
+		key := vars.Replace(p.Key)
+		if p.Type != "file" {
+			h := make(textproto.MIMEHeader)
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, escapeQuotes(key)))
+			if p.ContentType != "" {
+				h.Set("Content-Type", p.ContentType)
+			}
+
+			part, err := w.CreatePart(h)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if _, err := io.WriteString(part, vars.Replace(p.Value)); err != nil {
+				return nil, "", err
+			}
+			continue
+		}
+
+		for _, src := range p.Files() {
+			data, err := ioutil.ReadFile(src)
+			if err != nil {
+				return nil, "", err
+			}
+
+			part, err := w.CreateFormFile(key, filepath.Base(src))
+			if err != nil {
+				return nil, "", err
+			}
+
+			if _, err := part.Write(data); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf, w.FormDataContentType(), nil
+}
+
+func escapeQuotes(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+func applyAuth(req *http.Request, auth *gen.Auth, vars *Variables) error {
+	if auth == nil {
+		return nil
+	}
+
+	values := resources.AuthValues(auth)
+	for k, v := range values {
+		values[k] = vars.Replace(v)
+	}
+
+	switch auth.Type {
+	case "", "noauth", "inherit":
+	case "basic":
+		req.SetBasicAuth(values["username"], values["password"])
+	case "bearer":
+		req.Header.Set("Authorization", "Bearer "+values["token"])
+	case "apikey":
+		key, value := values["key"], values["value"]
+		if values["in"] == "query" {
+			addQuery(req.URL, key, value)
+		} else {
+			req.Header.Set(key, value)
+		}
+	case "oauth2":
+		token := values["accessToken"]
+		if values["addTokenTo"] == "queryParams" {
+			addQuery(req.URL, "access_token", token)
+		} else {
+			prefix, ok := values["headerPrefix"]
+			if !ok {
+				prefix = "Bearer"
+			}
+			req.Header.Set("Authorization", strings.TrimSpace(prefix+" "+token))
+		}
+	default:
+		return fmt.Errorf("auth type %s is not supported by the local runner", auth.Type)
+	}
+
+	return nil
+}
+
+// addQuery appends a parameter to the query of a URL, leaving the existing
+// parameters as they were written.
+func addQuery(u *url.URL, key, value string) {
+	pair := url.QueryEscape(key) + "=" + url.QueryEscape(value)
+	if u.RawQuery == "" {
+		u.RawQuery = pair
+		return
+	}
+
+	u.RawQuery += "&" + pair
+}
@@ -0,0 +1,464 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runner executes the requests of a Postman collection locally.
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Options configures a collection run.
+type Options struct {
+	// Client sends the requests. http.DefaultClient is used when nil.
+	Client *http.Client
+
+	// Environment provides the environment variables for the run.
+	Environment *resources.Environment
+
+	// Folders limits the run to the requests within these folders, given as
+	// paths or folder names.
+	Folders []string
+
+	// IterationData holds one row of data variables per iteration.
+	IterationData []map[string]string
+
+	// IterationCount is the number of iterations. It defaults to the number
+	// of rows in IterationData, or 1.
+	IterationCount int
+
+	// Bail stops the run after the first failed request.
+	Bail bool
+
+	// ScriptTimeout limits how long each script may run. A script that runs
+	// longer is interrupted and reported as a script error. Zero means no
+	// limit.
+	ScriptTimeout time.Duration
+
+	// OnExecution is called after each request completes.
+	OnExecution func(*Execution)
+}
+
+// Runner executes the requests of a collection in collection order.
+type Runner struct {
+	collection *resources.Collection
+	options    Options
+
+	// Variables holds the variable scopes for the run. Environment and
+	// collection variable changes carry over between iterations.
+	Variables *Variables
+}
+
+// New creates a runner for a collection.
+func New(c *resources.Collection, options Options) *Runner {
+	vars := NewVariables()
+	vars.Environment = NewEnvironmentScope(options.Environment)
+	if c.Collection != nil {
+		vars.Collection = NewCollectionScope(c.Variable)
+	}
+
+	if options.Client == nil {
+		options.Client = http.DefaultClient
+	}
+
+	return &Runner{
+		collection: c,
+		options:    options,
+		Variables:  vars,
+	}
+}
+
+// Execution is the result of sending a single request and running its
+// scripts.
+type Execution struct {
+	Iteration    int
+	Item         resources.ItemRef
+	Request      *http.Request
+	Response     *Response
+	Duration     time.Duration
+	Error        error
+	Tests        []TestResult
+	ScriptErrors []error
+	Console      []ConsoleMessage
+}
+
+// Name returns the path of the executed request within the collection.
+func (e *Execution) Name() string {
+	return e.Item.FullPath()
+}
+
+// Failed reports whether the request could not be completed, a script threw
+// an error or a test failed.
+func (e *Execution) Failed() bool {
+	if e.Error != nil || len(e.ScriptErrors) > 0 {
+		return true
+	}
+
+	for _, t := range e.Tests {
+		if t.Error != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Response is a received HTTP response.
+type Response struct {
+	Code   int
+	Status string
+	Header http.Header
+	Body   []byte
+}
+
+// Summary is the result of a collection run.
+type Summary struct {
+	Collection string
+	Iterations int
+	Executions []*Execution
+	Started    time.Time
+	Duration   time.Duration
+}
+
+// Assertions returns the number of tests that ran and how many of them
+// failed. Skipped tests are not counted.
+func (s *Summary) Assertions() (total, failed int) {
+	for _, e := range s.Executions {
+		for _, t := range e.Tests {
+			if t.Skipped {
+				continue
+			}
+
+			total++
+			if !t.Passed() {
+				failed++
+			}
+		}
+	}
+
+	return total, failed
+}
+
+// Failures returns the number of failed executions.
+func (s *Summary) Failures() int {
+	n := 0
+	for _, e := range s.Executions {
+		if e.Failed() {
+			n++
+		}
+	}
+
+	return n
+}
+
+// runItem is a request to execute along with the auth it inherits and the
+// scripts that apply to it, outermost first.
+type runItem struct {
+	ref    resources.ItemRef
+	auth   *gen.Auth
+	events []*gen.Event
+}
+
+// Step is a request the runner sends, along with the auth it inherits from
+// its folders and the collection.
+type Step struct {
+	Item resources.ItemRef
+	Auth *gen.Auth
+}
+
+// Plan lists the requests the runner sends, in order, without sending them.
+func (r *Runner) Plan() ([]Step, error) {
+	items, err := r.plan()
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]Step, len(items))
+	for i, it := range items {
+		steps[i] = Step{Item: it.ref, Auth: it.auth}
+	}
+
+	return steps, nil
+}
+
+// Iterations returns the number of iterations the runner runs.
+func (r *Runner) Iterations() int {
+	if r.options.IterationCount > 0 {
+		return r.options.IterationCount
+	}
+
+	if n := len(r.options.IterationData); n > 0 {
+		return n
+	}
+
+	return 1
+}
+
+// Run executes the collection. An error is returned when the run can't be
+// started or is cancelled; failed requests are recorded in the summary.
+func (r *Runner) Run(ctx context.Context) (*Summary, error) {
+	items, err := r.plan()
+	if err != nil {
+		return nil, err
+	}
+
+	iterations := r.Iterations()
+	summary := &Summary{
+		Iterations: iterations,
+		Started:    time.Now(),
+	}
+	if r.collection.Collection != nil && r.collection.Info != nil {
+		summary.Collection = r.collection.Info.Name
+	}
+
+	defer func() {
+		summary.Duration = time.Since(summary.Started)
+	}()
+
+	for i := 0; i < iterations; i++ {
+		r.Variables.Data = NewScope()
+		if n := len(r.options.IterationData); n > 0 {
+			// Newman reuses the last row when there are more iterations than rows.
+			row := i
+			if row >= n {
+				row = n - 1
+			}
+			r.Variables.Data = NewDataScope(r.options.IterationData[row])
+		}
+
+		for _, it := range items {
+			if err := ctx.Err(); err != nil {
+				return summary, err
+			}
+
+			e := r.execute(ctx, i, iterations, it)
+			summary.Executions = append(summary.Executions, e)
+
+			if r.options.OnExecution != nil {
+				r.options.OnExecution(e)
+			}
+
+			if r.options.Bail && e.Failed() {
+				return summary, nil
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+// plan lists the requests to run in collection order, resolving the auth
+// each one inherits from its folders and the collection.
+func (r *Runner) plan() ([]runItem, error) {
+	if r.collection.Items == nil {
+		return nil, nil
+	}
+
+	var selected []*resources.ItemTreeNode
+	for _, f := range r.options.Folders {
+		node, err := r.findFolder(f)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, node)
+	}
+
+	var (
+		rootAuth   *gen.Auth
+		rootEvents []*gen.Event
+	)
+	if r.collection.Collection != nil {
+		a, err := resources.ParseAuth(r.collection.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("invalid collection auth: %s", err)
+		}
+		rootAuth = a
+		rootEvents = r.collection.Event
+	}
+
+	// The walk is depth-first, so the enclosing folders of a request are the
+	// ones most recently visited at each shallower depth.
+	var (
+		folders []*resources.ItemTreeNode
+		auths   []*gen.Auth
+		events  [][]*gen.Event
+		items   []runItem
+	)
+
+	err := r.collection.Items.Walk(func(ref resources.ItemRef) error {
+		depth := len(ref.Path)
+		folders, auths, events = folders[:depth], auths[:depth], events[:depth]
+
+		inherited, inheritedEvents := rootAuth, rootEvents
+		if depth > 0 {
+			inherited, inheritedEvents = auths[depth-1], events[depth-1]
+		}
+
+		if ref.IsFolder() {
+			auth, scripts := inherited, inheritedEvents
+			if ref.Node.ItemGroup != nil && ref.Node.ItemGroup.ItemGroup != nil {
+				a, err := resources.ParseAuth(ref.Node.ItemGroup.Auth)
+				if err != nil {
+					return fmt.Errorf("invalid auth on %s: %s", ref.FullPath(), err)
+				}
+
+				if a != nil && a.Type != "inherit" {
+					auth = a
+				}
+
+				scripts = appendEvents(scripts, ref.Node.ItemGroup.Events)
+			}
+
+			folders = append(folders, ref.Node)
+			auths = append(auths, auth)
+			events = append(events, scripts)
+			return nil
+		}
+
+		if len(selected) > 0 && !containsAny(folders, selected) {
+			return nil
+		}
+
+		items = append(items, runItem{
+			ref:    ref,
+			auth:   inherited,
+			events: appendEvents(inheritedEvents, ref.Item.Events),
+		})
+		return nil
+	})
+
+	return items, err
+}
+
+func (r *Runner) findFolder(name string) (*resources.ItemTreeNode, error) {
+	if ref, ok := r.collection.Items.FindByPath(name); ok && ref.IsFolder() {
+		return ref.Node, nil
+	}
+
+	var found *resources.ItemTreeNode
+	_ = r.collection.Items.Walk(func(ref resources.ItemRef) error {
+		if found == nil && ref.IsFolder() && ref.Name() == name {
+			found = ref.Node
+		}
+		return nil
+	})
+
+	if found == nil {
+		return nil, fmt.Errorf("folder not found: %s", name)
+	}
+
+	return found, nil
+}
+
+// appendEvents returns a new slice holding parent followed by events.
+func appendEvents(parent []*gen.Event, events []resources.Event) []*gen.Event {
+	all := make([]*gen.Event, 0, len(parent)+len(events))
+	all = append(all, parent...)
+	for _, ev := range events {
+		all = append(all, ev.Event)
+	}
+
+	return all
+}
+
+func containsAny(haystack, needles []*resources.ItemTreeNode) bool {
+	for _, h := range haystack {
+		for _, n := range needles {
+			if h == n {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *Runner) execute(ctx context.Context, iteration, iterations int, it runItem) *Execution {
+	e := &Execution{
+		Iteration: iteration,
+		Item:      it.ref,
+	}
+
+	r.Variables.Local = NewScope()
+
+	def, err := it.ref.Item.ParseRequest()
+	if err != nil {
+		e.Error = err
+		return e
+	}
+
+	sb := &sandbox{
+		ctx:       ctx,
+		timeout:   r.options.ScriptTimeout,
+		client:    r.options.Client,
+		vars:      r.Variables,
+		execution: e,
+		request:   def,
+		info: map[string]interface{}{
+			"iteration":      iteration,
+			"iterationCount": iterations,
+			"requestName":    it.ref.Name(),
+			"requestId":      it.ref.Item.ID,
+		},
+	}
+	if r.options.Environment != nil {
+		sb.envName = r.options.Environment.Name
+	}
+
+	sb.run(EventPrerequest, it.events)
+
+	req, err := NewHTTPRequest(ctx, def, it.auth, r.Variables)
+	if err != nil {
+		e.Error = err
+		return e
+	}
+	e.Request = req
+
+	start := time.Now()
+	res, err := r.options.Client.Do(req)
+	if err != nil {
+		e.Duration = time.Since(start)
+		e.Error = err
+		return e
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	e.Duration = time.Since(start)
+	if err != nil {
+		e.Error = err
+		return e
+	}
+
+	e.Response = &Response{
+		Code:   res.StatusCode,
+		Status: res.Status,
+		Header: res.Header,
+		Body:   body,
+	}
+
+	sb.response = responseData(e.Response, e.Duration)
+	sb.run(EventTest, it.events)
+
+	return e
+}
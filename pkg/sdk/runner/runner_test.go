@@ -0,0 +1,369 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+type recorded struct {
+	Method string
+	Path   string
+	Query  string
+	Auth   string
+	User   string
+	Body   string
+}
+
+func setupServer(t *testing.T) (*httptest.Server, *[]recorded) {
+	t.Helper()
+
+	var (
+		mu   sync.Mutex
+		reqs []recorded
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+
+		mu.Lock()
+		reqs = append(reqs, recorded{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Auth:   r.Header.Get("Authorization"),
+			User:   r.Header.Get("X-User"),
+			Body:   string(b),
+		})
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &reqs
+}
+
+func readCollection(t *testing.T) *resources.Collection {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/runner.postman_collection.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c resources.Collection
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	return &c
+}
+
+func environment(values map[string]string) *resources.Environment {
+	env := &resources.Environment{Name: "test"}
+	for k, v := range values {
+		env.Values = append(env.Values, resources.KeyValuePair{Key: k, Value: v, Enabled: true})
+	}
+
+	return env
+}
+
+func TestRunnerRun(t *testing.T) {
+	server, reqs := setupServer(t)
+
+	r := runner.New(readCollection(t), runner.Options{
+		Environment: environment(map[string]string{
+			"baseUrl":   server.URL,
+			"user":      "env-user",
+			"adminUser": "admin",
+		}),
+		IterationData: []map[string]string{{"name": "Rex"}},
+	})
+
+	summary, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Collection != "Runner" || summary.Failures() != 0 || len(summary.Executions) != 3 {
+		t.Fatalf("Summary is incorrect: %+v", summary)
+	}
+
+	want := []recorded{
+		{Method: "GET", Path: "/root", Query: "page=1", Auth: "Bearer collection-token", User: "env-user"},
+		{Method: "POST", Path: "/admin/create", Auth: "Basic YWRtaW46c2VjcmV0", Body: `{"name": "Rex"}`},
+		{Method: "GET", Path: "/admin/public"},
+	}
+	if !reflect.DeepEqual(*reqs, want) {
+		t.Errorf("Requests are incorrect, have: %+v, want: %+v", *reqs, want)
+	}
+
+	e := summary.Executions[1]
+	if e.Name() != "admin/Create" || e.Response == nil || e.Response.Code != 200 {
+		t.Errorf("Execution is incorrect: %+v", e)
+	}
+
+	if ct := e.Request.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type is incorrect, have: %s", ct)
+	}
+}
+
+func TestRunnerEnvironmentOverridesCollection(t *testing.T) {
+	server, reqs := setupServer(t)
+
+	r := runner.New(readCollection(t), runner.Options{
+		Environment: environment(map[string]string{
+			"baseUrl": server.URL,
+			"token":   "env-token",
+			"page":    "2",
+		}),
+	})
+
+	if _, err := r.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	first := (*reqs)[0]
+	if first.Auth != "Bearer env-token" || first.Query != "page=2" {
+		t.Errorf("Environment should override collection variables: %+v", first)
+	}
+}
+
+func TestRunnerFolders(t *testing.T) {
+	server, reqs := setupServer(t)
+
+	for _, folder := range []string{"admin/nested", "nested"} {
+		*reqs = nil
+
+		r := runner.New(readCollection(t), runner.Options{
+			Environment: environment(map[string]string{"baseUrl": server.URL}),
+			Folders:     []string{folder},
+		})
+
+		summary, err := r.Run(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(summary.Executions) != 1 || summary.Executions[0].Name() != "admin/nested/Public" {
+			t.Errorf("Folder %s ran the wrong requests: %+v", folder, *reqs)
+		}
+	}
+
+	r := runner.New(readCollection(t), runner.Options{Folders: []string{"missing"}})
+	if _, err := r.Run(context.Background()); err == nil {
+		t.Error("Running a missing folder should return an error.")
+	}
+}
+
+func TestRunnerIterations(t *testing.T) {
+	server, reqs := setupServer(t)
+
+	r := runner.New(readCollection(t), runner.Options{
+		Environment: environment(map[string]string{"baseUrl": server.URL}),
+		Folders:     []string{"admin/Create"},
+	})
+	if _, err := r.Run(context.Background()); err == nil {
+		t.Error("Selecting a request as a folder should return an error.")
+	}
+
+	r = runner.New(readCollection(t), runner.Options{
+		Environment:    environment(map[string]string{"baseUrl": server.URL}),
+		Folders:        []string{"admin"},
+		IterationData:  []map[string]string{{"name": "a"}, {"name": "b"}},
+		IterationCount: 3,
+	})
+
+	summary, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Iterations != 3 || len(summary.Executions) != 6 {
+		t.Fatalf("Summary is incorrect: %+v", summary)
+	}
+
+	var names []string
+	for _, req := range *reqs {
+		if strings.HasSuffix(req.Path, "/create") {
+			names = append(names, req.Body)
+		}
+	}
+
+	want := []string{`{"name": "a"}`, `{"name": "b"}`, `{"name": "b"}`}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Iteration data is incorrect, have: %v, want: %v", names, want)
+	}
+}
+
+func TestRunnerBail(t *testing.T) {
+	r := runner.New(readCollection(t), runner.Options{
+		Environment: environment(map[string]string{"baseUrl": "http://127.0.0.1:0"}),
+		Bail:        true,
+	})
+
+	summary, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summary.Executions) != 1 || summary.Failures() != 1 {
+		t.Errorf("Run should stop after the first failure: %+v", summary)
+	}
+}
+
+func TestRunnerPlan(t *testing.T) {
+	r := runner.New(readCollection(t), runner.Options{Folders: []string{"admin"}})
+
+	steps, err := r.Plan()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have []string
+	for _, s := range steps {
+		have = append(have, s.Item.FullPath()+" "+s.Auth.Type)
+	}
+
+	want := []string{"admin/Create basic", "admin/nested/Public basic"}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("have steps %v, want %v", have, want)
+	}
+}
+
+func TestNewHTTPRequestQueryAuth(t *testing.T) {
+	def := &resources.Request{Method: "GET"}
+	if err := json.Unmarshal([]byte(`"https://example.com/pets?z=1&a=b%20c"`), &def.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	auth, err := resources.ParseAuth(map[string]interface{}{
+		"type": "apikey",
+		"apikey": []interface{}{
+			map[string]interface{}{"key": "key", "value": "api key"},
+			map[string]interface{}{"key": "value", "value": "{{key}}"},
+			map[string]interface{}{"key": "in", "value": "query"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vars := runner.NewVariables()
+	vars.Environment.Set("key", "s&cret")
+
+	req, err := runner.NewHTTPRequest(context.Background(), def, auth, vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "z=1&a=b%20c&api+key=s%26cret"; req.URL.RawQuery != want {
+		t.Errorf("have query %s, want %s", req.URL.RawQuery, want)
+	}
+}
+
+func TestResolveRequest(t *testing.T) {
+	var def resources.Request
+	if err := json.Unmarshal([]byte(`{
+		"method": "POST",
+		"url": "{{base}}/pets",
+		"header": [
+			{"key": "X-Trace", "value": "{{trace}}"},
+			{"key": "Accept", "value": "application/json"}
+		],
+		"body": {"mode": "raw", "raw": "{\"name\": \"{{name}}\", \"owner\": \"{{owner}}\"}"}
+	}`), &def); err != nil {
+		t.Fatal(err)
+	}
+
+	vars := runner.NewVariables()
+	vars.Environment.Set("base", "https://example.com")
+	vars.Environment.Set("name", "Rex")
+
+	req, err := runner.ResolveRequest(&def, nil, vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have := req.URL.String(); have != "https://example.com/pets" {
+		t.Errorf("have URL %s, want https://example.com/pets", have)
+	}
+
+	var keys []string
+	for _, h := range req.Header {
+		keys = append(keys, h.Key)
+	}
+	if want := []string{"Accept", "X-Trace"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("have headers %v, want %v", keys, want)
+	}
+
+	if want := `{"name": "Rex", "owner": "{{owner}}"}`; req.Body != want {
+		t.Errorf("have body %s, want %s", req.Body, want)
+	}
+
+	if want := []string{"owner", "trace"}; !reflect.DeepEqual(req.Unresolved, want) {
+		t.Errorf("have unresolved %v, want %v", req.Unresolved, want)
+	}
+
+	if _, err := runner.ResolveRequest(&def, nil, runner.NewVariables()); err == nil || !strings.Contains(err.Error(), "base") {
+		t.Errorf("have error %v, want one about the URL variable", err)
+	}
+}
+
+func TestResolveRequestUnresolvedURL(t *testing.T) {
+	var def resources.Request
+	if err := json.Unmarshal([]byte(`{
+		"method": "GET",
+		"url": {
+			"raw": "https://example.com/pets/:petId/photos/{{photo id}}?size={{size}}",
+			"host": ["example", "com"],
+			"protocol": "https",
+			"path": ["pets", ":petId", "photos", "{{photo id}}"],
+			"query": [{"key": "size", "value": "{{size}}"}],
+			"variable": [{"key": "petId", "value": "{{petId}}"}]
+		}
+	}`), &def); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := runner.ResolveRequest(&def, nil, runner.NewVariables())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "https://example.com/pets/{{petId}}/photos/{{photo id}}?size={{size}}"
+	if have := runner.UnescapeVariables(req.URL.String()); have != want {
+		t.Errorf("have URL %s, want %s", have, want)
+	}
+
+	if want := []string{"petId", "photo id", "size"}; !reflect.DeepEqual(req.Unresolved, want) {
+		t.Errorf("have unresolved %v, want %v", req.Unresolved, want)
+	}
+}
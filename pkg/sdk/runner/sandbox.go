@@ -0,0 +1,1113 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+// sandboxSource builds the pm.* API on top of the __host object provided by
+// the script engine. It defines __sandbox(host, data), which returns the
+// globals visible to collection scripts.
+const sandboxSource = `
+var __sandbox = (function () {
+	'use strict';
+
+	function isObject(v) {
+		return v !== null && typeof v === 'object';
+	}
+
+	function typeOf(v) {
+		if (v === null) {
+			return 'null';
+		}
+		if (Array.isArray(v)) {
+			return 'array';
+		}
+		var t = Object.prototype.toString.call(v);
+		if (t === '[object Date]') {
+			return 'date';
+		}
+		if (t === '[object RegExp]') {
+			return 'regexp';
+		}
+		return typeof v;
+	}
+
+	function inspect(v, depth) {
+		depth = depth || 0;
+		switch (typeOf(v)) {
+		case 'string':
+			return "'" + v + "'";
+		case 'undefined':
+			return 'undefined';
+		case 'function':
+			return '[Function' + (v.name ? ': ' + v.name : '') + ']';
+		case 'regexp':
+			return String(v);
+		case 'date':
+			return v.toISOString();
+		case 'array':
+			if (depth > 2) {
+				return '[Array]';
+			}
+			if (v.length === 0) {
+				return '[]';
+			}
+			return '[ ' + v.map(function (e) { return inspect(e, depth + 1); }).join(', ') + ' ]';
+		case 'object':
+			if (depth > 2) {
+				return '[Object]';
+			}
+			var keys = Object.keys(v);
+			if (keys.length === 0) {
+				return '{}';
+			}
+			return '{ ' + keys.map(function (k) { return k + ': ' + inspect(v[k], depth + 1); }).join(', ') + ' }';
+		}
+		return String(v);
+	}
+
+	function deepEqual(a, b) {
+		if (a === b) {
+			return a !== 0 || 1 / a === 1 / b;
+		}
+		if (a !== a && b !== b) {
+			return true;
+		}
+		var ta = typeOf(a);
+		if (ta !== typeOf(b)) {
+			return false;
+		}
+		if (ta === 'date') {
+			return a.getTime() === b.getTime();
+		}
+		if (ta === 'regexp') {
+			return String(a) === String(b);
+		}
+		if (ta === 'array') {
+			if (a.length !== b.length) {
+				return false;
+			}
+			for (var i = 0; i < a.length; i++) {
+				if (!deepEqual(a[i], b[i])) {
+					return false;
+				}
+			}
+			return true;
+		}
+		if (ta === 'object') {
+			var ka = Object.keys(a).sort(), kb = Object.keys(b).sort();
+			if (!deepEqual(ka, kb)) {
+				return false;
+			}
+			for (var j = 0; j < ka.length; j++) {
+				if (!deepEqual(a[ka[j]], b[ka[j]])) {
+					return false;
+				}
+			}
+			return true;
+		}
+		return false;
+	}
+
+	function toVariable(v) {
+		if (v === undefined || v === null) {
+			return '';
+		}
+		if (isObject(v)) {
+			return JSON.stringify(v);
+		}
+		return String(v);
+	}
+
+	function AssertionError(message) {
+		this.name = 'AssertionError';
+		this.message = message;
+	}
+	AssertionError.prototype = Object.create(Error.prototype);
+	AssertionError.prototype.constructor = AssertionError;
+	AssertionError.prototype.toString = function () {
+		return this.name + ': ' + this.message;
+	};
+
+	// Assertion implements the chai BDD interface, plus the response
+	// assertions Postman adds to pm.response.to.
+	function Assertion(obj, message) {
+		Object.defineProperty(this, '__flags', {
+			value: { object: obj, message: message },
+			writable: true
+		});
+	}
+
+	function flag(a, key, value) {
+		if (arguments.length === 3) {
+			a.__flags[key] = value;
+			return undefined;
+		}
+		return a.__flags[key];
+	}
+
+	Assertion.prototype.assert = function (ok, msg, negatedMsg, expected, actual) {
+		var negate = flag(this, 'negate');
+		if (negate ? !ok : ok) {
+			return;
+		}
+
+		var object = flag(this, 'object');
+		if (arguments.length < 5) {
+			actual = object;
+		}
+
+		var m = (negate ? negatedMsg : msg).replace(/#\{(this|exp|act)\}/g, function (_, k) {
+			return inspect(k === 'this' ? object : k === 'exp' ? expected : actual);
+		});
+
+		var custom = flag(this, 'message');
+		throw new AssertionError(custom ? custom + ': ' + m : m);
+	};
+
+	function addProperty(name, fn) {
+		Object.defineProperty(Assertion.prototype, name, {
+			get: function () {
+				var r = fn.call(this);
+				return r === undefined ? this : r;
+			},
+			configurable: true
+		});
+	}
+
+	function addMethod(name, fn) {
+		Assertion.prototype[name] = function () {
+			var r = fn.apply(this, arguments);
+			return r === undefined ? this : r;
+		};
+	}
+
+	function addChainableMethod(name, fn, chain) {
+		Object.defineProperty(Assertion.prototype, name, {
+			get: function () {
+				if (chain) {
+					chain.call(this);
+				}
+				var self = this;
+				var f = function () {
+					var r = fn.apply(self, arguments);
+					return r === undefined ? self : r;
+				};
+				Object.setPrototypeOf(f, self);
+				return f;
+			},
+			configurable: true
+		});
+	}
+
+	['to', 'be', 'been', 'is', 'that', 'which', 'and', 'has', 'have', 'with', 'at', 'of', 'same', 'but', 'does', 'still', 'also'].forEach(function (word) {
+		addProperty(word, function () {});
+	});
+
+	addProperty('not', function () { flag(this, 'negate', true); });
+	addProperty('deep', function () { flag(this, 'deep', true); });
+	addProperty('nested', function () { flag(this, 'nested', true); });
+	addProperty('own', function () { flag(this, 'own', true); });
+	addProperty('any', function () { flag(this, 'any', true); flag(this, 'all', false); });
+	addProperty('all', function () { flag(this, 'all', true); flag(this, 'any', false); });
+
+	function isResponse(a) {
+		return flag(a, 'object') instanceof Response;
+	}
+
+	function assertCode(a, test, description) {
+		var code = flag(a, 'object').code;
+		a.assert(test(code),
+			'expected response code to be ' + description + ' but found ' + code,
+			'expected response code to not be ' + description + ' but found ' + code);
+	}
+
+	function statusProperty(name, description, test) {
+		addProperty(name, function () {
+			if (!isResponse(this)) {
+				throw new AssertionError('expected ' + inspect(flag(this, 'object')) + ' to be a response');
+			}
+			assertCode(this, test, description);
+		});
+	}
+
+	addProperty('ok', function () {
+		if (isResponse(this)) {
+			assertCode(this, function (c) { return c === 200; }, '200');
+			return;
+		}
+		this.assert(flag(this, 'object'), 'expected #{this} to be truthy', 'expected #{this} to be falsy');
+	});
+
+	statusProperty('info', '1XX', function (c) { return c >= 100 && c < 200; });
+	statusProperty('success', '2XX', function (c) { return c >= 200 && c < 300; });
+	statusProperty('redirection', '3XX', function (c) { return c >= 300 && c < 400; });
+	statusProperty('clientError', '4XX', function (c) { return c >= 400 && c < 500; });
+	statusProperty('serverError', '5XX', function (c) { return c >= 500 && c < 600; });
+	statusProperty('error', '4XX or 5XX', function (c) { return c >= 400 && c < 600; });
+	statusProperty('accepted', '202', function (c) { return c === 202; });
+	statusProperty('badRequest', '400', function (c) { return c === 400; });
+	statusProperty('unauthorized', '401', function (c) { return c === 401; });
+	statusProperty('forbidden', '403', function (c) { return c === 403; });
+	statusProperty('notFound', '404', function (c) { return c === 404; });
+	statusProperty('rateLimited', '429', function (c) { return c === 429; });
+
+	addProperty('true', function () {
+		this.assert(flag(this, 'object') === true, 'expected #{this} to be true', 'expected #{this} to be false');
+	});
+	addProperty('false', function () {
+		this.assert(flag(this, 'object') === false, 'expected #{this} to be false', 'expected #{this} to be true');
+	});
+	addProperty('null', function () {
+		this.assert(flag(this, 'object') === null, 'expected #{this} to be null', 'expected #{this} not to be null');
+	});
+	addProperty('undefined', function () {
+		this.assert(flag(this, 'object') === undefined, 'expected #{this} to be undefined', 'expected #{this} not to be undefined');
+	});
+	addProperty('NaN', function () {
+		var o = flag(this, 'object');
+		this.assert(typeof o === 'number' && o !== o, 'expected #{this} to be NaN', 'expected #{this} not to be NaN');
+	});
+	addProperty('exist', function () {
+		var o = flag(this, 'object');
+		this.assert(o !== null && o !== undefined, 'expected #{this} to exist', 'expected #{this} to not exist');
+	});
+	addProperty('empty', function () {
+		var o = flag(this, 'object'), n;
+		switch (typeOf(o)) {
+		case 'string':
+		case 'array':
+			n = o.length;
+			break;
+		case 'object':
+			n = Object.keys(o).length;
+			break;
+		default:
+			throw new AssertionError('.empty was passed non-string primitive ' + inspect(o));
+		}
+		this.assert(n === 0, 'expected #{this} to be empty', 'expected #{this} not to be empty');
+	});
+
+	addProperty('json', function () {
+		var body = flag(this, 'object').text(), ok = true;
+		try {
+			JSON.parse(body);
+		} catch (e) {
+			ok = false;
+		}
+		this.assert(ok, 'expected response body to be a valid json', 'expected response body not to be a valid json');
+	});
+
+	addProperty('withBody', function () {
+		var body = flag(this, 'object').text();
+		this.assert(body.length > 0, 'expected response to have content in body', 'expected response to not have content in body');
+	});
+
+	addChainableMethod('a', assertType);
+	addChainableMethod('an', assertType);
+
+	function assertType(type) {
+		var article = /^[aeiou]/i.test(type) ? 'an ' : 'a ';
+		this.assert(typeOf(flag(this, 'object')) === String(type).toLowerCase(),
+			'expected #{this} to be ' + article + type,
+			'expected #{this} not to be ' + article + type);
+	}
+
+	function includes(haystack, needle, deep) {
+		switch (typeOf(haystack)) {
+		case 'string':
+			return haystack.indexOf(needle) !== -1;
+		case 'array':
+			return haystack.some(function (e) { return deep ? deepEqual(e, needle) : e === needle; });
+		case 'object':
+			if (!isObject(needle)) {
+				return false;
+			}
+			return Object.keys(needle).every(function (k) {
+				return deep ? deepEqual(haystack[k], needle[k]) : haystack[k] === needle[k];
+			});
+		}
+		return false;
+	}
+
+	function assertInclude(value) {
+		this.assert(includes(flag(this, 'object'), value, flag(this, 'deep')),
+			'expected #{this} to ' + (flag(this, 'deep') ? 'deep ' : '') + 'include #{exp}',
+			'expected #{this} to not ' + (flag(this, 'deep') ? 'deep ' : '') + 'include #{exp}', value);
+	}
+
+	function includeChain() {
+		flag(this, 'contains', true);
+	}
+
+	['include', 'includes', 'contain', 'contains'].forEach(function (name) {
+		addChainableMethod(name, assertInclude, includeChain);
+	});
+
+	function assertEqual(value) {
+		if (flag(this, 'deep')) {
+			return assertEql.call(this, value);
+		}
+		this.assert(flag(this, 'object') === value, 'expected #{this} to equal #{exp}', 'expected #{this} to not equal #{exp}', value);
+	}
+
+	function assertEql(value) {
+		this.assert(deepEqual(flag(this, 'object'), value), 'expected #{this} to deeply equal #{exp}', 'expected #{this} to not deeply equal #{exp}', value);
+	}
+
+	['equal', 'equals', 'eq'].forEach(function (name) { addMethod(name, assertEqual); });
+	['eql', 'eqls'].forEach(function (name) { addMethod(name, assertEql); });
+
+	function subject(a) {
+		var o = flag(a, 'object');
+		if (flag(a, 'doLength')) {
+			if (o === null || o === undefined || o.length === undefined) {
+				throw new AssertionError('expected ' + inspect(o) + ' to have a property \'length\'');
+			}
+			return o.length;
+		}
+		return o;
+	}
+
+	function compare(name, aliases, test, description) {
+		var fn = function (n) {
+			var what = flag(this, 'doLength') ? 'expected #{this} to have a length ' : 'expected #{this} to be ';
+			var whatNot = flag(this, 'doLength') ? 'expected #{this} to not have a length ' : 'expected #{this} to not be ';
+			this.assert(test(subject(this), n), what + description + ' #{exp}', whatNot + description + ' #{exp}', n);
+		};
+		[name].concat(aliases).forEach(function (alias) { addMethod(alias, fn); });
+	}
+
+	compare('above', ['gt', 'greaterThan'], function (a, b) { return a > b; }, 'above');
+	compare('least', ['gte'], function (a, b) { return a >= b; }, 'at least');
+	compare('below', ['lt', 'lessThan'], function (a, b) { return a < b; }, 'below');
+	compare('most', ['lte'], function (a, b) { return a <= b; }, 'at most');
+
+	addMethod('within', function (start, finish) {
+		var v = subject(this);
+		this.assert(v >= start && v <= finish,
+			'expected #{this} to be within ' + start + '..' + finish,
+			'expected #{this} to not be within ' + start + '..' + finish);
+	});
+
+	function assertLength(n) {
+		var o = flag(this, 'object');
+		var len = o === null || o === undefined ? undefined : o.length;
+		this.assert(len === n, 'expected #{this} to have a length of #{exp} but got #{act}', 'expected #{this} to not have a length of #{act}', n, len);
+	}
+
+	function lengthChain() {
+		flag(this, 'doLength', true);
+	}
+
+	addChainableMethod('length', assertLength, lengthChain);
+	addChainableMethod('lengthOf', assertLength, lengthChain);
+
+	function getPath(obj, path) {
+		var parts = String(path).replace(/\[(\d+)\]/g, '.$1').split('.');
+		var cur = obj;
+		for (var i = 0; i < parts.length; i++) {
+			if (cur === null || cur === undefined || !(parts[i] in Object(cur))) {
+				return { exists: false };
+			}
+			cur = cur[parts[i]];
+		}
+		return { exists: true, value: cur };
+	}
+
+	addMethod('property', function (name, value) {
+		var o = flag(this, 'object'), found;
+		if (flag(this, 'nested')) {
+			found = getPath(o, name);
+		} else if (flag(this, 'own')) {
+			found = { exists: o !== null && o !== undefined && Object.prototype.hasOwnProperty.call(o, name) };
+			found.value = found.exists ? o[name] : undefined;
+		} else {
+			found = { exists: o !== null && o !== undefined && name in Object(o) };
+			found.value = found.exists ? o[name] : undefined;
+		}
+
+		var desc = (flag(this, 'nested') ? 'nested ' : flag(this, 'own') ? 'own ' : '') + 'property ' + inspect(name);
+		if (arguments.length > 1) {
+			var match = found.exists && (flag(this, 'deep') ? deepEqual(found.value, value) : found.value === value);
+			this.assert(match,
+				'expected #{this} to have ' + desc + ' of #{exp}, but got #{act}',
+				'expected #{this} to not have ' + desc + ' of #{act}', value, found.value);
+		} else {
+			this.assert(found.exists, 'expected #{this} to have ' + desc, 'expected #{this} to not have ' + desc);
+		}
+
+		flag(this, 'object', found.value);
+	});
+
+	addMethod('ownProperty', function (name) {
+		flag(this, 'own', true);
+		return this.property.apply(this, arguments);
+	});
+	Assertion.prototype.haveOwnProperty = Assertion.prototype.ownProperty;
+
+	function assertKeys(keys) {
+		var o = flag(this, 'object');
+		if (arguments.length > 1) {
+			keys = Array.prototype.slice.call(arguments);
+		} else if (typeOf(keys) === 'object') {
+			keys = Object.keys(keys);
+		} else if (typeOf(keys) !== 'array') {
+			keys = [keys];
+		}
+
+		var actual = isObject(o) ? Object.keys(o) : [];
+		var has = function (k) { return actual.indexOf(String(k)) !== -1; };
+
+		var ok;
+		if (flag(this, 'any')) {
+			ok = keys.some(has);
+		} else {
+			ok = keys.every(has) && (flag(this, 'contains') || keys.length === actual.length);
+		}
+
+		var which = flag(this, 'any') ? 'any of keys ' : 'keys ';
+		this.assert(ok,
+			'expected #{this} to ' + (flag(this, 'contains') ? 'contain ' : 'have ') + which + keys.map(inspect).join(', '),
+			'expected #{this} to not ' + (flag(this, 'contains') ? 'contain ' : 'have ') + which + keys.map(inspect).join(', '));
+	}
+
+	addMethod('keys', assertKeys);
+	addMethod('key', assertKeys);
+
+	addMethod('match', function (re) {
+		this.assert(re.test(flag(this, 'object')), 'expected #{this} to match ' + re, 'expected #{this} not to match ' + re);
+	});
+	Assertion.prototype.matches = Assertion.prototype.match;
+
+	addMethod('string', function (s) {
+		this.assert(String(flag(this, 'object')).indexOf(s) !== -1, 'expected #{this} to contain #{exp}', 'expected #{this} to not contain #{exp}', s);
+	});
+
+	addMethod('oneOf', function (list) {
+		var o = flag(this, 'object'), deep = flag(this, 'deep');
+		this.assert(list.some(function (e) { return deep ? deepEqual(e, o) : e === o; }),
+			'expected #{this} to be one of #{exp}', 'expected #{this} to not be one of #{exp}', list);
+	});
+
+	addMethod('instanceof', function (ctor) {
+		this.assert(flag(this, 'object') instanceof ctor,
+			'expected #{this} to be an instance of ' + (ctor.name || 'constructor'),
+			'expected #{this} to not be an instance of ' + (ctor.name || 'constructor'));
+	});
+	Assertion.prototype.instanceOf = Assertion.prototype.instanceof;
+
+	addMethod('members', function (list) {
+		var o = flag(this, 'object'), deep = flag(this, 'deep');
+		var has = function (set, e) {
+			return set.some(function (s) { return deep ? deepEqual(s, e) : s === e; });
+		};
+
+		var ok = list.every(function (e) { return has(o, e); });
+		if (!flag(this, 'contains')) {
+			ok = ok && o.length === list.length && o.every(function (e) { return has(list, e); });
+		}
+
+		this.assert(ok, 'expected #{this} to have the same members as #{exp}', 'expected #{this} to not have the same members as #{exp}', list);
+	});
+
+	addMethod('closeTo', function (expected, delta) {
+		this.assert(Math.abs(flag(this, 'object') - expected) <= delta,
+			'expected #{this} to be close to ' + expected + ' +/- ' + delta,
+			'expected #{this} not to be close to ' + expected + ' +/- ' + delta);
+	});
+	Assertion.prototype.approximately = Assertion.prototype.closeTo;
+
+	addMethod('satisfy', function (fn) {
+		this.assert(fn(flag(this, 'object')), 'expected #{this} to satisfy ' + inspect(fn), 'expected #{this} to not satisfy ' + inspect(fn));
+	});
+	Assertion.prototype.satisfies = Assertion.prototype.satisfy;
+
+	function assertThrow(expected, message) {
+		var fn = flag(this, 'object'), thrown = false, err;
+		try {
+			fn();
+		} catch (e) {
+			thrown = true;
+			err = e;
+		}
+
+		if (typeof expected === 'string' || expected instanceof RegExp) {
+			message = expected;
+			expected = undefined;
+		}
+
+		var ok = thrown;
+		if (ok && typeof expected === 'function') {
+			ok = err instanceof expected;
+		}
+		if (ok && message !== undefined) {
+			var text = err && err.message !== undefined ? err.message : String(err);
+			ok = message instanceof RegExp ? message.test(text) : text.indexOf(message) !== -1;
+		}
+
+		this.assert(ok, 'expected #{this} to throw an error', 'expected #{this} to not throw an error but #{act} was thrown', undefined, err);
+	}
+
+	['throw', 'throws', 'Throw'].forEach(function (name) { addMethod(name, assertThrow); });
+
+	addMethod('status', function (code) {
+		var r = flag(this, 'object');
+		if (typeof code === 'string') {
+			this.assert(r.status === code,
+				'expected response to have status reason #{exp} but got #{act}',
+				'expected response to not have status reason #{exp}', code, r.status);
+			return;
+		}
+		this.assert(r.code === code,
+			'expected response to have status code #{exp} but got #{act}',
+			'expected response to not have status code #{exp}', code, r.code);
+	});
+
+	addMethod('header', function (key, value) {
+		var headers = flag(this, 'object').headers;
+		if (arguments.length < 2) {
+			this.assert(headers.has(key), 'expected response to have header with key #{exp}', 'expected response to not have header with key #{exp}', key);
+			return;
+		}
+		var actual = headers.get(key);
+		this.assert(actual === value,
+			'expected response to have header ' + inspect(key) + ' with value #{exp} but got #{act}',
+			'expected response to not have header ' + inspect(key) + ' with value #{exp}', value, actual);
+	});
+
+	addMethod('body', function (value) {
+		var body = flag(this, 'object').text();
+		if (arguments.length === 0) {
+			this.assert(body.length > 0, 'expected response to have content in body', 'expected response to not have content in body');
+		} else if (value instanceof RegExp) {
+			this.assert(value.test(body), 'expected response body to match ' + value, 'expected response body to not match ' + value);
+		} else if (typeof value === 'string') {
+			this.assert(body === value, 'expected response body to equal #{exp} but got #{act}', 'expected response body to not equal #{exp}', value, body);
+		} else {
+			var parsed;
+			try {
+				parsed = JSON.parse(body);
+			} catch (e) {
+				parsed = undefined;
+			}
+			this.assert(deepEqual(parsed, value), 'expected response body json to equal #{exp} but got #{act}', 'expected response body json to not equal #{exp}', value, parsed);
+		}
+	});
+
+	addMethod('jsonBody', function (path, value) {
+		var parsed;
+		try {
+			parsed = flag(this, 'object').json();
+		} catch (e) {
+			this.assert(false, 'expected response body to be a valid json', 'expected response body not to be a valid json');
+			return;
+		}
+
+		if (arguments.length === 0) {
+			this.assert(true, '', 'expected response body not to be a valid json');
+		} else if (typeof path === 'string') {
+			var found = getPath(parsed, path);
+			if (arguments.length === 1) {
+				this.assert(found.exists, 'expected #{act} in response to contain property #{exp}', 'expected #{act} in response to not contain property #{exp}', path, parsed);
+			} else {
+				this.assert(found.exists && deepEqual(found.value, value),
+					'expected response body json at ' + inspect(path) + ' to contain #{exp} but got #{act}',
+					'expected response body json at ' + inspect(path) + ' to not contain #{exp}', value, found.value);
+			}
+		} else {
+			this.assert(deepEqual(parsed, path), 'expected response body json to equal #{exp} but got #{act}', 'expected response body json to not equal #{exp}', path, parsed);
+		}
+	});
+
+	function expect(value, message) {
+		return new Assertion(value, message);
+	}
+	expect.fail = function (message) {
+		throw new AssertionError(message || 'expect.fail()');
+	};
+
+	// PropertyList mirrors the list types of the Postman collection SDK used
+	// for headers and query parameters.
+	function PropertyList(items, caseInsensitive) {
+		this.__items = [];
+		this.__caseInsensitive = caseInsensitive;
+		var self = this;
+		(items || []).forEach(function (item) { self.add(item); });
+	}
+
+	PropertyList.prototype.__index = function (key) {
+		var k = this.__caseInsensitive ? String(key).toLowerCase() : String(key);
+		for (var i = 0; i < this.__items.length; i++) {
+			var ik = this.__caseInsensitive ? this.__items[i].key.toLowerCase() : this.__items[i].key;
+			if (ik === k) {
+				return i;
+			}
+		}
+		return -1;
+	};
+
+	PropertyList.prototype.add = function (item) {
+		if (typeof item === 'string') {
+			var i = item.indexOf(':');
+			item = { key: i === -1 ? item : item.slice(0, i).trim(), value: i === -1 ? '' : item.slice(i + 1).trim() };
+		}
+		this.__items.push({
+			key: String(item.key),
+			value: item.value === undefined || item.value === null ? item.value : String(item.value),
+			disabled: !!item.disabled
+		});
+	};
+
+	PropertyList.prototype.upsert = function (item) {
+		var i = this.__index(item.key);
+		if (i === -1) {
+			this.add(item);
+			return;
+		}
+		this.__items[i].value = item.value === undefined || item.value === null ? item.value : String(item.value);
+		this.__items[i].disabled = !!item.disabled;
+	};
+
+	PropertyList.prototype.remove = function (predicate) {
+		var self = this;
+		this.__items = this.__items.filter(function (item) {
+			if (typeof predicate === 'function') {
+				return !predicate(item);
+			}
+			var key = isObject(predicate) ? predicate.key : predicate;
+			return self.__caseInsensitive ? item.key.toLowerCase() !== String(key).toLowerCase() : item.key !== String(key);
+		});
+	};
+
+	PropertyList.prototype.clear = function () {
+		this.__items = [];
+	};
+
+	PropertyList.prototype.get = function (key) {
+		var i = this.__index(key);
+		return i === -1 || this.__items[i].disabled ? undefined : this.__items[i].value;
+	};
+
+	PropertyList.prototype.one = function (key) {
+		var i = this.__index(key);
+		return i === -1 ? undefined : this.__items[i];
+	};
+
+	PropertyList.prototype.has = function (key, value) {
+		var i = this.__index(isObject(key) ? key.key : key);
+		if (i === -1 || this.__items[i].disabled) {
+			return false;
+		}
+		return arguments.length < 2 || this.__items[i].value === value;
+	};
+
+	PropertyList.prototype.all = function () {
+		return this.__items.slice();
+	};
+
+	PropertyList.prototype.count = function () {
+		return this.__items.length;
+	};
+
+	PropertyList.prototype.idx = function (i) {
+		return this.__items[i];
+	};
+
+	PropertyList.prototype.each = function (fn) {
+		this.__items.forEach(function (item) { fn(item); });
+	};
+
+	PropertyList.prototype.map = function (fn) {
+		return this.__items.map(function (item) { return fn(item); });
+	};
+
+	PropertyList.prototype.filter = function (fn) {
+		return this.__items.filter(function (item) { return fn(item); });
+	};
+
+	PropertyList.prototype.toObject = function () {
+		var o = {};
+		this.__items.forEach(function (item) {
+			if (!item.disabled) {
+				o[item.key] = item.value;
+			}
+		});
+		return o;
+	};
+
+	PropertyList.prototype.toJSON = function () {
+		return this.__items.slice();
+	};
+
+	function Url(raw) {
+		this.update(raw);
+	}
+
+	Url.prototype.update = function (raw) {
+		var s = String(raw === undefined || raw === null ? '' : raw), i;
+
+		this.hash = undefined;
+		i = s.indexOf('#');
+		if (i !== -1) {
+			this.hash = s.slice(i + 1);
+			s = s.slice(0, i);
+		}
+
+		var query = [];
+		i = s.indexOf('?');
+		if (i !== -1) {
+			query = s.slice(i + 1).split('&').filter(function (p) { return p !== ''; }).map(function (p) {
+				var j = p.indexOf('=');
+				return j === -1 ? { key: p, value: null } : { key: p.slice(0, j), value: p.slice(j + 1) };
+			});
+			s = s.slice(0, i);
+		}
+		this.query = new PropertyList(query, false);
+
+		this.protocol = undefined;
+		i = s.indexOf('://');
+		if (i !== -1) {
+			this.protocol = s.slice(0, i);
+			s = s.slice(i + 3);
+		}
+
+		var host = s, path;
+		i = s.indexOf('/');
+		if (i !== -1) {
+			host = s.slice(0, i);
+			path = s.slice(i + 1).split('/');
+		}
+
+		this.port = undefined;
+		var m = /^(.*):(\d+|\{\{[^{}]+\}\})$/.exec(host);
+		if (m) {
+			host = m[1];
+			this.port = m[2];
+		}
+
+		this.host = host === '' ? [] : host.split('.');
+		this.path = path;
+	};
+
+	Url.prototype.getHost = function () {
+		return this.host.join('.');
+	};
+
+	Url.prototype.getPath = function () {
+		return '/' + (this.path || []).join('/');
+	};
+
+	Url.prototype.getQueryString = function () {
+		return this.query.all().filter(function (q) { return !q.disabled; }).map(function (q) {
+			return q.value === null || q.value === undefined ? q.key : q.key + '=' + q.value;
+		}).join('&');
+	};
+
+	Url.prototype.getPathWithQuery = function () {
+		var q = this.getQueryString();
+		return this.getPath() + (q ? '?' + q : '');
+	};
+
+	Url.prototype.addQueryParams = function (params) {
+		var self = this;
+		if (typeof params === 'string') {
+			params = new Url('?' + params).query.all();
+		}
+		(Array.isArray(params) ? params : [params]).forEach(function (p) { self.query.add(p); });
+	};
+
+	Url.prototype.removeQueryParams = function (keys) {
+		var self = this;
+		(Array.isArray(keys) ? keys : [keys]).forEach(function (k) { self.query.remove(k); });
+	};
+
+	Url.prototype.toString = function () {
+		var s = '';
+		if (this.protocol) {
+			s += this.protocol + '://';
+		}
+		s += this.getHost();
+		if (this.port) {
+			s += ':' + this.port;
+		}
+		if (this.path) {
+			s += '/' + this.path.join('/');
+		}
+		var q = this.getQueryString();
+		if (q) {
+			s += '?' + q;
+		}
+		if (this.hash !== undefined) {
+			s += '#' + this.hash;
+		}
+		return s;
+	};
+
+	Url.prototype.toJSON = function () {
+		return this.toString();
+	};
+
+	function Request(d) {
+		this.id = d.id;
+		this.name = d.name;
+		this.method = d.method || 'GET';
+		this.url = new Url(d.url);
+		this.headers = new PropertyList(d.header, true);
+		this.body = d.body || undefined;
+		this.auth = d.auth || undefined;
+	}
+
+	Request.prototype.addHeader = function (header) {
+		this.headers.add(header);
+	};
+
+	Request.prototype.upsertHeader = function (header) {
+		this.headers.upsert(header);
+	};
+
+	Request.prototype.removeHeader = function (key) {
+		this.headers.remove(key);
+	};
+
+	Request.prototype.getHeaders = function () {
+		return this.headers.toObject();
+	};
+
+	Request.prototype.toJSON = function () {
+		return {
+			method: this.method,
+			url: this.url.toString(),
+			header: this.headers.all(),
+			body: this.body,
+			auth: this.auth
+		};
+	};
+
+	function Response(d) {
+		this.id = d.id;
+		this.code = d.code;
+		this.status = d.status;
+		this.headers = new PropertyList(d.header, true);
+		this.responseTime = d.responseTime;
+		this.responseSize = d.responseSize;
+		Object.defineProperty(this, '__body', { value: d.body });
+	}
+
+	Response.prototype.text = function () {
+		return this.__body;
+	};
+
+	Response.prototype.json = function () {
+		return JSON.parse(this.__body);
+	};
+
+	Response.prototype.reason = function () {
+		return this.status;
+	};
+
+	Response.prototype.size = function () {
+		return { body: this.responseSize, header: 0, total: this.responseSize };
+	};
+
+	Object.defineProperty(Response.prototype, 'to', {
+		get: function () {
+			return new Assertion(this).to;
+		}
+	});
+
+	Object.defineProperty(Response.prototype, 'not', {
+		get: function () {
+			return new Assertion(this).not;
+		}
+	});
+
+	function VariableScope(host, scope, name) {
+		this.__host = host;
+		this.__scope = scope;
+		if (name !== undefined) {
+			this.name = name;
+		}
+	}
+
+	VariableScope.prototype.get = function (key) {
+		return this.__host.get(this.__scope, String(key));
+	};
+
+	VariableScope.prototype.has = function (key) {
+		return this.__host.has(this.__scope, String(key));
+	};
+
+	VariableScope.prototype.set = function (key, value) {
+		this.__host.set(this.__scope, String(key), toVariable(value));
+	};
+
+	VariableScope.prototype.unset = function (key) {
+		this.__host.unset(this.__scope, String(key));
+	};
+
+	VariableScope.prototype.clear = function () {
+		this.__host.clear(this.__scope);
+	};
+
+	VariableScope.prototype.toObject = function () {
+		return this.__host.toObject(this.__scope);
+	};
+
+	VariableScope.prototype.replaceIn = function (template) {
+		return this.__host.replaceIn(this.__scope, String(template));
+	};
+
+	function ReadOnlyScope(host, scope) {
+		VariableScope.call(this, host, scope);
+	}
+	ReadOnlyScope.prototype.get = VariableScope.prototype.get;
+	ReadOnlyScope.prototype.has = VariableScope.prototype.has;
+	ReadOnlyScope.prototype.toObject = VariableScope.prototype.toObject;
+	ReadOnlyScope.prototype.toJSON = VariableScope.prototype.toObject;
+
+	function format(args) {
+		return Array.prototype.map.call(args, function (a) {
+			return typeof a === 'string' ? a : inspect(a);
+		}).join(' ');
+	}
+
+	function toResponse(d) {
+		return d ? new Response(d) : undefined;
+	}
+
+	return function (host, data) {
+		data = JSON.parse(data);
+
+		var request = new Request(data.request || {});
+		var response = toResponse(data.response);
+
+		function test(name, fn) {
+			var result = { name: String(name), skipped: false };
+			if (typeof fn !== 'function') {
+				result.skipped = true;
+				host.test(result);
+				return test;
+			}
+
+			try {
+				if (fn.length > 0) {
+					fn(function (err) {
+						if (err) {
+							throw err;
+						}
+					});
+				} else {
+					fn();
+				}
+			} catch (e) {
+				result.error = e && e.message !== undefined ? String(e.message) : String(e);
+				result.errorName = e && e.name ? String(e.name) : 'Error';
+			}
+
+			host.test(result);
+			return test;
+		}
+
+		test.skip = function (name) {
+			host.test({ name: String(name), skipped: true });
+			return test;
+		};
+
+		var pm = {
+			info: data.info,
+			environment: new VariableScope(host, 'environment', data.environmentName),
+			collectionVariables: new VariableScope(host, 'collection'),
+			globals: new VariableScope(host, 'globals'),
+			variables: new VariableScope(host, 'variables'),
+			iterationData: new ReadOnlyScope(host, 'data'),
+			request: request,
+			response: response,
+			test: test,
+			expect: expect,
+			sendRequest: function (req, callback) {
+				if (typeof req === 'string') {
+					req = { url: req };
+				}
+
+				var header = req.header || req.headers;
+				if (isObject(header) && !Array.isArray(header)) {
+					header = Object.keys(header).map(function (k) { return { key: k, value: String(header[k]) }; });
+				}
+
+				var out = {
+					method: req.method || 'GET',
+					url: String(req.url),
+					header: header || [],
+					body: req.body
+				};
+
+				var res, err = null;
+				try {
+					res = toResponse(JSON.parse(host.sendRequest(JSON.stringify(out))));
+				} catch (e) {
+					err = e;
+				}
+
+				if (typeof callback === 'function') {
+					callback(err, res);
+				}
+			}
+		};
+
+		var globals = {
+			pm: pm,
+			console: {
+				log: function () { host.log('log', format(arguments)); },
+				info: function () { host.log('info', format(arguments)); },
+				warn: function () { host.log('warn', format(arguments)); },
+				error: function () { host.log('error', format(arguments)); },
+				debug: function () { host.log('debug', format(arguments)); }
+			},
+			btoa: host.btoa,
+			atob: host.atob,
+			tests: {},
+			postman: {
+				setEnvironmentVariable: function (k, v) { pm.environment.set(k, v); },
+				getEnvironmentVariable: function (k) { return pm.environment.get(k); },
+				clearEnvironmentVariable: function (k) { pm.environment.unset(k); },
+				clearEnvironmentVariables: function () { pm.environment.clear(); },
+				setGlobalVariable: function (k, v) { pm.globals.set(k, v); },
+				getGlobalVariable: function (k) { return pm.globals.get(k); },
+				clearGlobalVariable: function (k) { pm.globals.unset(k); },
+				clearGlobalVariables: function () { pm.globals.clear(); }
+			},
+			environment: pm.environment.toObject(),
+			globals: pm.globals.toObject(),
+			data: pm.iterationData.toObject(),
+			request: {
+				method: request.method,
+				url: request.url.toString(),
+				headers: request.getHeaders(),
+				data: request.body && request.body.raw
+			}
+		};
+
+		if (response) {
+			globals.responseBody = response.text();
+			globals.responseCode = { code: response.code, name: response.status, detail: response.status };
+			globals.responseHeaders = response.headers.toObject();
+			globals.responseTime = response.responseTime;
+		}
+
+		return globals;
+	};
+})();
+`
@@ -0,0 +1,480 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Script events.
+const (
+	EventPrerequest = "prerequest"
+	EventTest       = "test"
+)
+
+// TestResult is the outcome of a pm.test call.
+type TestResult struct {
+	Name    string
+	Skipped bool
+
+	// Error is the assertion message of a failed test.
+	Error string
+}
+
+// Passed reports whether the test ran without failing.
+func (t TestResult) Passed() bool {
+	return !t.Skipped && t.Error == ""
+}
+
+// ScriptError is an error thrown by a script outside of a test.
+type ScriptError struct {
+	Event string
+	Err   error
+}
+
+func (e *ScriptError) Error() string {
+	return fmt.Sprintf("%s script: %s", e.Event, e.Err)
+}
+
+// ConsoleMessage is a message logged by a script with console.log and
+// friends.
+type ConsoleMessage struct {
+	Level   string
+	Message string
+}
+
+var (
+	sandboxOnce    sync.Once
+	sandboxProgram *goja.Program
+	sandboxErr     error
+)
+
+func compiledSandbox() (*goja.Program, error) {
+	sandboxOnce.Do(func() {
+		sandboxProgram, sandboxErr = goja.Compile("sandbox.js", sandboxSource, false)
+	})
+
+	return sandboxProgram, sandboxErr
+}
+
+// scriptSource returns the source of a script. Collections store it as a
+// string or as a list of lines.
+func scriptSource(s *gen.Script) string {
+	if s == nil {
+		return ""
+	}
+
+	switch exec := s.Exec.(type) {
+	case string:
+		return exec
+	case []interface{}:
+		lines := make([]string, len(exec))
+		for i, line := range exec {
+			lines[i] = valueString(line)
+		}
+		return strings.Join(lines, "\n")
+	case []string:
+		return strings.Join(exec, "\n")
+	}
+
+	return ""
+}
+
+// sandbox runs the scripts of a single request execution. Each script runs
+// in a fresh JavaScript runtime; changes to variables and the request are
+// carried between them.
+type sandbox struct {
+	ctx       context.Context
+	timeout   time.Duration
+	client    *http.Client
+	vars      *Variables
+	envName   string
+	execution *Execution
+	info      map[string]interface{}
+	request   *resources.Request
+	response  map[string]interface{}
+}
+
+// run executes the scripts listening to event, in order.
+func (s *sandbox) run(event string, events []*gen.Event) {
+	for _, ev := range events {
+		if ev == nil || ev.Disabled || ev.Listen != event {
+			continue
+		}
+
+		source := scriptSource(ev.Script)
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+
+		if err := s.exec(event, source); err != nil {
+			s.execution.ScriptErrors = append(s.execution.ScriptErrors, &ScriptError{Event: event, Err: err})
+		}
+	}
+}
+
+func (s *sandbox) exec(event, source string) error {
+	program, err := compiledSandbox()
+	if err != nil {
+		return err
+	}
+
+	vm := goja.New()
+
+	var expired <-chan time.Time
+	if s.timeout > 0 {
+		timer := time.NewTimer(s.timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			vm.Interrupt(s.ctx.Err())
+		case <-expired:
+			vm.Interrupt(fmt.Errorf("the script timed out after %s", s.timeout))
+		case <-done:
+		}
+	}()
+
+	if _, err := vm.RunProgram(program); err != nil {
+		return err
+	}
+
+	data, err := s.data(event)
+	if err != nil {
+		return err
+	}
+
+	newSandbox, _ := goja.AssertFunction(vm.Get("__sandbox"))
+	v, err := newSandbox(goja.Undefined(), vm.ToValue(s.host(vm)), vm.ToValue(string(data)))
+	if err != nil {
+		return err
+	}
+
+	globals := v.ToObject(vm)
+	for _, k := range globals.Keys() {
+		if err := vm.Set(k, globals.Get(k)); err != nil {
+			return err
+		}
+	}
+
+	name := fmt.Sprintf("%s/%s", s.execution.Name(), event)
+	_, runErr := vm.RunScript(name, source)
+
+	// Record legacy tests["name"] = bool results and request changes even
+	// when the script failed part way.
+	s.legacyTests(vm)
+
+	if event == EventPrerequest {
+		if err := s.updateRequest(vm, globals); err != nil && runErr == nil {
+			runErr = err
+		}
+	}
+
+	return runErr
+}
+
+func (s *sandbox) data(event string) ([]byte, error) {
+	info := map[string]interface{}{"eventName": event}
+	for k, v := range s.info {
+		info[k] = v
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"info":            info,
+		"environmentName": s.envName,
+		"request": map[string]interface{}{
+			"id":     s.execution.Item.Item.ID,
+			"name":   s.execution.Item.Name(),
+			"method": s.request.Method,
+			"url":    s.request.URL.String(),
+			"header": s.request.Header,
+			"body":   s.request.Body,
+			"auth":   s.request.Auth,
+		},
+		"response": s.response,
+	})
+}
+
+// updateRequest applies changes made to pm.request by a pre-request script.
+func (s *sandbox) updateRequest(vm *goja.Runtime, globals *goja.Object) error {
+	pm := globals.Get("pm")
+	if pm == nil || goja.IsUndefined(pm) || goja.IsNull(pm) {
+		return nil
+	}
+
+	stringify, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	v, err := stringify(goja.Undefined(), pm.ToObject(vm).Get("request"))
+	if err != nil {
+		return err
+	}
+
+	var updated struct {
+		Method string               `json:"method"`
+		URL    string               `json:"url"`
+		Header resources.HeaderList `json:"header"`
+		Body   json.RawMessage      `json:"body"`
+		Auth   json.RawMessage      `json:"auth"`
+	}
+	if err := json.Unmarshal([]byte(v.String()), &updated); err != nil {
+		return fmt.Errorf("invalid pm.request: %s", err)
+	}
+
+	if updated.Method != "" {
+		s.request.Method = strings.ToUpper(updated.Method)
+	}
+
+	if updated.URL != s.request.URL.String() {
+		s.request.URL = resources.URL{Raw: updated.URL, Variable: s.request.URL.Variable}
+	}
+
+	s.request.Header = updated.Header
+
+	if changedJSON(s.request.Body, updated.Body) {
+		var body *resources.RequestBody
+		if err := json.Unmarshal(updated.Body, &body); err != nil {
+			return fmt.Errorf("invalid pm.request.body: %s", err)
+		}
+		s.request.Body = body
+	}
+
+	if changedJSON(s.request.Auth, updated.Auth) {
+		var auth *gen.Auth
+		if len(updated.Auth) > 0 && string(updated.Auth) != "null" {
+			auth = &gen.Auth{}
+			if err := json.Unmarshal(updated.Auth, auth); err != nil {
+				return fmt.Errorf("invalid pm.request.auth: %s", err)
+			}
+		}
+		s.request.Auth = auth
+	}
+
+	return nil
+}
+
+// changedJSON reports whether updated encodes a different value than
+// original.
+func changedJSON(original interface{}, updated json.RawMessage) bool {
+	b, err := json.Marshal(original)
+	if err != nil {
+		return true
+	}
+
+	var a, u interface{}
+	if len(updated) > 0 {
+		if err := json.Unmarshal(updated, &u); err != nil {
+			return true
+		}
+	}
+	_ = json.Unmarshal(b, &a)
+
+	return !reflect.DeepEqual(a, u)
+}
+
+func (s *sandbox) legacyTests(vm *goja.Runtime) {
+	v := vm.Get("tests")
+	if v == nil || goja.IsUndefined(v) || goja.IsNull(v) {
+		return
+	}
+
+	tests := v.ToObject(vm)
+	for _, k := range tests.Keys() {
+		result := TestResult{Name: k}
+		if !tests.Get(k).ToBoolean() {
+			result.Error = "expected " + strconv.Quote(k) + " to be truthy"
+		}
+		s.execution.Tests = append(s.execution.Tests, result)
+	}
+}
+
+func (s *sandbox) scope(name string) *Scope {
+	switch name {
+	case "environment":
+		return s.vars.Environment
+	case "collection":
+		return s.vars.Collection
+	case "globals":
+		return s.vars.Globals
+	case "data":
+		return s.vars.Data
+	}
+
+	return s.vars.Local
+}
+
+// host returns the functions backing the pm.* API. The "variables" scope
+// reads across all scopes and writes to local variables.
+func (s *sandbox) host(vm *goja.Runtime) map[string]interface{} {
+	return map[string]interface{}{
+		"get": func(scope, key string) goja.Value {
+			var (
+				v  string
+				ok bool
+			)
+			if scope == "variables" {
+				v, ok = s.vars.Get(key)
+			} else {
+				v, ok = s.scope(scope).Get(key)
+			}
+
+			if !ok {
+				return goja.Undefined()
+			}
+			return vm.ToValue(v)
+		},
+		"has": func(scope, key string) bool {
+			if scope == "variables" {
+				_, ok := s.vars.Get(key)
+				return ok
+			}
+			return s.scope(scope).Has(key)
+		},
+		"set": func(scope, key, value string) {
+			s.scope(scope).Set(key, value)
+		},
+		"unset": func(scope, key string) {
+			s.scope(scope).Unset(key)
+		},
+		"clear": func(scope string) {
+			s.scope(scope).Clear()
+		},
+		"toObject": func(scope string) map[string]interface{} {
+			scopes := []*Scope{s.scope(scope)}
+			if scope == "variables" {
+				scopes = []*Scope{s.vars.Globals, s.vars.Collection, s.vars.Environment, s.vars.Data, s.vars.Local}
+			}
+
+			o := make(map[string]interface{})
+			for _, sc := range scopes {
+				if sc == nil {
+					continue
+				}
+				for _, k := range sc.Keys() {
+					o[k], _ = sc.Get(k)
+				}
+			}
+			return o
+		},
+		"replaceIn": func(scope, template string) string {
+			if scope == "variables" {
+				return s.vars.Replace(template)
+			}
+			return (&Variables{Local: s.scope(scope)}).Replace(template)
+		},
+		"test": func(v map[string]interface{}) {
+			result := TestResult{Name: valueString(v["name"])}
+			result.Skipped, _ = v["skipped"].(bool)
+			if msg, ok := v["error"]; ok {
+				result.Error = valueString(msg)
+				if result.Error == "" {
+					result.Error = valueString(v["errorName"])
+				}
+			}
+			s.execution.Tests = append(s.execution.Tests, result)
+		},
+		"log": func(level, message string) {
+			s.execution.Console = append(s.execution.Console, ConsoleMessage{Level: level, Message: message})
+		},
+		"sendRequest": s.sendRequest,
+		"btoa": func(v string) string {
+			return base64.StdEncoding.EncodeToString([]byte(v))
+		},
+		"atob": func(v string) (string, error) {
+			b, err := base64.StdEncoding.DecodeString(v)
+			return string(b), err
+		},
+	}
+}
+
+// sendRequest sends a request for pm.sendRequest. Variables are not
+// resolved, matching Postman.
+func (s *sandbox) sendRequest(def string) (string, error) {
+	r, err := resources.ParseRequest(json.RawMessage(def))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := NewHTTPRequest(s.ctx, r, nil, NewVariables())
+	if err != nil {
+		return "", err
+	}
+
+	start := time.Now()
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(responseData(&Response{
+		Code:   res.StatusCode,
+		Status: res.Status,
+		Header: res.Header,
+		Body:   body,
+	}, time.Since(start)))
+
+	return string(b), err
+}
+
+// responseData converts a response to the data pm.response is built from.
+func responseData(r *Response, d time.Duration) map[string]interface{} {
+	keys := make([]string, 0, len(r.Header))
+	for k := range r.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	headers := []map[string]string{}
+	for _, k := range keys {
+		for _, v := range r.Header[k] {
+			headers = append(headers, map[string]string{"key": k, "value": v})
+		}
+	}
+
+	return map[string]interface{}{
+		"code":         r.Code,
+		"status":       strings.TrimSpace(strings.TrimPrefix(r.Status, strconv.Itoa(r.Code))),
+		"header":       headers,
+		"body":         string(r.Body),
+		"responseTime": d.Milliseconds(),
+		"responseSize": len(r.Body),
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+func scriptServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Echo", r.Header.Get("X-Pre"))
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ok":    true,
+			"query": r.URL.RawQuery,
+			"items": []int{1, 2},
+			"user":  map[string]interface{}{"name": "Rex", "tags": []string{"a"}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// scriptCollection returns a collection with a single request to path on
+// server, with the given pre-request and test scripts.
+func scriptCollection(t *testing.T, server *httptest.Server, path, prerequest, test string) *resources.Collection {
+	t.Helper()
+
+	c := map[string]interface{}{
+		"info": map[string]interface{}{
+			"name":   "Scripts",
+			"schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json",
+		},
+		"item": []interface{}{
+			map[string]interface{}{
+				"name": "Request",
+				"event": []interface{}{
+					map[string]interface{}{"listen": "prerequest", "script": map[string]interface{}{"exec": strings.Split(prerequest, "\n")}},
+					map[string]interface{}{"listen": "test", "script": map[string]interface{}{"exec": test}},
+				},
+				"request": server.URL + path,
+			},
+		},
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var collection resources.Collection
+	if err := json.Unmarshal(b, &collection); err != nil {
+		t.Fatal(err)
+	}
+
+	return &collection
+}
+
+// runScripts runs a collection with a single request to path on server,
+// with the given pre-request and test scripts.
+func runScripts(t *testing.T, server *httptest.Server, path, prerequest, test string) (*runner.Runner, *runner.Execution) {
+	t.Helper()
+
+	r := runner.New(scriptCollection(t, server, path, prerequest, test), runner.Options{
+		Environment: environment(map[string]string{"counter": "1"}),
+	})
+
+	summary, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r, summary.Executions[0]
+}
+
+func TestScriptsPrerequest(t *testing.T) {
+	server := scriptServer(t)
+
+	r, e := runScripts(t, server, "/get", `
+pm.variables.set("pre", "from-script");
+pm.request.headers.add({ key: "X-Pre", value: pm.variables.get("pre") });
+pm.request.url.addQueryParams("added=1");
+pm.environment.set("counter", Number(pm.environment.get("counter")) + 1);
+pm.collectionVariables.set("seen", pm.info.requestName + ":" + pm.info.eventName);`, `
+pm.test("header", function () {
+	pm.response.to.have.header("X-Echo", "from-script");
+	pm.expect(pm.response.json().query).to.equal("added=1");
+});`)
+
+	if len(e.ScriptErrors) > 0 {
+		t.Fatal(e.ScriptErrors)
+	}
+
+	if len(e.Tests) != 1 || !e.Tests[0].Passed() {
+		t.Errorf("Test should pass: %+v", e.Tests)
+	}
+
+	if v, _ := r.Variables.Environment.Get("counter"); v != "2" {
+		t.Errorf("Environment variable is incorrect, have: %s", v)
+	}
+
+	if v, _ := r.Variables.Collection.Get("seen"); v != "Request:prerequest" {
+		t.Errorf("Collection variable is incorrect, have: %s", v)
+	}
+}
+
+func TestScriptsTestResults(t *testing.T) {
+	server := scriptServer(t)
+
+	_, e := runScripts(t, server, "/missing", "", `
+pm.test("status", function () { pm.response.to.have.status(404); });
+pm.test("not ok", function () { pm.response.to.be.ok; });
+pm.test.skip("later", function () {});
+tests["legacy"] = responseCode.code === 404;
+console.log("code", pm.response.code, { a: 1 });`)
+
+	want := []struct {
+		name    string
+		passed  bool
+		skipped bool
+	}{
+		{"status", true, false},
+		{"not ok", false, false},
+		{"later", false, true},
+		{"legacy", true, false},
+	}
+
+	if len(e.Tests) != len(want) {
+		t.Fatalf("Tests are incorrect: %+v", e.Tests)
+	}
+
+	for i, w := range want {
+		have := e.Tests[i]
+		if have.Name != w.name || have.Passed() != w.passed || have.Skipped != w.skipped {
+			t.Errorf("Test %d is incorrect, have: %+v, want: %+v", i, have, w)
+		}
+	}
+
+	if msg := e.Tests[1].Error; msg != "expected response code to be 200 but found 404" {
+		t.Errorf("Failure message is incorrect, have: %s", msg)
+	}
+
+	if len(e.Console) != 1 || e.Console[0].Message != "code 404 { a: 1 }" {
+		t.Errorf("Console is incorrect: %+v", e.Console)
+	}
+
+	if !e.Failed() {
+		t.Error("Execution with a failed test should fail.")
+	}
+}
+
+func TestScriptsErrors(t *testing.T) {
+	server := scriptServer(t)
+
+	_, e := runScripts(t, server, "/get", "notDefined();", "pm.test('ok', function () {});")
+
+	if len(e.ScriptErrors) != 1 || !strings.Contains(e.ScriptErrors[0].Error(), "notDefined") {
+		t.Errorf("Script error is incorrect: %v", e.ScriptErrors)
+	}
+
+	if e.Response == nil || len(e.Tests) != 1 {
+		t.Error("The request and tests should run after a pre-request script error.")
+	}
+}
+
+func TestScriptsTimeout(t *testing.T) {
+	server := scriptServer(t)
+
+	r := runner.New(scriptCollection(t, server, "/get", "while (true) {}", "pm.test('ok', function () {});"), runner.Options{
+		ScriptTimeout: 50 * time.Millisecond,
+	})
+
+	summary, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := summary.Executions[0]
+	if len(e.ScriptErrors) != 1 || !strings.Contains(e.ScriptErrors[0].Error(), "timed out") {
+		t.Errorf("Script error is incorrect: %v", e.ScriptErrors)
+	}
+
+	if e.Response == nil || len(e.Tests) != 1 {
+		t.Error("The request and tests should run after a pre-request script times out.")
+	}
+}
+
+func TestScriptsSendRequest(t *testing.T) {
+	server := scriptServer(t)
+
+	_, e := runScripts(t, server, "/get", `
+pm.sendRequest({ url: "`+server.URL+`/other?x=1", header: { "X-Pre": "sent" } }, function (err, res) {
+	pm.variables.set("echo", res.headers.get("x-echo"));
+	pm.environment.set("sentQuery", res.json().query);
+});
+pm.sendRequest("http://127.0.0.1:0/", function (err, res) {
+	pm.environment.set("sendError", err ? "yes" : "no");
+});`, `
+pm.test("sent", function () {
+	pm.expect(pm.environment.get("sentQuery")).to.equal("x=1");
+	pm.expect(pm.environment.get("sendError")).to.equal("yes");
+});`)
+
+	if len(e.ScriptErrors) > 0 {
+		t.Fatal(e.ScriptErrors)
+	}
+
+	if len(e.Tests) != 1 || !e.Tests[0].Passed() {
+		t.Errorf("Test should pass: %+v", e.Tests)
+	}
+}
+
+func TestScriptsExpect(t *testing.T) {
+	server := scriptServer(t)
+
+	cases := []struct {
+		assertion string
+		err       string
+	}{
+		{`pm.expect(1).to.equal(1)`, ""},
+		{`pm.expect(1).to.not.equal(2)`, ""},
+		{`pm.expect(1).to.equal(2)`, "expected 1 to equal 2"},
+		{`pm.expect({ a: [1] }).to.eql({ a: [1] })`, ""},
+		{`pm.expect({ a: 1 }).to.deep.equal({ a: 2 })`, "expected { a: 1 } to deeply equal { a: 2 }"},
+		{`pm.expect("abc").to.be.a("string")`, ""},
+		{`pm.expect([]).to.be.an("array").that.is.empty`, ""},
+		{`pm.expect(null).to.be.an("object")`, "expected null to be an object"},
+		{`pm.expect("foobar").to.include("oba")`, ""},
+		{`pm.expect([1, 2, 3]).to.include(4)`, "expected [ 1, 2, 3 ] to include 4"},
+		{`pm.expect({ a: 1, b: 2 }).to.include({ a: 1 })`, ""},
+		{`pm.expect([{ a: 1 }]).to.deep.include({ a: 1 })`, ""},
+		{`pm.expect(5).to.be.above(3).and.below(10)`, ""},
+		{`pm.expect(5).to.be.within(6, 10)`, "expected 5 to be within 6..10"},
+		{`pm.expect([1, 2]).to.have.lengthOf(2)`, ""},
+		{`pm.expect("abc").to.have.length.above(2)`, ""},
+		{`pm.expect({ a: { b: 1 } }).to.have.property("a").that.has.property("b", 1)`, ""},
+		{`pm.expect({ a: { b: [1] } }).to.have.nested.property("a.b[0]", 1)`, ""},
+		{`pm.expect({ a: 1 }).to.have.property("b")`, "expected { a: 1 } to have property 'b'"},
+		{`pm.expect({ a: 1, b: 2 }).to.have.all.keys("a", "b")`, ""},
+		{`pm.expect({ a: 1, b: 2 }).to.include.keys("a")`, ""},
+		{`pm.expect({ a: 1, b: 2 }).to.have.any.keys("c", "a")`, ""},
+		{`pm.expect({ a: 1, b: 2 }).to.have.keys("a")`, "expected { a: 1, b: 2 } to have keys 'a'"},
+		{`pm.expect("abc").to.match(/^a/)`, ""},
+		{`pm.expect(2).to.be.oneOf([1, 2])`, ""},
+		{`pm.expect([3, 1]).to.have.members([1, 3])`, ""},
+		{`pm.expect(1.5).to.be.closeTo(1, 0.5)`, ""},
+		{`pm.expect(function () { throw new Error("boom"); }).to.throw("boom")`, ""},
+		{`pm.expect(undefined).to.not.exist`, ""},
+		{`pm.expect(0).to.be.ok`, "expected 0 to be truthy"},
+		{`pm.expect(true, "custom").to.be.false`, "custom: expected true to be false"},
+		{`pm.response.to.be.success`, ""},
+		{`pm.response.to.not.be.error`, ""},
+		{`pm.response.to.be.json`, ""},
+		{`pm.response.to.have.jsonBody("user.name", "Rex")`, ""},
+		{`pm.response.to.have.status("OK")`, ""},
+		{`pm.response.to.have.status(201)`, "expected response to have status code 201 but got 200"},
+		{`pm.expect(pm.response.headers.get("content-type")).to.equal("application/json")`, ""},
+		{`pm.expect(pm.response.responseTime).to.be.a("number")`, ""},
+	}
+
+	var script strings.Builder
+	for i, c := range cases {
+		script.WriteString("pm.test(" + jsString(i) + ", function () { " + c.assertion + "; });\n")
+	}
+
+	_, e := runScripts(t, server, "/get", "", script.String())
+	if len(e.ScriptErrors) > 0 {
+		t.Fatal(e.ScriptErrors)
+	}
+
+	if len(e.Tests) != len(cases) {
+		t.Fatalf("Tests are incorrect: %+v", e.Tests)
+	}
+
+	for i, c := range cases {
+		if have := e.Tests[i].Error; have != c.err {
+			t.Errorf("%s\n have: %q\n want: %q", c.assertion, have, c.err)
+		}
+	}
+}
+
+func jsString(i int) string {
+	b, _ := json.Marshal(i)
+	return string(b)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Scope is a set of variables that share a lifetime, such as an environment
+// or the variables of a collection.
+type Scope struct {
+	keys   []string
+	values map[string]string
+}
+
+// NewScope creates an empty scope.
+func NewScope() *Scope {
+	return &Scope{values: make(map[string]string)}
+}
+
+// NewEnvironmentScope creates a scope from the enabled values of an
+// environment.
+func NewEnvironmentScope(env *resources.Environment) *Scope {
+	s := NewScope()
+	if env == nil {
+		return s
+	}
+
+	for _, v := range env.Values {
+		if v.Enabled {
+			s.Set(v.Key, v.Value)
+		}
+	}
+
+	return s
+}
+
+// NewCollectionScope creates a scope from collection variables.
+func NewCollectionScope(variables []*gen.Variable) *Scope {
+	s := NewScope()
+	for _, v := range variables {
+		if v == nil || v.Disabled {
+			continue
+		}
+
+		key := v.Key
+		if key == "" {
+			key = v.ID
+		}
+		s.Set(key, valueString(v.Value))
+	}
+
+	return s
+}
+
+// NewDataScope creates a scope from a row of iteration data.
+func NewDataScope(data map[string]string) *Scope {
+	s := NewScope()
+	for k, v := range data {
+		s.Set(k, v)
+	}
+
+	return s
+}
+
+// Get returns the value of a variable.
+func (s *Scope) Get(key string) (string, bool) {
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Has reports whether the scope has a variable.
+func (s *Scope) Has(key string) bool {
+	_, ok := s.values[key]
+	return ok
+}
+
+// Set sets the value of a variable.
+func (s *Scope) Set(key, value string) {
+	if _, ok := s.values[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.values[key] = value
+}
+
+// Unset removes a variable.
+func (s *Scope) Unset(key string) {
+	if _, ok := s.values[key]; !ok {
+		return
+	}
+
+	delete(s.values, key)
+	for i, k := range s.keys {
+		if k == key {
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Clear removes all variables.
+func (s *Scope) Clear() {
+	s.keys = nil
+	s.values = make(map[string]string)
+}
+
+// Keys returns the names of the variables in the order they were set.
+func (s *Scope) Keys() []string {
+	return append([]string{}, s.keys...)
+}
+
+// Variables resolves {{variable}} references across scopes. When a variable
+// is set in more than one scope, Local wins over Data, Data over
+// Environment, Environment over Collection and Collection over Globals.
+type Variables struct {
+	Globals     *Scope
+	Collection  *Scope
+	Environment *Scope
+	Data        *Scope
+	Local       *Scope
+}
+
+// NewVariables creates a set of empty scopes.
+func NewVariables() *Variables {
+	return &Variables{
+		Globals:     NewScope(),
+		Collection:  NewScope(),
+		Environment: NewScope(),
+		Data:        NewScope(),
+		Local:       NewScope(),
+	}
+}
+
+// Get returns the value of a variable from the scope with the highest
+// precedence that sets it.
+func (v *Variables) Get(key string) (string, bool) {
+	for _, s := range []*Scope{v.Local, v.Data, v.Environment, v.Collection, v.Globals} {
+		if s == nil {
+			continue
+		}
+
+		if value, ok := s.Get(key); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+var variablePattern = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+// maxReplaceDepth bounds how many times variables referring to other
+// variables are expanded.
+const maxReplaceDepth = 10
+
+// Replace substitutes {{variable}} references in s. Dynamic variables such as
+// {{$guid}} and {{$timestamp}} are generated. References that can't be
+// resolved are left untouched.
+func (v *Variables) Replace(s string) string {
+	for i := 0; i < maxReplaceDepth; i++ {
+		replaced := variablePattern.ReplaceAllStringFunc(s, func(m string) string {
+			key := m[2 : len(m)-2]
+			if value, ok := v.Get(key); ok {
+				return value
+			}
+
+			if value, ok := dynamicVariable(key); ok {
+				return value
+			}
+
+			return m
+		})
+
+		if replaced == s {
+			break
+		}
+		s = replaced
+	}
+
+	return s
+}
+
+// Unresolved returns the variables referenced in s that have no value, in
+// order.
+func (v *Variables) Unresolved(s string) []string {
+	var names []string
+	for _, m := range variablePattern.FindAllStringSubmatch(v.Replace(s), -1) {
+		names = append(names, m[1])
+	}
+
+	return names
+}
+
+func dynamicVariable(key string) (string, bool) {
+	switch key {
+	case "$guid", "$randomUUID":
+		return newUUID(), true
+	case "$timestamp":
+		return strconv.FormatInt(time.Now().Unix(), 10), true
+	case "$isoTimestamp":
+		return time.Now().UTC().Format(time.RFC3339), true
+	case "$randomInt":
+		n, err := rand.Int(rand.Reader, big.NewInt(1001))
+		if err != nil {
+			return "0", true
+		}
+		return n.String(), true
+	}
+
+	return "", false
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func valueString(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner_test
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/runner"
+)
+
+func TestVariablesPrecedence(t *testing.T) {
+	v := runner.NewVariables()
+	v.Globals.Set("a", "global")
+	v.Collection.Set("a", "collection")
+	v.Collection.Set("b", "collection")
+	v.Environment.Set("a", "environment")
+	v.Data.Set("a", "data")
+	v.Local.Set("a", "local")
+
+	if have, _ := v.Get("a"); have != "local" {
+		t.Errorf("Local variables should win, have: %s", have)
+	}
+
+	v.Local.Unset("a")
+	if have, _ := v.Get("a"); have != "data" {
+		t.Errorf("Data variables should win, have: %s", have)
+	}
+
+	if have, _ := v.Get("b"); have != "collection" {
+		t.Errorf("Collection variable is incorrect, have: %s", have)
+	}
+}
+
+func TestVariablesReplace(t *testing.T) {
+	v := runner.NewVariables()
+	v.Environment.Set("host", "{{scheme}}://example.com")
+	v.Environment.Set("scheme", "https")
+
+	if have := v.Replace("{{host}}/{{missing}}"); have != "https://example.com/{{missing}}" {
+		t.Errorf("Replace is incorrect, have: %s", have)
+	}
+
+	if have := v.Unresolved("{{host}}/{{missing}}/{{$guid}}"); !reflect.DeepEqual(have, []string{"missing"}) {
+		t.Errorf("Unresolved is incorrect, have: %v", have)
+	}
+
+	v.Environment.Set("loop", "{{loop}}")
+	if have := v.Replace("{{loop}}"); have != "{{loop}}" {
+		t.Errorf("Recursive variables should stop, have: %s", have)
+	}
+
+	guid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if have := v.Replace("{{$guid}}"); !guid.MatchString(have) {
+		t.Errorf("$guid is incorrect, have: %s", have)
+	}
+}
+
+func TestReadIterationCSV(t *testing.T) {
+	data, err := runner.ReadIterationCSV(strings.NewReader("\xef\xbb\xbfname,age\nRex,3\nFido,\"5\"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]string{
+		{"name": "Rex", "age": "3"},
+		{"name": "Fido", "age": "5"},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("Data is incorrect, have: %v, want: %v", data, want)
+	}
+}
+
+func TestReadIterationJSON(t *testing.T) {
+	data, err := runner.ReadIterationJSON(strings.NewReader(`[{"name":"Rex","age":3,"tags":["a"]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]string{{"name": "Rex", "age": "3", "tags": `["a"]`}}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("Data is incorrect, have: %v, want: %v", data, want)
+	}
+
+	if _, err := runner.ReadIterationJSON(strings.NewReader(`{"name":"Rex"}`)); err == nil {
+		t.Error("An object should be rejected.")
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sarif writes results in the Static Analysis Results Interchange
+// Format (SARIF) 2.1.0, which code scanning services such as GitHub's
+// import.
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Rule describes a kind of result.
+type Rule struct {
+	ID          string
+	Description string
+}
+
+// Levels of a result.
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+)
+
+// Result is a single result of a rule.
+type Result struct {
+	RuleID  string
+	Level   string
+	Message string
+
+	// Location is the fully qualified name of the place within a resource
+	// the result is about, such as "collection/Pets/Folder/Request".
+	Location string
+
+	// URI is the file the result is about, if any.
+	URI string
+
+	Properties map[string]interface{}
+}
+
+type (
+	log struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []run  `json:"runs"`
+	}
+
+	run struct {
+		Tool    tool     `json:"tool"`
+		Results []result `json:"results"`
+	}
+
+	tool struct {
+		Driver driver `json:"driver"`
+	}
+
+	driver struct {
+		Name           string `json:"name"`
+		InformationURI string `json:"informationUri"`
+		Rules          []rule `json:"rules"`
+	}
+
+	rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+	}
+
+	message struct {
+		Text string `json:"text"`
+	}
+
+	result struct {
+		RuleID     string                 `json:"ruleId"`
+		RuleIndex  int                    `json:"ruleIndex"`
+		Level      string                 `json:"level"`
+		Message    message                `json:"message"`
+		Locations  []location             `json:"locations"`
+		Properties map[string]interface{} `json:"properties,omitempty"`
+	}
+
+	location struct {
+		PhysicalLocation *physicalLocation `json:"physicalLocation,omitempty"`
+		LogicalLocations []logicalLocation `json:"logicalLocations"`
+	}
+
+	physicalLocation struct {
+		ArtifactLocation artifactLocation `json:"artifactLocation"`
+	}
+
+	artifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	logicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+		Kind               string `json:"kind"`
+	}
+)
+
+// Write writes a SARIF log with a single run of postmanctl.
+func Write(w io.Writer, rules []Rule, results []Result) error {
+	r := run{
+		Tool: tool{Driver: driver{
+			Name:           "postmanctl",
+			InformationURI: "https://github.com/kevinswiber/postmanctl",
+			Rules:          make([]rule, len(rules)),
+		}},
+		Results: make([]result, len(results)),
+	}
+
+	index := make(map[string]int)
+	for i, v := range rules {
+		index[v.ID] = i
+		r.Tool.Driver.Rules[i] = rule{ID: v.ID, ShortDescription: message{Text: v.Description}}
+	}
+
+	for i, v := range results {
+		loc := location{
+			LogicalLocations: []logicalLocation{{FullyQualifiedName: v.Location, Kind: "member"}},
+		}
+
+		if v.URI != "" {
+			loc.PhysicalLocation = &physicalLocation{ArtifactLocation: artifactLocation{URI: v.URI}}
+		}
+
+		ruleIndex, ok := index[v.RuleID]
+		if !ok {
+			ruleIndex = -1
+		}
+
+		r.Results[i] = result{
+			RuleID:     v.RuleID,
+			RuleIndex:  ruleIndex,
+			Level:      v.Level,
+			Message:    message{Text: v.Message},
+			Locations:  []location{loc},
+			Properties: v.Properties,
+		}
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+
+	return e.Encode(log{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []run{r},
+	})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/printers"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/sarif"
+)
+
+// Formats returns the names of the supported output formats.
+func Formats() []string {
+	return []string{"json", "sarif", "text"}
+}
+
+// Write writes the findings in the given format. SARIF output describes
+// the rules as well.
+func Write(w io.Writer, findings []Finding, rules []Rule, format string) error {
+	switch format {
+	case "json":
+		if findings == nil {
+			findings = []Finding{}
+		}
+
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(findings)
+	case "sarif":
+		return writeSARIF(w, findings, rules)
+	case "text":
+		return writeText(w, findings)
+	}
+
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func writeText(w io.Writer, findings []Finding) error {
+	if len(findings) == 0 {
+		_, err := fmt.Fprintln(w, "No secrets found.")
+		return err
+	}
+
+	tw := printers.GetNewTabWriter(w)
+	fmt.Fprintln(tw, "RULE\tRESOURCE\tLOCATION\tLINE\tMATCH")
+	for _, f := range findings {
+		line := ""
+		if f.Line > 0 {
+			line = strconv.Itoa(f.Line)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s %s\t%s\t%s\t%s\n", f.RuleID, f.Resource.Type, f.Resource, f.Location, line, f.Match)
+	}
+
+	return tw.Flush()
+}
+
+func writeSARIF(w io.Writer, findings []Finding, rules []Rule) error {
+	sr := make([]sarif.Rule, len(rules))
+	for i, r := range rules {
+		sr[i] = sarif.Rule{ID: r.ID, Description: r.Description}
+	}
+
+	results := make([]sarif.Result, len(findings))
+	for i, f := range findings {
+		props := map[string]interface{}{"match": f.Match}
+		if f.Resource.ID != "" {
+			props["resourceId"] = f.Resource.ID
+		}
+		if f.Line > 0 {
+			props["scriptLine"] = f.Line
+		}
+
+		results[i] = sarif.Result{
+			RuleID:     f.RuleID,
+			Level:      sarif.LevelError,
+			Message:    fmt.Sprintf("%s in %s %q", f.Message, f.Resource.Type, f.Resource),
+			Location:   fmt.Sprintf("%s/%s/%s", f.Resource.Type, f.Resource, f.Location),
+			URI:        f.Resource.URI,
+			Properties: props,
+		}
+	}
+
+	return sarif.Write(w, sr, results)
+}
@@ -0,0 +1,498 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scan looks for secrets that were left in collections and
+// environments, such as tokens in headers, auth settings, URLs, bodies,
+// variables and scripts.
+//
+// Values are matched against patterns for well-known kinds of secrets. In
+// places where secrets are expected, such as the token of a bearer auth or
+// a variable named "apiKey", values that look random are reported as well.
+// References to variables, like "{{token}}", are never reported.
+package scan
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
+)
+
+// Resource identifies a scanned collection or environment.
+type Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	// URI is the file the resource was read from, if any.
+	URI string `json:"uri,omitempty"`
+}
+
+// String returns the name of the resource, or its ID when it has no name.
+func (r Resource) String() string {
+	if r.Name != "" {
+		return r.Name
+	}
+
+	return r.ID
+}
+
+// Finding is a probable secret.
+type Finding struct {
+	RuleID   string   `json:"ruleId"`
+	Message  string   `json:"message"`
+	Resource Resource `json:"resource"`
+
+	// Location is where the secret was found within the resource, such as
+	// "Folder/Request: request.header[Authorization]".
+	Location string `json:"location"`
+
+	// Line is the line of a script the secret was found on.
+	Line int `json:"line,omitempty"`
+
+	// Match is the start of the secret, with the rest masked.
+	Match string `json:"match"`
+}
+
+// Rule describes a kind of secret.
+type Rule struct {
+	ID          string
+	Description string
+
+	// Pattern matches the secret. Rules without a pattern are applied by
+	// the Scanner itself.
+	Pattern *regexp.Regexp
+}
+
+// Rule IDs that aren't matched by a pattern.
+const (
+	HighEntropyRuleID = "high-entropy-value"
+	PasswordRuleID    = "hard-coded-password"
+)
+
+// DefaultRules returns the rules used by New, in the order they are tried.
+func DefaultRules() []Rule {
+	return []Rule{
+		{ID: "private-key", Description: "Private key", Pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+		{ID: "aws-access-key-id", Description: "AWS access key ID", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[A-Z0-9]{16}\b`)},
+		{ID: "aws-secret-access-key", Description: "AWS secret access key", Pattern: regexp.MustCompile(`(?i)aws.{0,20}secret.{0,20}?[=:"'\s]\s*["']?[A-Za-z0-9/+=]{40}\b`)},
+		{ID: "github-token", Description: "GitHub token", Pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{40,})\b`)},
+		{ID: "slack-token", Description: "Slack token", Pattern: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+		{ID: "jwt", Description: "JSON Web Token", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+		{ID: "bearer-token", Description: "Bearer token", Pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]{16,}=*`)},
+		{ID: HighEntropyRuleID, Description: "High entropy value where a secret is expected"},
+		{ID: PasswordRuleID, Description: "Password set in auth settings"},
+	}
+}
+
+// Scanner finds secrets in collections and environments.
+type Scanner struct {
+	Rules []Rule
+
+	// MinEntropy is the Shannon entropy, in bits per character, from which
+	// a value where a secret is expected is reported.
+	MinEntropy float64
+
+	// MinLength is the length from which a value where a secret is
+	// expected is checked for its entropy.
+	MinLength int
+}
+
+// New returns a Scanner with the default rules.
+func New() *Scanner {
+	return &Scanner{
+		Rules:      DefaultRules(),
+		MinEntropy: 3.5,
+		MinLength:  16,
+	}
+}
+
+// Rule returns the rule with the given ID.
+func (s *Scanner) Rule(id string) (Rule, bool) {
+	for _, r := range s.Rules {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+var (
+	// sensitiveName matches names of variables, headers, parameters and
+	// auth attributes that hold secrets.
+	sensitiveName = regexp.MustCompile(`(?i)(token|secret|passw(or)?d|pwd|api[-_]?key|access[-_]?key|private[-_]?key|auth|credential|session|signature|cookie)`)
+
+	// variableRef matches references to variables, which are left out of
+	// values before they are checked.
+	variableRef = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+	// assignment matches string literals assigned to sensitive names in
+	// scripts and bodies, such as "password": "..." or apiKey = '...'.
+	assignment = regexp.MustCompile(`(?i)([A-Za-z0-9_-]*(?:token|secret|passw(?:or)?d|api[-_]?key|access[-_]?key)[A-Za-z0-9_-]*)["']?\s*[:=,]\s*["']([^"'\s]+)["']`)
+
+	// authScheme matches the scheme of an Authorization header value.
+	authScheme = regexp.MustCompile(`(?i)^(bearer|basic|token|digest)\s+`)
+)
+
+// scan holds the findings for one resource.
+type scan struct {
+	*Scanner
+	resource Resource
+	findings []Finding
+}
+
+// Collection returns the secrets found in a collection.
+func (s *Scanner) Collection(c *resources.Collection, uri string) ([]Finding, error) {
+	sc := &scan{Scanner: s, resource: Resource{Type: "collection", URI: uri}}
+	if c.Collection != nil && c.Info != nil {
+		sc.resource.ID = c.Info.PostmanID
+		sc.resource.Name = c.Info.Name
+	}
+
+	if c.Collection != nil {
+		if err := sc.auth("", c.Auth); err != nil {
+			return nil, err
+		}
+		sc.variables("", c.Variable)
+		sc.events("", resources.CollectionEvents(c.Event))
+	}
+
+	if c.Items == nil {
+		return sc.findings, nil
+	}
+
+	err := c.Items.Walk(func(ref resources.ItemRef) error {
+		prefix := ref.FullPath() + ": "
+
+		if ref.IsFolder() {
+			g := ref.Node.ItemGroup
+			if g == nil || g.ItemGroup == nil {
+				return nil
+			}
+
+			if err := sc.auth(prefix, g.Auth); err != nil {
+				return err
+			}
+			sc.variables(prefix, g.Variable)
+			sc.events(prefix, g.Events)
+
+			return nil
+		}
+
+		item := ref.Item
+		if item.Item == nil {
+			return nil
+		}
+
+		if item.Request != nil {
+			r, err := item.ParseRequest()
+			if err != nil {
+				return fmt.Errorf("%s: %s", ref.FullPath(), err)
+			}
+			sc.request(prefix+"request", r)
+		}
+
+		for i, resp := range item.Response {
+			if resp == nil || resp.OriginalRequest == nil {
+				continue
+			}
+
+			r, err := resources.ParseRequest(resp.OriginalRequest)
+			if err != nil {
+				return fmt.Errorf("%s: %s", ref.FullPath(), err)
+			}
+			sc.request(fmt.Sprintf("%sresponse[%d].originalRequest", prefix, i), r)
+		}
+
+		sc.variables(prefix, item.Variable)
+		sc.events(prefix, item.Events)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sc.findings, nil
+}
+
+// Environment returns the secrets found in an environment.
+func (s *Scanner) Environment(e *resources.Environment, uri string) []Finding {
+	sc := &scan{Scanner: s, resource: Resource{Type: "environment", ID: e.ID, Name: e.Name, URI: uri}}
+	for _, v := range e.Values {
+		sc.value(fmt.Sprintf("values[%s]", v.Key), 0, v.Value, v.Secret() || sensitiveName.MatchString(v.Key))
+	}
+
+	return sc.findings
+}
+
+func (sc *scan) request(loc string, r *resources.Request) {
+	sc.value(loc+".url", 0, r.URL.String(), false)
+	for _, q := range queryParams(r.URL) {
+		if sensitiveName.MatchString(q.Key) {
+			sc.value(fmt.Sprintf("%s.url.query[%s]", loc, q.Key), 0, q.Value, true)
+		}
+	}
+
+	for _, h := range r.Header {
+		sc.value(fmt.Sprintf("%s.header[%s]", loc, h.Key), 0, h.Value, sensitiveName.MatchString(h.Key))
+	}
+
+	if r.Auth != nil {
+		sc.authValues(loc+".", r.Auth)
+	}
+
+	if b := r.Body; b != nil {
+		sc.text(loc+".body", b.Raw)
+		for _, p := range append(append([]resources.FormParameter{}, b.URLEncoded...), b.FormData...) {
+			if p.Type == "file" {
+				continue
+			}
+			sc.value(fmt.Sprintf("%s.body[%s]", loc, p.Key), 0, p.Value, sensitiveName.MatchString(p.Key))
+		}
+		if b.GraphQL != nil {
+			sc.text(loc+".body.graphql", b.GraphQL.Query)
+		}
+	}
+}
+
+// queryParams returns the query parameters of u. URLs given as a string
+// only have their raw form.
+func queryParams(u resources.URL) []resources.QueryParam {
+	if len(u.Query) > 0 || u.Raw == "" {
+		return u.Query
+	}
+
+	i := strings.IndexByte(u.Raw, '?')
+	if i < 0 {
+		return nil
+	}
+
+	query := u.Raw[i+1:]
+	if j := strings.IndexByte(query, '#'); j >= 0 {
+		query = query[:j]
+	}
+
+	var params []resources.QueryParam
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+
+		kv := strings.SplitN(pair, "=", 2)
+		q := resources.QueryParam{Key: kv[0]}
+		if len(kv) == 2 {
+			q.Value = kv[1]
+		}
+		params = append(params, q)
+	}
+
+	return params
+}
+
+func (sc *scan) auth(prefix string, v interface{}) error {
+	auth, err := resources.ParseAuth(v)
+	if err != nil {
+		return fmt.Errorf("%sauth: %s", prefix, err)
+	}
+
+	if auth != nil {
+		sc.authValues(prefix, auth)
+	}
+
+	return nil
+}
+
+func (sc *scan) authValues(prefix string, auth *gen.Auth) {
+	values := resources.AuthValues(auth)
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		loc := fmt.Sprintf("%sauth.%s.%s", prefix, auth.Type, k)
+		v := values[k]
+
+		if strings.EqualFold(k, "password") && literal(v) != "" {
+			if !sc.patterns(loc, 0, v) {
+				sc.report(PasswordRuleID, loc, 0, literal(v))
+			}
+			continue
+		}
+
+		sensitive := sensitiveName.MatchString(k) || (auth.Type == "apikey" && k == "value")
+		sc.value(loc, 0, v, sensitive)
+	}
+}
+
+func (sc *scan) variables(prefix string, vars []*gen.Variable) {
+	for _, v := range vars {
+		if v == nil {
+			continue
+		}
+
+		value, ok := v.Value.(string)
+		if !ok {
+			continue
+		}
+
+		key := v.Key
+		if key == "" {
+			key = v.ID
+		}
+
+		sc.value(fmt.Sprintf("%svariable[%s]", prefix, key), 0, value, v.Type == resources.SecretValueType || sensitiveName.MatchString(key))
+	}
+}
+
+func (sc *scan) events(prefix string, events []resources.Event) {
+	for _, e := range events {
+		if e.Event == nil {
+			continue
+		}
+
+		sc.text(fmt.Sprintf("%sevent[%s]", prefix, e.Listen), e.Source())
+	}
+}
+
+// text checks each line of a script or body.
+func (sc *scan) text(loc, s string) {
+	if s == "" {
+		return
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		n := 0
+		if len(lines) > 1 {
+			n = i + 1
+		}
+
+		if sc.patterns(loc, n, line) {
+			continue
+		}
+
+		for _, m := range assignment.FindAllStringSubmatch(line, -1) {
+			sc.entropy(fmt.Sprintf("%s.%s", loc, m[1]), n, m[2])
+		}
+	}
+}
+
+// value checks a single value. Sensitive values are also checked for their
+// entropy.
+func (sc *scan) value(loc string, line int, v string, sensitive bool) {
+	if sc.patterns(loc, line, v) || !sensitive {
+		return
+	}
+
+	sc.entropy(loc, line, authScheme.ReplaceAllString(literal(v), ""))
+}
+
+// patterns reports the matches of the pattern rules in v and whether there
+// were any. Matches overlapping an earlier one are skipped, so a JWT sent as
+// a bearer token is reported once.
+func (sc *scan) patterns(loc string, line int, v string) bool {
+	v = variableRef.ReplaceAllString(v, " ")
+
+	var seen [][]int
+	for _, r := range sc.Rules {
+		if r.Pattern == nil {
+			continue
+		}
+
+	matches:
+		for _, m := range r.Pattern.FindAllStringIndex(v, -1) {
+			for _, s := range seen {
+				if m[0] < s[1] && s[0] < m[1] {
+					continue matches
+				}
+			}
+
+			seen = append(seen, m)
+			sc.report(r.ID, loc, line, v[m[0]:m[1]])
+		}
+	}
+
+	return len(seen) > 0
+}
+
+func (sc *scan) entropy(loc string, line int, v string) {
+	v = strings.TrimSpace(literal(v))
+	if len(v) < sc.MinLength || strings.ContainsAny(v, " \t") {
+		return
+	}
+
+	if shannon(v) >= sc.MinEntropy {
+		sc.report(HighEntropyRuleID, loc, line, v)
+	}
+}
+
+func (sc *scan) report(ruleID, loc string, line int, match string) {
+	r, ok := sc.Rule(ruleID)
+	if !ok {
+		return
+	}
+
+	sc.findings = append(sc.findings, Finding{
+		RuleID:   r.ID,
+		Message:  fmt.Sprintf("%s in %s", r.Description, loc),
+		Resource: sc.resource,
+		Location: loc,
+		Line:     line,
+		Match:    mask(match),
+	})
+}
+
+// literal returns v without references to variables.
+func literal(v string) string {
+	return strings.TrimSpace(variableRef.ReplaceAllString(v, ""))
+}
+
+// mask keeps the first four characters of a secret.
+func mask(s string) string {
+	const keep = 4
+	if len(s) <= keep {
+		return strings.Repeat("*", 8)
+	}
+
+	return s[:keep] + strings.Repeat("*", 8)
+}
+
+// shannon returns the Shannon entropy of s in bits per character.
+func shannon(s string) float64 {
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+
+	return h
+}
@@ -0,0 +1,3683 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package sdkmock
+
+import (
+	"context"
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"io"
+	"sync"
+)
+
+// Ensure, that ServiceMock does implement sdk.Interface.
+// If this is not the case, regenerate this file with moq.
+var _ sdk.Interface = &ServiceMock{}
+
+// ServiceMock is a mock implementation of sdk.Interface.
+//
+//	func TestSomethingThatUsesInterface(t *testing.T) {
+//
+//		// make and configure a mocked sdk.Interface
+//		mockedInterface := &ServiceMock{
+//			APIFunc: func(ctx context.Context, id string) (*resources.API, error) {
+//				panic("mock out the API method")
+//			},
+//			APIRelationsFunc: func(ctx context.Context, apiID string, apiVersionID string) (*resources.APIRelations, error) {
+//				panic("mock out the APIRelations method")
+//			},
+//			APIVersionFunc: func(ctx context.Context, apiID string, id string) (*resources.APIVersion, error) {
+//				panic("mock out the APIVersion method")
+//			},
+//			APIVersionsFunc: func(ctx context.Context, apiID string) (*resources.APIVersionListItems, error) {
+//				panic("mock out the APIVersions method")
+//			},
+//			APIsFunc: func(ctx context.Context, workspace string) (*resources.APIListItems, error) {
+//				panic("mock out the APIs method")
+//			},
+//			CollectionFunc: func(ctx context.Context, id string) (*resources.Collection, error) {
+//				panic("mock out the Collection method")
+//			},
+//			CollectionsFunc: func(ctx context.Context) (*resources.CollectionListItems, error) {
+//				panic("mock out the Collections method")
+//			},
+//			CreateAPIFunc: func(ctx context.Context, a *resources.API, workspace string) (*resources.API, error) {
+//				panic("mock out the CreateAPI method")
+//			},
+//			CreateAPIFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateAPIFromReader method")
+//			},
+//			CreateAPIVersionFunc: func(ctx context.Context, a *resources.APIVersion, workspace string, apiID string) (*resources.APIVersion, error) {
+//				panic("mock out the CreateAPIVersion method")
+//			},
+//			CreateAPIVersionFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string, apiID string) (*resources.Result, error) {
+//				panic("mock out the CreateAPIVersionFromReader method")
+//			},
+//			CreateCollectionFunc: func(ctx context.Context, c *resources.Collection, workspace string) (*resources.Collection, error) {
+//				panic("mock out the CreateCollection method")
+//			},
+//			CreateCollectionFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateCollectionFromReader method")
+//			},
+//			CreateEnvironmentFunc: func(ctx context.Context, e *resources.Environment, workspace string) (*resources.Environment, error) {
+//				panic("mock out the CreateEnvironment method")
+//			},
+//			CreateEnvironmentFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateEnvironmentFromReader method")
+//			},
+//			CreateFromReaderFunc: func(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams map[string]string, urlParams map[string]string) (*resources.Result, error) {
+//				panic("mock out the CreateFromReader method")
+//			},
+//			CreateMockFunc: func(ctx context.Context, m *resources.Mock, workspace string) (*resources.Mock, error) {
+//				panic("mock out the CreateMock method")
+//			},
+//			CreateMockFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateMockFromReader method")
+//			},
+//			CreateMonitorFunc: func(ctx context.Context, m *resources.Monitor, workspace string) (*resources.Monitor, error) {
+//				panic("mock out the CreateMonitor method")
+//			},
+//			CreateMonitorFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateMonitorFromReader method")
+//			},
+//			CreateSchemaFunc: func(ctx context.Context, sc *resources.Schema, workspace string, apiID string, apiVersionID string) (*resources.Schema, error) {
+//				panic("mock out the CreateSchema method")
+//			},
+//			CreateSchemaFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string, apiID string, apiVersionID string) (*resources.Result, error) {
+//				panic("mock out the CreateSchemaFromReader method")
+//			},
+//			CreateWorkspaceFunc: func(ctx context.Context, w *resources.Workspace) (*resources.Workspace, error) {
+//				panic("mock out the CreateWorkspace method")
+//			},
+//			CreateWorkspaceFromReaderFunc: func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+//				panic("mock out the CreateWorkspaceFromReader method")
+//			},
+//			DeleteFunc: func(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (*resources.Result, error) {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteAPIFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteAPI method")
+//			},
+//			DeleteAPIVersionFunc: func(ctx context.Context, resourceID string, apiID string) (*resources.Result, error) {
+//				panic("mock out the DeleteAPIVersion method")
+//			},
+//			DeleteCollectionFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteCollection method")
+//			},
+//			DeleteEnvironmentFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteEnvironment method")
+//			},
+//			DeleteMockFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteMock method")
+//			},
+//			DeleteMonitorFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteMonitor method")
+//			},
+//			DeleteSchemaFunc: func(ctx context.Context, resourceID string, apiID string, apiVersionID string) (*resources.Result, error) {
+//				panic("mock out the DeleteSchema method")
+//			},
+//			DeleteWorkspaceFunc: func(ctx context.Context, resourceID string) (*resources.Result, error) {
+//				panic("mock out the DeleteWorkspace method")
+//			},
+//			EnvironmentFunc: func(ctx context.Context, id string) (*resources.Environment, error) {
+//				panic("mock out the Environment method")
+//			},
+//			EnvironmentsFunc: func(ctx context.Context) (*resources.EnvironmentListItems, error) {
+//				panic("mock out the Environments method")
+//			},
+//			ForkCollectionFunc: func(ctx context.Context, id string, workspace string, label string) (*resources.Result, error) {
+//				panic("mock out the ForkCollection method")
+//			},
+//			FormattedAPIRelationItemsFunc: func(ctx context.Context, apiID string, apiVersionID string) (*resources.FormattedAPIRelationItems, error) {
+//				panic("mock out the FormattedAPIRelationItems method")
+//			},
+//			MergeCollectionFunc: func(ctx context.Context, id string, destination string, strategy string) (*resources.Result, error) {
+//				panic("mock out the MergeCollection method")
+//			},
+//			MockFunc: func(ctx context.Context, id string) (*resources.Mock, error) {
+//				panic("mock out the Mock method")
+//			},
+//			MocksFunc: func(ctx context.Context) (*resources.MockListItems, error) {
+//				panic("mock out the Mocks method")
+//			},
+//			MonitorFunc: func(ctx context.Context, id string) (*resources.Monitor, error) {
+//				panic("mock out the Monitor method")
+//			},
+//			MonitorsFunc: func(ctx context.Context) (*resources.MonitorListItems, error) {
+//				panic("mock out the Monitors method")
+//			},
+//			PatchFunc: func(ctx context.Context, t resources.ResourceType, urlParams map[string]string, fn patch.Func) (*resources.Result, error) {
+//				panic("mock out the Patch method")
+//			},
+//			ReplaceAPIFunc: func(ctx context.Context, resourceID string, a *resources.API) (*resources.API, error) {
+//				panic("mock out the ReplaceAPI method")
+//			},
+//			ReplaceAPIFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceAPIFromReader method")
+//			},
+//			ReplaceAPIVersionFunc: func(ctx context.Context, resourceID string, apiID string, a *resources.APIVersion) (*resources.APIVersion, error) {
+//				panic("mock out the ReplaceAPIVersion method")
+//			},
+//			ReplaceAPIVersionFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string, apiID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceAPIVersionFromReader method")
+//			},
+//			ReplaceCollectionFunc: func(ctx context.Context, resourceID string, c *resources.Collection) (*resources.Collection, error) {
+//				panic("mock out the ReplaceCollection method")
+//			},
+//			ReplaceCollectionFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceCollectionFromReader method")
+//			},
+//			ReplaceEnvironmentFunc: func(ctx context.Context, resourceID string, e *resources.Environment) (*resources.Environment, error) {
+//				panic("mock out the ReplaceEnvironment method")
+//			},
+//			ReplaceEnvironmentFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceEnvironmentFromReader method")
+//			},
+//			ReplaceFromReaderFunc: func(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (*resources.Result, error) {
+//				panic("mock out the ReplaceFromReader method")
+//			},
+//			ReplaceMockFunc: func(ctx context.Context, resourceID string, m *resources.Mock) (*resources.Mock, error) {
+//				panic("mock out the ReplaceMock method")
+//			},
+//			ReplaceMockFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceMockFromReader method")
+//			},
+//			ReplaceMonitorFunc: func(ctx context.Context, resourceID string, m *resources.Monitor) (*resources.Monitor, error) {
+//				panic("mock out the ReplaceMonitor method")
+//			},
+//			ReplaceMonitorFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceMonitorFromReader method")
+//			},
+//			ReplaceSchemaFunc: func(ctx context.Context, resourceID string, apiID string, apiVersionID string, sc *resources.Schema) (*resources.Schema, error) {
+//				panic("mock out the ReplaceSchema method")
+//			},
+//			ReplaceSchemaFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string, apiID string, apiVersionID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceSchemaFromReader method")
+//			},
+//			ReplaceWorkspaceFunc: func(ctx context.Context, resourceID string, w *resources.Workspace) (*resources.Workspace, error) {
+//				panic("mock out the ReplaceWorkspace method")
+//			},
+//			ReplaceWorkspaceFromReaderFunc: func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+//				panic("mock out the ReplaceWorkspaceFromReader method")
+//			},
+//			RunMonitorFunc: func(ctx context.Context, id string) (*resources.MonitorRun, error) {
+//				panic("mock out the RunMonitor method")
+//			},
+//			SchemaFunc: func(ctx context.Context, apiID string, apiVersionID string, id string) (*resources.Schema, error) {
+//				panic("mock out the Schema method")
+//			},
+//			UpdateEnvironmentFunc: func(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error) {
+//				panic("mock out the UpdateEnvironment method")
+//			},
+//			UserFunc: func(ctx context.Context) (*resources.User, error) {
+//				panic("mock out the User method")
+//			},
+//			WorkspaceFunc: func(ctx context.Context, id string) (*resources.Workspace, error) {
+//				panic("mock out the Workspace method")
+//			},
+//			WorkspacesFunc: func(ctx context.Context) (*resources.WorkspaceListItems, error) {
+//				panic("mock out the Workspaces method")
+//			},
+//		}
+//
+//		// use mockedInterface in code that requires sdk.Interface
+//		// and then make assertions.
+//
+//	}
+type ServiceMock struct {
+	// APIFunc mocks the API method.
+	APIFunc func(ctx context.Context, id string) (*resources.API, error)
+
+	// APIRelationsFunc mocks the APIRelations method.
+	APIRelationsFunc func(ctx context.Context, apiID string, apiVersionID string) (*resources.APIRelations, error)
+
+	// APIVersionFunc mocks the APIVersion method.
+	APIVersionFunc func(ctx context.Context, apiID string, id string) (*resources.APIVersion, error)
+
+	// APIVersionsFunc mocks the APIVersions method.
+	APIVersionsFunc func(ctx context.Context, apiID string) (*resources.APIVersionListItems, error)
+
+	// APIsFunc mocks the APIs method.
+	APIsFunc func(ctx context.Context, workspace string) (*resources.APIListItems, error)
+
+	// CollectionFunc mocks the Collection method.
+	CollectionFunc func(ctx context.Context, id string) (*resources.Collection, error)
+
+	// CollectionsFunc mocks the Collections method.
+	CollectionsFunc func(ctx context.Context) (*resources.CollectionListItems, error)
+
+	// CreateAPIFunc mocks the CreateAPI method.
+	CreateAPIFunc func(ctx context.Context, a *resources.API, workspace string) (*resources.API, error)
+
+	// CreateAPIFromReaderFunc mocks the CreateAPIFromReader method.
+	CreateAPIFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// CreateAPIVersionFunc mocks the CreateAPIVersion method.
+	CreateAPIVersionFunc func(ctx context.Context, a *resources.APIVersion, workspace string, apiID string) (*resources.APIVersion, error)
+
+	// CreateAPIVersionFromReaderFunc mocks the CreateAPIVersionFromReader method.
+	CreateAPIVersionFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string, apiID string) (*resources.Result, error)
+
+	// CreateCollectionFunc mocks the CreateCollection method.
+	CreateCollectionFunc func(ctx context.Context, c *resources.Collection, workspace string) (*resources.Collection, error)
+
+	// CreateCollectionFromReaderFunc mocks the CreateCollectionFromReader method.
+	CreateCollectionFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// CreateEnvironmentFunc mocks the CreateEnvironment method.
+	CreateEnvironmentFunc func(ctx context.Context, e *resources.Environment, workspace string) (*resources.Environment, error)
+
+	// CreateEnvironmentFromReaderFunc mocks the CreateEnvironmentFromReader method.
+	CreateEnvironmentFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// CreateFromReaderFunc mocks the CreateFromReader method.
+	CreateFromReaderFunc func(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams map[string]string, urlParams map[string]string) (*resources.Result, error)
+
+	// CreateMockFunc mocks the CreateMock method.
+	CreateMockFunc func(ctx context.Context, m *resources.Mock, workspace string) (*resources.Mock, error)
+
+	// CreateMockFromReaderFunc mocks the CreateMockFromReader method.
+	CreateMockFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// CreateMonitorFunc mocks the CreateMonitor method.
+	CreateMonitorFunc func(ctx context.Context, m *resources.Monitor, workspace string) (*resources.Monitor, error)
+
+	// CreateMonitorFromReaderFunc mocks the CreateMonitorFromReader method.
+	CreateMonitorFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// CreateSchemaFunc mocks the CreateSchema method.
+	CreateSchemaFunc func(ctx context.Context, sc *resources.Schema, workspace string, apiID string, apiVersionID string) (*resources.Schema, error)
+
+	// CreateSchemaFromReaderFunc mocks the CreateSchemaFromReader method.
+	CreateSchemaFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string, apiID string, apiVersionID string) (*resources.Result, error)
+
+	// CreateWorkspaceFunc mocks the CreateWorkspace method.
+	CreateWorkspaceFunc func(ctx context.Context, w *resources.Workspace) (*resources.Workspace, error)
+
+	// CreateWorkspaceFromReaderFunc mocks the CreateWorkspaceFromReader method.
+	CreateWorkspaceFromReaderFunc func(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (*resources.Result, error)
+
+	// DeleteAPIFunc mocks the DeleteAPI method.
+	DeleteAPIFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// DeleteAPIVersionFunc mocks the DeleteAPIVersion method.
+	DeleteAPIVersionFunc func(ctx context.Context, resourceID string, apiID string) (*resources.Result, error)
+
+	// DeleteCollectionFunc mocks the DeleteCollection method.
+	DeleteCollectionFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// DeleteEnvironmentFunc mocks the DeleteEnvironment method.
+	DeleteEnvironmentFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// DeleteMockFunc mocks the DeleteMock method.
+	DeleteMockFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// DeleteMonitorFunc mocks the DeleteMonitor method.
+	DeleteMonitorFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// DeleteSchemaFunc mocks the DeleteSchema method.
+	DeleteSchemaFunc func(ctx context.Context, resourceID string, apiID string, apiVersionID string) (*resources.Result, error)
+
+	// DeleteWorkspaceFunc mocks the DeleteWorkspace method.
+	DeleteWorkspaceFunc func(ctx context.Context, resourceID string) (*resources.Result, error)
+
+	// EnvironmentFunc mocks the Environment method.
+	EnvironmentFunc func(ctx context.Context, id string) (*resources.Environment, error)
+
+	// EnvironmentsFunc mocks the Environments method.
+	EnvironmentsFunc func(ctx context.Context) (*resources.EnvironmentListItems, error)
+
+	// ForkCollectionFunc mocks the ForkCollection method.
+	ForkCollectionFunc func(ctx context.Context, id string, workspace string, label string) (*resources.Result, error)
+
+	// FormattedAPIRelationItemsFunc mocks the FormattedAPIRelationItems method.
+	FormattedAPIRelationItemsFunc func(ctx context.Context, apiID string, apiVersionID string) (*resources.FormattedAPIRelationItems, error)
+
+	// MergeCollectionFunc mocks the MergeCollection method.
+	MergeCollectionFunc func(ctx context.Context, id string, destination string, strategy string) (*resources.Result, error)
+
+	// MockFunc mocks the Mock method.
+	MockFunc func(ctx context.Context, id string) (*resources.Mock, error)
+
+	// MocksFunc mocks the Mocks method.
+	MocksFunc func(ctx context.Context) (*resources.MockListItems, error)
+
+	// MonitorFunc mocks the Monitor method.
+	MonitorFunc func(ctx context.Context, id string) (*resources.Monitor, error)
+
+	// MonitorsFunc mocks the Monitors method.
+	MonitorsFunc func(ctx context.Context) (*resources.MonitorListItems, error)
+
+	// PatchFunc mocks the Patch method.
+	PatchFunc func(ctx context.Context, t resources.ResourceType, urlParams map[string]string, fn patch.Func) (*resources.Result, error)
+
+	// ReplaceAPIFunc mocks the ReplaceAPI method.
+	ReplaceAPIFunc func(ctx context.Context, resourceID string, a *resources.API) (*resources.API, error)
+
+	// ReplaceAPIFromReaderFunc mocks the ReplaceAPIFromReader method.
+	ReplaceAPIFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// ReplaceAPIVersionFunc mocks the ReplaceAPIVersion method.
+	ReplaceAPIVersionFunc func(ctx context.Context, resourceID string, apiID string, a *resources.APIVersion) (*resources.APIVersion, error)
+
+	// ReplaceAPIVersionFromReaderFunc mocks the ReplaceAPIVersionFromReader method.
+	ReplaceAPIVersionFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string, apiID string) (*resources.Result, error)
+
+	// ReplaceCollectionFunc mocks the ReplaceCollection method.
+	ReplaceCollectionFunc func(ctx context.Context, resourceID string, c *resources.Collection) (*resources.Collection, error)
+
+	// ReplaceCollectionFromReaderFunc mocks the ReplaceCollectionFromReader method.
+	ReplaceCollectionFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// ReplaceEnvironmentFunc mocks the ReplaceEnvironment method.
+	ReplaceEnvironmentFunc func(ctx context.Context, resourceID string, e *resources.Environment) (*resources.Environment, error)
+
+	// ReplaceEnvironmentFromReaderFunc mocks the ReplaceEnvironmentFromReader method.
+	ReplaceEnvironmentFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// ReplaceFromReaderFunc mocks the ReplaceFromReader method.
+	ReplaceFromReaderFunc func(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (*resources.Result, error)
+
+	// ReplaceMockFunc mocks the ReplaceMock method.
+	ReplaceMockFunc func(ctx context.Context, resourceID string, m *resources.Mock) (*resources.Mock, error)
+
+	// ReplaceMockFromReaderFunc mocks the ReplaceMockFromReader method.
+	ReplaceMockFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// ReplaceMonitorFunc mocks the ReplaceMonitor method.
+	ReplaceMonitorFunc func(ctx context.Context, resourceID string, m *resources.Monitor) (*resources.Monitor, error)
+
+	// ReplaceMonitorFromReaderFunc mocks the ReplaceMonitorFromReader method.
+	ReplaceMonitorFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// ReplaceSchemaFunc mocks the ReplaceSchema method.
+	ReplaceSchemaFunc func(ctx context.Context, resourceID string, apiID string, apiVersionID string, sc *resources.Schema) (*resources.Schema, error)
+
+	// ReplaceSchemaFromReaderFunc mocks the ReplaceSchemaFromReader method.
+	ReplaceSchemaFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string, apiID string, apiVersionID string) (*resources.Result, error)
+
+	// ReplaceWorkspaceFunc mocks the ReplaceWorkspace method.
+	ReplaceWorkspaceFunc func(ctx context.Context, resourceID string, w *resources.Workspace) (*resources.Workspace, error)
+
+	// ReplaceWorkspaceFromReaderFunc mocks the ReplaceWorkspaceFromReader method.
+	ReplaceWorkspaceFromReaderFunc func(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error)
+
+	// RunMonitorFunc mocks the RunMonitor method.
+	RunMonitorFunc func(ctx context.Context, id string) (*resources.MonitorRun, error)
+
+	// SchemaFunc mocks the Schema method.
+	SchemaFunc func(ctx context.Context, apiID string, apiVersionID string, id string) (*resources.Schema, error)
+
+	// UpdateEnvironmentFunc mocks the UpdateEnvironment method.
+	UpdateEnvironmentFunc func(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error)
+
+	// UserFunc mocks the User method.
+	UserFunc func(ctx context.Context) (*resources.User, error)
+
+	// WorkspaceFunc mocks the Workspace method.
+	WorkspaceFunc func(ctx context.Context, id string) (*resources.Workspace, error)
+
+	// WorkspacesFunc mocks the Workspaces method.
+	WorkspacesFunc func(ctx context.Context) (*resources.WorkspaceListItems, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// API holds details about calls to the API method.
+		API []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// APIRelations holds details about calls to the APIRelations method.
+		APIRelations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// APIVersion holds details about calls to the APIVersion method.
+		APIVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ID is the id argument value.
+			ID string
+		}
+		// APIVersions holds details about calls to the APIVersions method.
+		APIVersions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
+		// APIs holds details about calls to the APIs method.
+		APIs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// Collection holds details about calls to the Collection method.
+		Collection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Collections holds details about calls to the Collections method.
+		Collections []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// CreateAPI holds details about calls to the CreateAPI method.
+		CreateAPI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// A is the a argument value.
+			A *resources.API
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateAPIFromReader holds details about calls to the CreateAPIFromReader method.
+		CreateAPIFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateAPIVersion holds details about calls to the CreateAPIVersion method.
+		CreateAPIVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// A is the a argument value.
+			A *resources.APIVersion
+			// Workspace is the workspace argument value.
+			Workspace string
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
+		// CreateAPIVersionFromReader holds details about calls to the CreateAPIVersionFromReader method.
+		CreateAPIVersionFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
+		// CreateCollection holds details about calls to the CreateCollection method.
+		CreateCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C *resources.Collection
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateCollectionFromReader holds details about calls to the CreateCollectionFromReader method.
+		CreateCollectionFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateEnvironment holds details about calls to the CreateEnvironment method.
+		CreateEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// E is the e argument value.
+			E *resources.Environment
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateEnvironmentFromReader holds details about calls to the CreateEnvironmentFromReader method.
+		CreateEnvironmentFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateFromReader holds details about calls to the CreateFromReader method.
+		CreateFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T resources.ResourceType
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// QueryParams is the queryParams argument value.
+			QueryParams map[string]string
+			// UrlParams is the urlParams argument value.
+			UrlParams map[string]string
+		}
+		// CreateMock holds details about calls to the CreateMock method.
+		CreateMock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// M is the m argument value.
+			M *resources.Mock
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateMockFromReader holds details about calls to the CreateMockFromReader method.
+		CreateMockFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateMonitor holds details about calls to the CreateMonitor method.
+		CreateMonitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// M is the m argument value.
+			M *resources.Monitor
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateMonitorFromReader holds details about calls to the CreateMonitorFromReader method.
+		CreateMonitorFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// CreateSchema holds details about calls to the CreateSchema method.
+		CreateSchema []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sc is the sc argument value.
+			Sc *resources.Schema
+			// Workspace is the workspace argument value.
+			Workspace string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// CreateSchemaFromReader holds details about calls to the CreateSchemaFromReader method.
+		CreateSchemaFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// CreateWorkspace holds details about calls to the CreateWorkspace method.
+		CreateWorkspace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// W is the w argument value.
+			W *resources.Workspace
+		}
+		// CreateWorkspaceFromReader holds details about calls to the CreateWorkspaceFromReader method.
+		CreateWorkspaceFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// Workspace is the workspace argument value.
+			Workspace string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T resources.ResourceType
+			// UrlParams is the urlParams argument value.
+			UrlParams map[string]string
+		}
+		// DeleteAPI holds details about calls to the DeleteAPI method.
+		DeleteAPI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// DeleteAPIVersion holds details about calls to the DeleteAPIVersion method.
+		DeleteAPIVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
+		// DeleteCollection holds details about calls to the DeleteCollection method.
+		DeleteCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// DeleteEnvironment holds details about calls to the DeleteEnvironment method.
+		DeleteEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// DeleteMock holds details about calls to the DeleteMock method.
+		DeleteMock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// DeleteMonitor holds details about calls to the DeleteMonitor method.
+		DeleteMonitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// DeleteSchema holds details about calls to the DeleteSchema method.
+		DeleteSchema []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// DeleteWorkspace holds details about calls to the DeleteWorkspace method.
+		DeleteWorkspace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// Environment holds details about calls to the Environment method.
+		Environment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Environments holds details about calls to the Environments method.
+		Environments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ForkCollection holds details about calls to the ForkCollection method.
+		ForkCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Workspace is the workspace argument value.
+			Workspace string
+			// Label is the label argument value.
+			Label string
+		}
+		// FormattedAPIRelationItems holds details about calls to the FormattedAPIRelationItems method.
+		FormattedAPIRelationItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// MergeCollection holds details about calls to the MergeCollection method.
+		MergeCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Destination is the destination argument value.
+			Destination string
+			// Strategy is the strategy argument value.
+			Strategy string
+		}
+		// Mock holds details about calls to the Mock method.
+		Mock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Mocks holds details about calls to the Mocks method.
+		Mocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Monitor holds details about calls to the Monitor method.
+		Monitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Monitors holds details about calls to the Monitors method.
+		Monitors []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Patch holds details about calls to the Patch method.
+		Patch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T resources.ResourceType
+			// UrlParams is the urlParams argument value.
+			UrlParams map[string]string
+			// Fn is the fn argument value.
+			Fn patch.Func
+		}
+		// ReplaceAPI holds details about calls to the ReplaceAPI method.
+		ReplaceAPI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// A is the a argument value.
+			A *resources.API
+		}
+		// ReplaceAPIFromReader holds details about calls to the ReplaceAPIFromReader method.
+		ReplaceAPIFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ReplaceAPIVersion holds details about calls to the ReplaceAPIVersion method.
+		ReplaceAPIVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// A is the a argument value.
+			A *resources.APIVersion
+		}
+		// ReplaceAPIVersionFromReader holds details about calls to the ReplaceAPIVersionFromReader method.
+		ReplaceAPIVersionFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
+		// ReplaceCollection holds details about calls to the ReplaceCollection method.
+		ReplaceCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// C is the c argument value.
+			C *resources.Collection
+		}
+		// ReplaceCollectionFromReader holds details about calls to the ReplaceCollectionFromReader method.
+		ReplaceCollectionFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ReplaceEnvironment holds details about calls to the ReplaceEnvironment method.
+		ReplaceEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// E is the e argument value.
+			E *resources.Environment
+		}
+		// ReplaceEnvironmentFromReader holds details about calls to the ReplaceEnvironmentFromReader method.
+		ReplaceEnvironmentFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ReplaceFromReader holds details about calls to the ReplaceFromReader method.
+		ReplaceFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// T is the t argument value.
+			T resources.ResourceType
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// UrlParams is the urlParams argument value.
+			UrlParams map[string]string
+		}
+		// ReplaceMock holds details about calls to the ReplaceMock method.
+		ReplaceMock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// M is the m argument value.
+			M *resources.Mock
+		}
+		// ReplaceMockFromReader holds details about calls to the ReplaceMockFromReader method.
+		ReplaceMockFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ReplaceMonitor holds details about calls to the ReplaceMonitor method.
+		ReplaceMonitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// M is the m argument value.
+			M *resources.Monitor
+		}
+		// ReplaceMonitorFromReader holds details about calls to the ReplaceMonitorFromReader method.
+		ReplaceMonitorFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ReplaceSchema holds details about calls to the ReplaceSchema method.
+		ReplaceSchema []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+			// Sc is the sc argument value.
+			Sc *resources.Schema
+		}
+		// ReplaceSchemaFromReader holds details about calls to the ReplaceSchemaFromReader method.
+		ReplaceSchemaFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+		}
+		// ReplaceWorkspace holds details about calls to the ReplaceWorkspace method.
+		ReplaceWorkspace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// W is the w argument value.
+			W *resources.Workspace
+		}
+		// ReplaceWorkspaceFromReader holds details about calls to the ReplaceWorkspaceFromReader method.
+		ReplaceWorkspaceFromReader []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reader is the reader argument value.
+			Reader io.Reader
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// RunMonitor holds details about calls to the RunMonitor method.
+		RunMonitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Schema holds details about calls to the Schema method.
+		Schema []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// ApiVersionID is the apiVersionID argument value.
+			ApiVersionID string
+			// ID is the id argument value.
+			ID string
+		}
+		// UpdateEnvironment holds details about calls to the UpdateEnvironment method.
+		UpdateEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// Fn is the fn argument value.
+			Fn func(*resources.Environment) error
+		}
+		// User holds details about calls to the User method.
+		User []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Workspace holds details about calls to the Workspace method.
+		Workspace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// Workspaces holds details about calls to the Workspaces method.
+		Workspaces []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockAPI                          sync.RWMutex
+	lockAPIRelations                 sync.RWMutex
+	lockAPIVersion                   sync.RWMutex
+	lockAPIVersions                  sync.RWMutex
+	lockAPIs                         sync.RWMutex
+	lockCollection                   sync.RWMutex
+	lockCollections                  sync.RWMutex
+	lockCreateAPI                    sync.RWMutex
+	lockCreateAPIFromReader          sync.RWMutex
+	lockCreateAPIVersion             sync.RWMutex
+	lockCreateAPIVersionFromReader   sync.RWMutex
+	lockCreateCollection             sync.RWMutex
+	lockCreateCollectionFromReader   sync.RWMutex
+	lockCreateEnvironment            sync.RWMutex
+	lockCreateEnvironmentFromReader  sync.RWMutex
+	lockCreateFromReader             sync.RWMutex
+	lockCreateMock                   sync.RWMutex
+	lockCreateMockFromReader         sync.RWMutex
+	lockCreateMonitor                sync.RWMutex
+	lockCreateMonitorFromReader      sync.RWMutex
+	lockCreateSchema                 sync.RWMutex
+	lockCreateSchemaFromReader       sync.RWMutex
+	lockCreateWorkspace              sync.RWMutex
+	lockCreateWorkspaceFromReader    sync.RWMutex
+	lockDelete                       sync.RWMutex
+	lockDeleteAPI                    sync.RWMutex
+	lockDeleteAPIVersion             sync.RWMutex
+	lockDeleteCollection             sync.RWMutex
+	lockDeleteEnvironment            sync.RWMutex
+	lockDeleteMock                   sync.RWMutex
+	lockDeleteMonitor                sync.RWMutex
+	lockDeleteSchema                 sync.RWMutex
+	lockDeleteWorkspace              sync.RWMutex
+	lockEnvironment                  sync.RWMutex
+	lockEnvironments                 sync.RWMutex
+	lockForkCollection               sync.RWMutex
+	lockFormattedAPIRelationItems    sync.RWMutex
+	lockMergeCollection              sync.RWMutex
+	lockMock                         sync.RWMutex
+	lockMocks                        sync.RWMutex
+	lockMonitor                      sync.RWMutex
+	lockMonitors                     sync.RWMutex
+	lockPatch                        sync.RWMutex
+	lockReplaceAPI                   sync.RWMutex
+	lockReplaceAPIFromReader         sync.RWMutex
+	lockReplaceAPIVersion            sync.RWMutex
+	lockReplaceAPIVersionFromReader  sync.RWMutex
+	lockReplaceCollection            sync.RWMutex
+	lockReplaceCollectionFromReader  sync.RWMutex
+	lockReplaceEnvironment           sync.RWMutex
+	lockReplaceEnvironmentFromReader sync.RWMutex
+	lockReplaceFromReader            sync.RWMutex
+	lockReplaceMock                  sync.RWMutex
+	lockReplaceMockFromReader        sync.RWMutex
+	lockReplaceMonitor               sync.RWMutex
+	lockReplaceMonitorFromReader     sync.RWMutex
+	lockReplaceSchema                sync.RWMutex
+	lockReplaceSchemaFromReader      sync.RWMutex
+	lockReplaceWorkspace             sync.RWMutex
+	lockReplaceWorkspaceFromReader   sync.RWMutex
+	lockRunMonitor                   sync.RWMutex
+	lockSchema                       sync.RWMutex
+	lockUpdateEnvironment            sync.RWMutex
+	lockUser                         sync.RWMutex
+	lockWorkspace                    sync.RWMutex
+	lockWorkspaces                   sync.RWMutex
+}
+
+// API calls APIFunc.
+func (mock *ServiceMock) API(ctx context.Context, id string) (*resources.API, error) {
+	if mock.APIFunc == nil {
+		panic("ServiceMock.APIFunc: method is nil but Interface.API was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockAPI.Lock()
+	mock.calls.API = append(mock.calls.API, callInfo)
+	mock.lockAPI.Unlock()
+	return mock.APIFunc(ctx, id)
+}
+
+// APICalls gets all the calls that were made to API.
+// Check the length with:
+//
+//	len(mockedInterface.APICalls())
+func (mock *ServiceMock) APICalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockAPI.RLock()
+	calls = mock.calls.API
+	mock.lockAPI.RUnlock()
+	return calls
+}
+
+// APIRelations calls APIRelationsFunc.
+func (mock *ServiceMock) APIRelations(ctx context.Context, apiID string, apiVersionID string) (*resources.APIRelations, error) {
+	if mock.APIRelationsFunc == nil {
+		panic("ServiceMock.APIRelationsFunc: method is nil but Interface.APIRelations was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockAPIRelations.Lock()
+	mock.calls.APIRelations = append(mock.calls.APIRelations, callInfo)
+	mock.lockAPIRelations.Unlock()
+	return mock.APIRelationsFunc(ctx, apiID, apiVersionID)
+}
+
+// APIRelationsCalls gets all the calls that were made to APIRelations.
+// Check the length with:
+//
+//	len(mockedInterface.APIRelationsCalls())
+func (mock *ServiceMock) APIRelationsCalls() []struct {
+	Ctx          context.Context
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockAPIRelations.RLock()
+	calls = mock.calls.APIRelations
+	mock.lockAPIRelations.RUnlock()
+	return calls
+}
+
+// APIVersion calls APIVersionFunc.
+func (mock *ServiceMock) APIVersion(ctx context.Context, apiID string, id string) (*resources.APIVersion, error) {
+	if mock.APIVersionFunc == nil {
+		panic("ServiceMock.APIVersionFunc: method is nil but Interface.APIVersion was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ApiID string
+		ID    string
+	}{
+		Ctx:   ctx,
+		ApiID: apiID,
+		ID:    id,
+	}
+	mock.lockAPIVersion.Lock()
+	mock.calls.APIVersion = append(mock.calls.APIVersion, callInfo)
+	mock.lockAPIVersion.Unlock()
+	return mock.APIVersionFunc(ctx, apiID, id)
+}
+
+// APIVersionCalls gets all the calls that were made to APIVersion.
+// Check the length with:
+//
+//	len(mockedInterface.APIVersionCalls())
+func (mock *ServiceMock) APIVersionCalls() []struct {
+	Ctx   context.Context
+	ApiID string
+	ID    string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ApiID string
+		ID    string
+	}
+	mock.lockAPIVersion.RLock()
+	calls = mock.calls.APIVersion
+	mock.lockAPIVersion.RUnlock()
+	return calls
+}
+
+// APIVersions calls APIVersionsFunc.
+func (mock *ServiceMock) APIVersions(ctx context.Context, apiID string) (*resources.APIVersionListItems, error) {
+	if mock.APIVersionsFunc == nil {
+		panic("ServiceMock.APIVersionsFunc: method is nil but Interface.APIVersions was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ApiID string
+	}{
+		Ctx:   ctx,
+		ApiID: apiID,
+	}
+	mock.lockAPIVersions.Lock()
+	mock.calls.APIVersions = append(mock.calls.APIVersions, callInfo)
+	mock.lockAPIVersions.Unlock()
+	return mock.APIVersionsFunc(ctx, apiID)
+}
+
+// APIVersionsCalls gets all the calls that were made to APIVersions.
+// Check the length with:
+//
+//	len(mockedInterface.APIVersionsCalls())
+func (mock *ServiceMock) APIVersionsCalls() []struct {
+	Ctx   context.Context
+	ApiID string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ApiID string
+	}
+	mock.lockAPIVersions.RLock()
+	calls = mock.calls.APIVersions
+	mock.lockAPIVersions.RUnlock()
+	return calls
+}
+
+// APIs calls APIsFunc.
+func (mock *ServiceMock) APIs(ctx context.Context, workspace string) (*resources.APIListItems, error) {
+	if mock.APIsFunc == nil {
+		panic("ServiceMock.APIsFunc: method is nil but Interface.APIs was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Workspace: workspace,
+	}
+	mock.lockAPIs.Lock()
+	mock.calls.APIs = append(mock.calls.APIs, callInfo)
+	mock.lockAPIs.Unlock()
+	return mock.APIsFunc(ctx, workspace)
+}
+
+// APIsCalls gets all the calls that were made to APIs.
+// Check the length with:
+//
+//	len(mockedInterface.APIsCalls())
+func (mock *ServiceMock) APIsCalls() []struct {
+	Ctx       context.Context
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Workspace string
+	}
+	mock.lockAPIs.RLock()
+	calls = mock.calls.APIs
+	mock.lockAPIs.RUnlock()
+	return calls
+}
+
+// Collection calls CollectionFunc.
+func (mock *ServiceMock) Collection(ctx context.Context, id string) (*resources.Collection, error) {
+	if mock.CollectionFunc == nil {
+		panic("ServiceMock.CollectionFunc: method is nil but Interface.Collection was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCollection.Lock()
+	mock.calls.Collection = append(mock.calls.Collection, callInfo)
+	mock.lockCollection.Unlock()
+	return mock.CollectionFunc(ctx, id)
+}
+
+// CollectionCalls gets all the calls that were made to Collection.
+// Check the length with:
+//
+//	len(mockedInterface.CollectionCalls())
+func (mock *ServiceMock) CollectionCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockCollection.RLock()
+	calls = mock.calls.Collection
+	mock.lockCollection.RUnlock()
+	return calls
+}
+
+// Collections calls CollectionsFunc.
+func (mock *ServiceMock) Collections(ctx context.Context) (*resources.CollectionListItems, error) {
+	if mock.CollectionsFunc == nil {
+		panic("ServiceMock.CollectionsFunc: method is nil but Interface.Collections was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCollections.Lock()
+	mock.calls.Collections = append(mock.calls.Collections, callInfo)
+	mock.lockCollections.Unlock()
+	return mock.CollectionsFunc(ctx)
+}
+
+// CollectionsCalls gets all the calls that were made to Collections.
+// Check the length with:
+//
+//	len(mockedInterface.CollectionsCalls())
+func (mock *ServiceMock) CollectionsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCollections.RLock()
+	calls = mock.calls.Collections
+	mock.lockCollections.RUnlock()
+	return calls
+}
+
+// CreateAPI calls CreateAPIFunc.
+func (mock *ServiceMock) CreateAPI(ctx context.Context, a *resources.API, workspace string) (*resources.API, error) {
+	if mock.CreateAPIFunc == nil {
+		panic("ServiceMock.CreateAPIFunc: method is nil but Interface.CreateAPI was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		A         *resources.API
+		Workspace string
+	}{
+		Ctx:       ctx,
+		A:         a,
+		Workspace: workspace,
+	}
+	mock.lockCreateAPI.Lock()
+	mock.calls.CreateAPI = append(mock.calls.CreateAPI, callInfo)
+	mock.lockCreateAPI.Unlock()
+	return mock.CreateAPIFunc(ctx, a, workspace)
+}
+
+// CreateAPICalls gets all the calls that were made to CreateAPI.
+// Check the length with:
+//
+//	len(mockedInterface.CreateAPICalls())
+func (mock *ServiceMock) CreateAPICalls() []struct {
+	Ctx       context.Context
+	A         *resources.API
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		A         *resources.API
+		Workspace string
+	}
+	mock.lockCreateAPI.RLock()
+	calls = mock.calls.CreateAPI
+	mock.lockCreateAPI.RUnlock()
+	return calls
+}
+
+// CreateAPIFromReader calls CreateAPIFromReaderFunc.
+func (mock *ServiceMock) CreateAPIFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateAPIFromReaderFunc == nil {
+		panic("ServiceMock.CreateAPIFromReaderFunc: method is nil but Interface.CreateAPIFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateAPIFromReader.Lock()
+	mock.calls.CreateAPIFromReader = append(mock.calls.CreateAPIFromReader, callInfo)
+	mock.lockCreateAPIFromReader.Unlock()
+	return mock.CreateAPIFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateAPIFromReaderCalls gets all the calls that were made to CreateAPIFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateAPIFromReaderCalls())
+func (mock *ServiceMock) CreateAPIFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateAPIFromReader.RLock()
+	calls = mock.calls.CreateAPIFromReader
+	mock.lockCreateAPIFromReader.RUnlock()
+	return calls
+}
+
+// CreateAPIVersion calls CreateAPIVersionFunc.
+func (mock *ServiceMock) CreateAPIVersion(ctx context.Context, a *resources.APIVersion, workspace string, apiID string) (*resources.APIVersion, error) {
+	if mock.CreateAPIVersionFunc == nil {
+		panic("ServiceMock.CreateAPIVersionFunc: method is nil but Interface.CreateAPIVersion was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		A         *resources.APIVersion
+		Workspace string
+		ApiID     string
+	}{
+		Ctx:       ctx,
+		A:         a,
+		Workspace: workspace,
+		ApiID:     apiID,
+	}
+	mock.lockCreateAPIVersion.Lock()
+	mock.calls.CreateAPIVersion = append(mock.calls.CreateAPIVersion, callInfo)
+	mock.lockCreateAPIVersion.Unlock()
+	return mock.CreateAPIVersionFunc(ctx, a, workspace, apiID)
+}
+
+// CreateAPIVersionCalls gets all the calls that were made to CreateAPIVersion.
+// Check the length with:
+//
+//	len(mockedInterface.CreateAPIVersionCalls())
+func (mock *ServiceMock) CreateAPIVersionCalls() []struct {
+	Ctx       context.Context
+	A         *resources.APIVersion
+	Workspace string
+	ApiID     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		A         *resources.APIVersion
+		Workspace string
+		ApiID     string
+	}
+	mock.lockCreateAPIVersion.RLock()
+	calls = mock.calls.CreateAPIVersion
+	mock.lockCreateAPIVersion.RUnlock()
+	return calls
+}
+
+// CreateAPIVersionFromReader calls CreateAPIVersionFromReaderFunc.
+func (mock *ServiceMock) CreateAPIVersionFromReader(ctx context.Context, reader io.Reader, workspace string, apiID string) (*resources.Result, error) {
+	if mock.CreateAPIVersionFromReaderFunc == nil {
+		panic("ServiceMock.CreateAPIVersionFromReaderFunc: method is nil but Interface.CreateAPIVersionFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+		ApiID     string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+		ApiID:     apiID,
+	}
+	mock.lockCreateAPIVersionFromReader.Lock()
+	mock.calls.CreateAPIVersionFromReader = append(mock.calls.CreateAPIVersionFromReader, callInfo)
+	mock.lockCreateAPIVersionFromReader.Unlock()
+	return mock.CreateAPIVersionFromReaderFunc(ctx, reader, workspace, apiID)
+}
+
+// CreateAPIVersionFromReaderCalls gets all the calls that were made to CreateAPIVersionFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateAPIVersionFromReaderCalls())
+func (mock *ServiceMock) CreateAPIVersionFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+	ApiID     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+		ApiID     string
+	}
+	mock.lockCreateAPIVersionFromReader.RLock()
+	calls = mock.calls.CreateAPIVersionFromReader
+	mock.lockCreateAPIVersionFromReader.RUnlock()
+	return calls
+}
+
+// CreateCollection calls CreateCollectionFunc.
+func (mock *ServiceMock) CreateCollection(ctx context.Context, c *resources.Collection, workspace string) (*resources.Collection, error) {
+	if mock.CreateCollectionFunc == nil {
+		panic("ServiceMock.CreateCollectionFunc: method is nil but Interface.CreateCollection was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		C         *resources.Collection
+		Workspace string
+	}{
+		Ctx:       ctx,
+		C:         c,
+		Workspace: workspace,
+	}
+	mock.lockCreateCollection.Lock()
+	mock.calls.CreateCollection = append(mock.calls.CreateCollection, callInfo)
+	mock.lockCreateCollection.Unlock()
+	return mock.CreateCollectionFunc(ctx, c, workspace)
+}
+
+// CreateCollectionCalls gets all the calls that were made to CreateCollection.
+// Check the length with:
+//
+//	len(mockedInterface.CreateCollectionCalls())
+func (mock *ServiceMock) CreateCollectionCalls() []struct {
+	Ctx       context.Context
+	C         *resources.Collection
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		C         *resources.Collection
+		Workspace string
+	}
+	mock.lockCreateCollection.RLock()
+	calls = mock.calls.CreateCollection
+	mock.lockCreateCollection.RUnlock()
+	return calls
+}
+
+// CreateCollectionFromReader calls CreateCollectionFromReaderFunc.
+func (mock *ServiceMock) CreateCollectionFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateCollectionFromReaderFunc == nil {
+		panic("ServiceMock.CreateCollectionFromReaderFunc: method is nil but Interface.CreateCollectionFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateCollectionFromReader.Lock()
+	mock.calls.CreateCollectionFromReader = append(mock.calls.CreateCollectionFromReader, callInfo)
+	mock.lockCreateCollectionFromReader.Unlock()
+	return mock.CreateCollectionFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateCollectionFromReaderCalls gets all the calls that were made to CreateCollectionFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateCollectionFromReaderCalls())
+func (mock *ServiceMock) CreateCollectionFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateCollectionFromReader.RLock()
+	calls = mock.calls.CreateCollectionFromReader
+	mock.lockCreateCollectionFromReader.RUnlock()
+	return calls
+}
+
+// CreateEnvironment calls CreateEnvironmentFunc.
+func (mock *ServiceMock) CreateEnvironment(ctx context.Context, e *resources.Environment, workspace string) (*resources.Environment, error) {
+	if mock.CreateEnvironmentFunc == nil {
+		panic("ServiceMock.CreateEnvironmentFunc: method is nil but Interface.CreateEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		E         *resources.Environment
+		Workspace string
+	}{
+		Ctx:       ctx,
+		E:         e,
+		Workspace: workspace,
+	}
+	mock.lockCreateEnvironment.Lock()
+	mock.calls.CreateEnvironment = append(mock.calls.CreateEnvironment, callInfo)
+	mock.lockCreateEnvironment.Unlock()
+	return mock.CreateEnvironmentFunc(ctx, e, workspace)
+}
+
+// CreateEnvironmentCalls gets all the calls that were made to CreateEnvironment.
+// Check the length with:
+//
+//	len(mockedInterface.CreateEnvironmentCalls())
+func (mock *ServiceMock) CreateEnvironmentCalls() []struct {
+	Ctx       context.Context
+	E         *resources.Environment
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		E         *resources.Environment
+		Workspace string
+	}
+	mock.lockCreateEnvironment.RLock()
+	calls = mock.calls.CreateEnvironment
+	mock.lockCreateEnvironment.RUnlock()
+	return calls
+}
+
+// CreateEnvironmentFromReader calls CreateEnvironmentFromReaderFunc.
+func (mock *ServiceMock) CreateEnvironmentFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateEnvironmentFromReaderFunc == nil {
+		panic("ServiceMock.CreateEnvironmentFromReaderFunc: method is nil but Interface.CreateEnvironmentFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateEnvironmentFromReader.Lock()
+	mock.calls.CreateEnvironmentFromReader = append(mock.calls.CreateEnvironmentFromReader, callInfo)
+	mock.lockCreateEnvironmentFromReader.Unlock()
+	return mock.CreateEnvironmentFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateEnvironmentFromReaderCalls gets all the calls that were made to CreateEnvironmentFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateEnvironmentFromReaderCalls())
+func (mock *ServiceMock) CreateEnvironmentFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateEnvironmentFromReader.RLock()
+	calls = mock.calls.CreateEnvironmentFromReader
+	mock.lockCreateEnvironmentFromReader.RUnlock()
+	return calls
+}
+
+// CreateFromReader calls CreateFromReaderFunc.
+func (mock *ServiceMock) CreateFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams map[string]string, urlParams map[string]string) (*resources.Result, error) {
+	if mock.CreateFromReaderFunc == nil {
+		panic("ServiceMock.CreateFromReaderFunc: method is nil but Interface.CreateFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		T           resources.ResourceType
+		Reader      io.Reader
+		QueryParams map[string]string
+		UrlParams   map[string]string
+	}{
+		Ctx:         ctx,
+		T:           t,
+		Reader:      reader,
+		QueryParams: queryParams,
+		UrlParams:   urlParams,
+	}
+	mock.lockCreateFromReader.Lock()
+	mock.calls.CreateFromReader = append(mock.calls.CreateFromReader, callInfo)
+	mock.lockCreateFromReader.Unlock()
+	return mock.CreateFromReaderFunc(ctx, t, reader, queryParams, urlParams)
+}
+
+// CreateFromReaderCalls gets all the calls that were made to CreateFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateFromReaderCalls())
+func (mock *ServiceMock) CreateFromReaderCalls() []struct {
+	Ctx         context.Context
+	T           resources.ResourceType
+	Reader      io.Reader
+	QueryParams map[string]string
+	UrlParams   map[string]string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		T           resources.ResourceType
+		Reader      io.Reader
+		QueryParams map[string]string
+		UrlParams   map[string]string
+	}
+	mock.lockCreateFromReader.RLock()
+	calls = mock.calls.CreateFromReader
+	mock.lockCreateFromReader.RUnlock()
+	return calls
+}
+
+// CreateMock calls CreateMockFunc.
+func (mock *ServiceMock) CreateMock(ctx context.Context, m *resources.Mock, workspace string) (*resources.Mock, error) {
+	if mock.CreateMockFunc == nil {
+		panic("ServiceMock.CreateMockFunc: method is nil but Interface.CreateMock was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		M         *resources.Mock
+		Workspace string
+	}{
+		Ctx:       ctx,
+		M:         m,
+		Workspace: workspace,
+	}
+	mock.lockCreateMock.Lock()
+	mock.calls.CreateMock = append(mock.calls.CreateMock, callInfo)
+	mock.lockCreateMock.Unlock()
+	return mock.CreateMockFunc(ctx, m, workspace)
+}
+
+// CreateMockCalls gets all the calls that were made to CreateMock.
+// Check the length with:
+//
+//	len(mockedInterface.CreateMockCalls())
+func (mock *ServiceMock) CreateMockCalls() []struct {
+	Ctx       context.Context
+	M         *resources.Mock
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		M         *resources.Mock
+		Workspace string
+	}
+	mock.lockCreateMock.RLock()
+	calls = mock.calls.CreateMock
+	mock.lockCreateMock.RUnlock()
+	return calls
+}
+
+// CreateMockFromReader calls CreateMockFromReaderFunc.
+func (mock *ServiceMock) CreateMockFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateMockFromReaderFunc == nil {
+		panic("ServiceMock.CreateMockFromReaderFunc: method is nil but Interface.CreateMockFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateMockFromReader.Lock()
+	mock.calls.CreateMockFromReader = append(mock.calls.CreateMockFromReader, callInfo)
+	mock.lockCreateMockFromReader.Unlock()
+	return mock.CreateMockFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateMockFromReaderCalls gets all the calls that were made to CreateMockFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateMockFromReaderCalls())
+func (mock *ServiceMock) CreateMockFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateMockFromReader.RLock()
+	calls = mock.calls.CreateMockFromReader
+	mock.lockCreateMockFromReader.RUnlock()
+	return calls
+}
+
+// CreateMonitor calls CreateMonitorFunc.
+func (mock *ServiceMock) CreateMonitor(ctx context.Context, m *resources.Monitor, workspace string) (*resources.Monitor, error) {
+	if mock.CreateMonitorFunc == nil {
+		panic("ServiceMock.CreateMonitorFunc: method is nil but Interface.CreateMonitor was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		M         *resources.Monitor
+		Workspace string
+	}{
+		Ctx:       ctx,
+		M:         m,
+		Workspace: workspace,
+	}
+	mock.lockCreateMonitor.Lock()
+	mock.calls.CreateMonitor = append(mock.calls.CreateMonitor, callInfo)
+	mock.lockCreateMonitor.Unlock()
+	return mock.CreateMonitorFunc(ctx, m, workspace)
+}
+
+// CreateMonitorCalls gets all the calls that were made to CreateMonitor.
+// Check the length with:
+//
+//	len(mockedInterface.CreateMonitorCalls())
+func (mock *ServiceMock) CreateMonitorCalls() []struct {
+	Ctx       context.Context
+	M         *resources.Monitor
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		M         *resources.Monitor
+		Workspace string
+	}
+	mock.lockCreateMonitor.RLock()
+	calls = mock.calls.CreateMonitor
+	mock.lockCreateMonitor.RUnlock()
+	return calls
+}
+
+// CreateMonitorFromReader calls CreateMonitorFromReaderFunc.
+func (mock *ServiceMock) CreateMonitorFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateMonitorFromReaderFunc == nil {
+		panic("ServiceMock.CreateMonitorFromReaderFunc: method is nil but Interface.CreateMonitorFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateMonitorFromReader.Lock()
+	mock.calls.CreateMonitorFromReader = append(mock.calls.CreateMonitorFromReader, callInfo)
+	mock.lockCreateMonitorFromReader.Unlock()
+	return mock.CreateMonitorFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateMonitorFromReaderCalls gets all the calls that were made to CreateMonitorFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateMonitorFromReaderCalls())
+func (mock *ServiceMock) CreateMonitorFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateMonitorFromReader.RLock()
+	calls = mock.calls.CreateMonitorFromReader
+	mock.lockCreateMonitorFromReader.RUnlock()
+	return calls
+}
+
+// CreateSchema calls CreateSchemaFunc.
+func (mock *ServiceMock) CreateSchema(ctx context.Context, sc *resources.Schema, workspace string, apiID string, apiVersionID string) (*resources.Schema, error) {
+	if mock.CreateSchemaFunc == nil {
+		panic("ServiceMock.CreateSchemaFunc: method is nil but Interface.CreateSchema was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Sc           *resources.Schema
+		Workspace    string
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		Sc:           sc,
+		Workspace:    workspace,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockCreateSchema.Lock()
+	mock.calls.CreateSchema = append(mock.calls.CreateSchema, callInfo)
+	mock.lockCreateSchema.Unlock()
+	return mock.CreateSchemaFunc(ctx, sc, workspace, apiID, apiVersionID)
+}
+
+// CreateSchemaCalls gets all the calls that were made to CreateSchema.
+// Check the length with:
+//
+//	len(mockedInterface.CreateSchemaCalls())
+func (mock *ServiceMock) CreateSchemaCalls() []struct {
+	Ctx          context.Context
+	Sc           *resources.Schema
+	Workspace    string
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Sc           *resources.Schema
+		Workspace    string
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockCreateSchema.RLock()
+	calls = mock.calls.CreateSchema
+	mock.lockCreateSchema.RUnlock()
+	return calls
+}
+
+// CreateSchemaFromReader calls CreateSchemaFromReaderFunc.
+func (mock *ServiceMock) CreateSchemaFromReader(ctx context.Context, reader io.Reader, workspace string, apiID string, apiVersionID string) (*resources.Result, error) {
+	if mock.CreateSchemaFromReaderFunc == nil {
+		panic("ServiceMock.CreateSchemaFromReaderFunc: method is nil but Interface.CreateSchemaFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Reader       io.Reader
+		Workspace    string
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		Reader:       reader,
+		Workspace:    workspace,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockCreateSchemaFromReader.Lock()
+	mock.calls.CreateSchemaFromReader = append(mock.calls.CreateSchemaFromReader, callInfo)
+	mock.lockCreateSchemaFromReader.Unlock()
+	return mock.CreateSchemaFromReaderFunc(ctx, reader, workspace, apiID, apiVersionID)
+}
+
+// CreateSchemaFromReaderCalls gets all the calls that were made to CreateSchemaFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateSchemaFromReaderCalls())
+func (mock *ServiceMock) CreateSchemaFromReaderCalls() []struct {
+	Ctx          context.Context
+	Reader       io.Reader
+	Workspace    string
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Reader       io.Reader
+		Workspace    string
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockCreateSchemaFromReader.RLock()
+	calls = mock.calls.CreateSchemaFromReader
+	mock.lockCreateSchemaFromReader.RUnlock()
+	return calls
+}
+
+// CreateWorkspace calls CreateWorkspaceFunc.
+func (mock *ServiceMock) CreateWorkspace(ctx context.Context, w *resources.Workspace) (*resources.Workspace, error) {
+	if mock.CreateWorkspaceFunc == nil {
+		panic("ServiceMock.CreateWorkspaceFunc: method is nil but Interface.CreateWorkspace was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		W   *resources.Workspace
+	}{
+		Ctx: ctx,
+		W:   w,
+	}
+	mock.lockCreateWorkspace.Lock()
+	mock.calls.CreateWorkspace = append(mock.calls.CreateWorkspace, callInfo)
+	mock.lockCreateWorkspace.Unlock()
+	return mock.CreateWorkspaceFunc(ctx, w)
+}
+
+// CreateWorkspaceCalls gets all the calls that were made to CreateWorkspace.
+// Check the length with:
+//
+//	len(mockedInterface.CreateWorkspaceCalls())
+func (mock *ServiceMock) CreateWorkspaceCalls() []struct {
+	Ctx context.Context
+	W   *resources.Workspace
+} {
+	var calls []struct {
+		Ctx context.Context
+		W   *resources.Workspace
+	}
+	mock.lockCreateWorkspace.RLock()
+	calls = mock.calls.CreateWorkspace
+	mock.lockCreateWorkspace.RUnlock()
+	return calls
+}
+
+// CreateWorkspaceFromReader calls CreateWorkspaceFromReaderFunc.
+func (mock *ServiceMock) CreateWorkspaceFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	if mock.CreateWorkspaceFromReaderFunc == nil {
+		panic("ServiceMock.CreateWorkspaceFromReaderFunc: method is nil but Interface.CreateWorkspaceFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}{
+		Ctx:       ctx,
+		Reader:    reader,
+		Workspace: workspace,
+	}
+	mock.lockCreateWorkspaceFromReader.Lock()
+	mock.calls.CreateWorkspaceFromReader = append(mock.calls.CreateWorkspaceFromReader, callInfo)
+	mock.lockCreateWorkspaceFromReader.Unlock()
+	return mock.CreateWorkspaceFromReaderFunc(ctx, reader, workspace)
+}
+
+// CreateWorkspaceFromReaderCalls gets all the calls that were made to CreateWorkspaceFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.CreateWorkspaceFromReaderCalls())
+func (mock *ServiceMock) CreateWorkspaceFromReaderCalls() []struct {
+	Ctx       context.Context
+	Reader    io.Reader
+	Workspace string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Reader    io.Reader
+		Workspace string
+	}
+	mock.lockCreateWorkspaceFromReader.RLock()
+	calls = mock.calls.CreateWorkspaceFromReader
+	mock.lockCreateWorkspaceFromReader.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ServiceMock) Delete(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (*resources.Result, error) {
+	if mock.DeleteFunc == nil {
+		panic("ServiceMock.DeleteFunc: method is nil but Interface.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		UrlParams map[string]string
+	}{
+		Ctx:       ctx,
+		T:         t,
+		UrlParams: urlParams,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, t, urlParams)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteCalls())
+func (mock *ServiceMock) DeleteCalls() []struct {
+	Ctx       context.Context
+	T         resources.ResourceType
+	UrlParams map[string]string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		UrlParams map[string]string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// DeleteAPI calls DeleteAPIFunc.
+func (mock *ServiceMock) DeleteAPI(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteAPIFunc == nil {
+		panic("ServiceMock.DeleteAPIFunc: method is nil but Interface.DeleteAPI was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteAPI.Lock()
+	mock.calls.DeleteAPI = append(mock.calls.DeleteAPI, callInfo)
+	mock.lockDeleteAPI.Unlock()
+	return mock.DeleteAPIFunc(ctx, resourceID)
+}
+
+// DeleteAPICalls gets all the calls that were made to DeleteAPI.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteAPICalls())
+func (mock *ServiceMock) DeleteAPICalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteAPI.RLock()
+	calls = mock.calls.DeleteAPI
+	mock.lockDeleteAPI.RUnlock()
+	return calls
+}
+
+// DeleteAPIVersion calls DeleteAPIVersionFunc.
+func (mock *ServiceMock) DeleteAPIVersion(ctx context.Context, resourceID string, apiID string) (*resources.Result, error) {
+	if mock.DeleteAPIVersionFunc == nil {
+		panic("ServiceMock.DeleteAPIVersionFunc: method is nil but Interface.DeleteAPIVersion was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		ApiID      string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		ApiID:      apiID,
+	}
+	mock.lockDeleteAPIVersion.Lock()
+	mock.calls.DeleteAPIVersion = append(mock.calls.DeleteAPIVersion, callInfo)
+	mock.lockDeleteAPIVersion.Unlock()
+	return mock.DeleteAPIVersionFunc(ctx, resourceID, apiID)
+}
+
+// DeleteAPIVersionCalls gets all the calls that were made to DeleteAPIVersion.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteAPIVersionCalls())
+func (mock *ServiceMock) DeleteAPIVersionCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	ApiID      string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		ApiID      string
+	}
+	mock.lockDeleteAPIVersion.RLock()
+	calls = mock.calls.DeleteAPIVersion
+	mock.lockDeleteAPIVersion.RUnlock()
+	return calls
+}
+
+// DeleteCollection calls DeleteCollectionFunc.
+func (mock *ServiceMock) DeleteCollection(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteCollectionFunc == nil {
+		panic("ServiceMock.DeleteCollectionFunc: method is nil but Interface.DeleteCollection was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteCollection.Lock()
+	mock.calls.DeleteCollection = append(mock.calls.DeleteCollection, callInfo)
+	mock.lockDeleteCollection.Unlock()
+	return mock.DeleteCollectionFunc(ctx, resourceID)
+}
+
+// DeleteCollectionCalls gets all the calls that were made to DeleteCollection.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteCollectionCalls())
+func (mock *ServiceMock) DeleteCollectionCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteCollection.RLock()
+	calls = mock.calls.DeleteCollection
+	mock.lockDeleteCollection.RUnlock()
+	return calls
+}
+
+// DeleteEnvironment calls DeleteEnvironmentFunc.
+func (mock *ServiceMock) DeleteEnvironment(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteEnvironmentFunc == nil {
+		panic("ServiceMock.DeleteEnvironmentFunc: method is nil but Interface.DeleteEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteEnvironment.Lock()
+	mock.calls.DeleteEnvironment = append(mock.calls.DeleteEnvironment, callInfo)
+	mock.lockDeleteEnvironment.Unlock()
+	return mock.DeleteEnvironmentFunc(ctx, resourceID)
+}
+
+// DeleteEnvironmentCalls gets all the calls that were made to DeleteEnvironment.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteEnvironmentCalls())
+func (mock *ServiceMock) DeleteEnvironmentCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteEnvironment.RLock()
+	calls = mock.calls.DeleteEnvironment
+	mock.lockDeleteEnvironment.RUnlock()
+	return calls
+}
+
+// DeleteMock calls DeleteMockFunc.
+func (mock *ServiceMock) DeleteMock(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteMockFunc == nil {
+		panic("ServiceMock.DeleteMockFunc: method is nil but Interface.DeleteMock was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteMock.Lock()
+	mock.calls.DeleteMock = append(mock.calls.DeleteMock, callInfo)
+	mock.lockDeleteMock.Unlock()
+	return mock.DeleteMockFunc(ctx, resourceID)
+}
+
+// DeleteMockCalls gets all the calls that were made to DeleteMock.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteMockCalls())
+func (mock *ServiceMock) DeleteMockCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteMock.RLock()
+	calls = mock.calls.DeleteMock
+	mock.lockDeleteMock.RUnlock()
+	return calls
+}
+
+// DeleteMonitor calls DeleteMonitorFunc.
+func (mock *ServiceMock) DeleteMonitor(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteMonitorFunc == nil {
+		panic("ServiceMock.DeleteMonitorFunc: method is nil but Interface.DeleteMonitor was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteMonitor.Lock()
+	mock.calls.DeleteMonitor = append(mock.calls.DeleteMonitor, callInfo)
+	mock.lockDeleteMonitor.Unlock()
+	return mock.DeleteMonitorFunc(ctx, resourceID)
+}
+
+// DeleteMonitorCalls gets all the calls that were made to DeleteMonitor.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteMonitorCalls())
+func (mock *ServiceMock) DeleteMonitorCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteMonitor.RLock()
+	calls = mock.calls.DeleteMonitor
+	mock.lockDeleteMonitor.RUnlock()
+	return calls
+}
+
+// DeleteSchema calls DeleteSchemaFunc.
+func (mock *ServiceMock) DeleteSchema(ctx context.Context, resourceID string, apiID string, apiVersionID string) (*resources.Result, error) {
+	if mock.DeleteSchemaFunc == nil {
+		panic("ServiceMock.DeleteSchemaFunc: method is nil but Interface.DeleteSchema was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		ResourceID:   resourceID,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockDeleteSchema.Lock()
+	mock.calls.DeleteSchema = append(mock.calls.DeleteSchema, callInfo)
+	mock.lockDeleteSchema.Unlock()
+	return mock.DeleteSchemaFunc(ctx, resourceID, apiID, apiVersionID)
+}
+
+// DeleteSchemaCalls gets all the calls that were made to DeleteSchema.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteSchemaCalls())
+func (mock *ServiceMock) DeleteSchemaCalls() []struct {
+	Ctx          context.Context
+	ResourceID   string
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockDeleteSchema.RLock()
+	calls = mock.calls.DeleteSchema
+	mock.lockDeleteSchema.RUnlock()
+	return calls
+}
+
+// DeleteWorkspace calls DeleteWorkspaceFunc.
+func (mock *ServiceMock) DeleteWorkspace(ctx context.Context, resourceID string) (*resources.Result, error) {
+	if mock.DeleteWorkspaceFunc == nil {
+		panic("ServiceMock.DeleteWorkspaceFunc: method is nil but Interface.DeleteWorkspace was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteWorkspace.Lock()
+	mock.calls.DeleteWorkspace = append(mock.calls.DeleteWorkspace, callInfo)
+	mock.lockDeleteWorkspace.Unlock()
+	return mock.DeleteWorkspaceFunc(ctx, resourceID)
+}
+
+// DeleteWorkspaceCalls gets all the calls that were made to DeleteWorkspace.
+// Check the length with:
+//
+//	len(mockedInterface.DeleteWorkspaceCalls())
+func (mock *ServiceMock) DeleteWorkspaceCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteWorkspace.RLock()
+	calls = mock.calls.DeleteWorkspace
+	mock.lockDeleteWorkspace.RUnlock()
+	return calls
+}
+
+// Environment calls EnvironmentFunc.
+func (mock *ServiceMock) Environment(ctx context.Context, id string) (*resources.Environment, error) {
+	if mock.EnvironmentFunc == nil {
+		panic("ServiceMock.EnvironmentFunc: method is nil but Interface.Environment was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockEnvironment.Lock()
+	mock.calls.Environment = append(mock.calls.Environment, callInfo)
+	mock.lockEnvironment.Unlock()
+	return mock.EnvironmentFunc(ctx, id)
+}
+
+// EnvironmentCalls gets all the calls that were made to Environment.
+// Check the length with:
+//
+//	len(mockedInterface.EnvironmentCalls())
+func (mock *ServiceMock) EnvironmentCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockEnvironment.RLock()
+	calls = mock.calls.Environment
+	mock.lockEnvironment.RUnlock()
+	return calls
+}
+
+// Environments calls EnvironmentsFunc.
+func (mock *ServiceMock) Environments(ctx context.Context) (*resources.EnvironmentListItems, error) {
+	if mock.EnvironmentsFunc == nil {
+		panic("ServiceMock.EnvironmentsFunc: method is nil but Interface.Environments was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockEnvironments.Lock()
+	mock.calls.Environments = append(mock.calls.Environments, callInfo)
+	mock.lockEnvironments.Unlock()
+	return mock.EnvironmentsFunc(ctx)
+}
+
+// EnvironmentsCalls gets all the calls that were made to Environments.
+// Check the length with:
+//
+//	len(mockedInterface.EnvironmentsCalls())
+func (mock *ServiceMock) EnvironmentsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockEnvironments.RLock()
+	calls = mock.calls.Environments
+	mock.lockEnvironments.RUnlock()
+	return calls
+}
+
+// ForkCollection calls ForkCollectionFunc.
+func (mock *ServiceMock) ForkCollection(ctx context.Context, id string, workspace string, label string) (*resources.Result, error) {
+	if mock.ForkCollectionFunc == nil {
+		panic("ServiceMock.ForkCollectionFunc: method is nil but Interface.ForkCollection was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ID        string
+		Workspace string
+		Label     string
+	}{
+		Ctx:       ctx,
+		ID:        id,
+		Workspace: workspace,
+		Label:     label,
+	}
+	mock.lockForkCollection.Lock()
+	mock.calls.ForkCollection = append(mock.calls.ForkCollection, callInfo)
+	mock.lockForkCollection.Unlock()
+	return mock.ForkCollectionFunc(ctx, id, workspace, label)
+}
+
+// ForkCollectionCalls gets all the calls that were made to ForkCollection.
+// Check the length with:
+//
+//	len(mockedInterface.ForkCollectionCalls())
+func (mock *ServiceMock) ForkCollectionCalls() []struct {
+	Ctx       context.Context
+	ID        string
+	Workspace string
+	Label     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ID        string
+		Workspace string
+		Label     string
+	}
+	mock.lockForkCollection.RLock()
+	calls = mock.calls.ForkCollection
+	mock.lockForkCollection.RUnlock()
+	return calls
+}
+
+// FormattedAPIRelationItems calls FormattedAPIRelationItemsFunc.
+func (mock *ServiceMock) FormattedAPIRelationItems(ctx context.Context, apiID string, apiVersionID string) (*resources.FormattedAPIRelationItems, error) {
+	if mock.FormattedAPIRelationItemsFunc == nil {
+		panic("ServiceMock.FormattedAPIRelationItemsFunc: method is nil but Interface.FormattedAPIRelationItems was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockFormattedAPIRelationItems.Lock()
+	mock.calls.FormattedAPIRelationItems = append(mock.calls.FormattedAPIRelationItems, callInfo)
+	mock.lockFormattedAPIRelationItems.Unlock()
+	return mock.FormattedAPIRelationItemsFunc(ctx, apiID, apiVersionID)
+}
+
+// FormattedAPIRelationItemsCalls gets all the calls that were made to FormattedAPIRelationItems.
+// Check the length with:
+//
+//	len(mockedInterface.FormattedAPIRelationItemsCalls())
+func (mock *ServiceMock) FormattedAPIRelationItemsCalls() []struct {
+	Ctx          context.Context
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockFormattedAPIRelationItems.RLock()
+	calls = mock.calls.FormattedAPIRelationItems
+	mock.lockFormattedAPIRelationItems.RUnlock()
+	return calls
+}
+
+// MergeCollection calls MergeCollectionFunc.
+func (mock *ServiceMock) MergeCollection(ctx context.Context, id string, destination string, strategy string) (*resources.Result, error) {
+	if mock.MergeCollectionFunc == nil {
+		panic("ServiceMock.MergeCollectionFunc: method is nil but Interface.MergeCollection was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ID          string
+		Destination string
+		Strategy    string
+	}{
+		Ctx:         ctx,
+		ID:          id,
+		Destination: destination,
+		Strategy:    strategy,
+	}
+	mock.lockMergeCollection.Lock()
+	mock.calls.MergeCollection = append(mock.calls.MergeCollection, callInfo)
+	mock.lockMergeCollection.Unlock()
+	return mock.MergeCollectionFunc(ctx, id, destination, strategy)
+}
+
+// MergeCollectionCalls gets all the calls that were made to MergeCollection.
+// Check the length with:
+//
+//	len(mockedInterface.MergeCollectionCalls())
+func (mock *ServiceMock) MergeCollectionCalls() []struct {
+	Ctx         context.Context
+	ID          string
+	Destination string
+	Strategy    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ID          string
+		Destination string
+		Strategy    string
+	}
+	mock.lockMergeCollection.RLock()
+	calls = mock.calls.MergeCollection
+	mock.lockMergeCollection.RUnlock()
+	return calls
+}
+
+// Mock calls MockFunc.
+func (mock *ServiceMock) Mock(ctx context.Context, id string) (*resources.Mock, error) {
+	if mock.MockFunc == nil {
+		panic("ServiceMock.MockFunc: method is nil but Interface.Mock was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMock.Lock()
+	mock.calls.Mock = append(mock.calls.Mock, callInfo)
+	mock.lockMock.Unlock()
+	return mock.MockFunc(ctx, id)
+}
+
+// MockCalls gets all the calls that were made to Mock.
+// Check the length with:
+//
+//	len(mockedInterface.MockCalls())
+func (mock *ServiceMock) MockCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockMock.RLock()
+	calls = mock.calls.Mock
+	mock.lockMock.RUnlock()
+	return calls
+}
+
+// Mocks calls MocksFunc.
+func (mock *ServiceMock) Mocks(ctx context.Context) (*resources.MockListItems, error) {
+	if mock.MocksFunc == nil {
+		panic("ServiceMock.MocksFunc: method is nil but Interface.Mocks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockMocks.Lock()
+	mock.calls.Mocks = append(mock.calls.Mocks, callInfo)
+	mock.lockMocks.Unlock()
+	return mock.MocksFunc(ctx)
+}
+
+// MocksCalls gets all the calls that were made to Mocks.
+// Check the length with:
+//
+//	len(mockedInterface.MocksCalls())
+func (mock *ServiceMock) MocksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockMocks.RLock()
+	calls = mock.calls.Mocks
+	mock.lockMocks.RUnlock()
+	return calls
+}
+
+// Monitor calls MonitorFunc.
+func (mock *ServiceMock) Monitor(ctx context.Context, id string) (*resources.Monitor, error) {
+	if mock.MonitorFunc == nil {
+		panic("ServiceMock.MonitorFunc: method is nil but Interface.Monitor was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMonitor.Lock()
+	mock.calls.Monitor = append(mock.calls.Monitor, callInfo)
+	mock.lockMonitor.Unlock()
+	return mock.MonitorFunc(ctx, id)
+}
+
+// MonitorCalls gets all the calls that were made to Monitor.
+// Check the length with:
+//
+//	len(mockedInterface.MonitorCalls())
+func (mock *ServiceMock) MonitorCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockMonitor.RLock()
+	calls = mock.calls.Monitor
+	mock.lockMonitor.RUnlock()
+	return calls
+}
+
+// Monitors calls MonitorsFunc.
+func (mock *ServiceMock) Monitors(ctx context.Context) (*resources.MonitorListItems, error) {
+	if mock.MonitorsFunc == nil {
+		panic("ServiceMock.MonitorsFunc: method is nil but Interface.Monitors was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockMonitors.Lock()
+	mock.calls.Monitors = append(mock.calls.Monitors, callInfo)
+	mock.lockMonitors.Unlock()
+	return mock.MonitorsFunc(ctx)
+}
+
+// MonitorsCalls gets all the calls that were made to Monitors.
+// Check the length with:
+//
+//	len(mockedInterface.MonitorsCalls())
+func (mock *ServiceMock) MonitorsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockMonitors.RLock()
+	calls = mock.calls.Monitors
+	mock.lockMonitors.RUnlock()
+	return calls
+}
+
+// Patch calls PatchFunc.
+func (mock *ServiceMock) Patch(ctx context.Context, t resources.ResourceType, urlParams map[string]string, fn patch.Func) (*resources.Result, error) {
+	if mock.PatchFunc == nil {
+		panic("ServiceMock.PatchFunc: method is nil but Interface.Patch was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		UrlParams map[string]string
+		Fn        patch.Func
+	}{
+		Ctx:       ctx,
+		T:         t,
+		UrlParams: urlParams,
+		Fn:        fn,
+	}
+	mock.lockPatch.Lock()
+	mock.calls.Patch = append(mock.calls.Patch, callInfo)
+	mock.lockPatch.Unlock()
+	return mock.PatchFunc(ctx, t, urlParams, fn)
+}
+
+// PatchCalls gets all the calls that were made to Patch.
+// Check the length with:
+//
+//	len(mockedInterface.PatchCalls())
+func (mock *ServiceMock) PatchCalls() []struct {
+	Ctx       context.Context
+	T         resources.ResourceType
+	UrlParams map[string]string
+	Fn        patch.Func
+} {
+	var calls []struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		UrlParams map[string]string
+		Fn        patch.Func
+	}
+	mock.lockPatch.RLock()
+	calls = mock.calls.Patch
+	mock.lockPatch.RUnlock()
+	return calls
+}
+
+// ReplaceAPI calls ReplaceAPIFunc.
+func (mock *ServiceMock) ReplaceAPI(ctx context.Context, resourceID string, a *resources.API) (*resources.API, error) {
+	if mock.ReplaceAPIFunc == nil {
+		panic("ServiceMock.ReplaceAPIFunc: method is nil but Interface.ReplaceAPI was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		A          *resources.API
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		A:          a,
+	}
+	mock.lockReplaceAPI.Lock()
+	mock.calls.ReplaceAPI = append(mock.calls.ReplaceAPI, callInfo)
+	mock.lockReplaceAPI.Unlock()
+	return mock.ReplaceAPIFunc(ctx, resourceID, a)
+}
+
+// ReplaceAPICalls gets all the calls that were made to ReplaceAPI.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceAPICalls())
+func (mock *ServiceMock) ReplaceAPICalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	A          *resources.API
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		A          *resources.API
+	}
+	mock.lockReplaceAPI.RLock()
+	calls = mock.calls.ReplaceAPI
+	mock.lockReplaceAPI.RUnlock()
+	return calls
+}
+
+// ReplaceAPIFromReader calls ReplaceAPIFromReaderFunc.
+func (mock *ServiceMock) ReplaceAPIFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceAPIFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceAPIFromReaderFunc: method is nil but Interface.ReplaceAPIFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceAPIFromReader.Lock()
+	mock.calls.ReplaceAPIFromReader = append(mock.calls.ReplaceAPIFromReader, callInfo)
+	mock.lockReplaceAPIFromReader.Unlock()
+	return mock.ReplaceAPIFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceAPIFromReaderCalls gets all the calls that were made to ReplaceAPIFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceAPIFromReaderCalls())
+func (mock *ServiceMock) ReplaceAPIFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceAPIFromReader.RLock()
+	calls = mock.calls.ReplaceAPIFromReader
+	mock.lockReplaceAPIFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceAPIVersion calls ReplaceAPIVersionFunc.
+func (mock *ServiceMock) ReplaceAPIVersion(ctx context.Context, resourceID string, apiID string, a *resources.APIVersion) (*resources.APIVersion, error) {
+	if mock.ReplaceAPIVersionFunc == nil {
+		panic("ServiceMock.ReplaceAPIVersionFunc: method is nil but Interface.ReplaceAPIVersion was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		ApiID      string
+		A          *resources.APIVersion
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		ApiID:      apiID,
+		A:          a,
+	}
+	mock.lockReplaceAPIVersion.Lock()
+	mock.calls.ReplaceAPIVersion = append(mock.calls.ReplaceAPIVersion, callInfo)
+	mock.lockReplaceAPIVersion.Unlock()
+	return mock.ReplaceAPIVersionFunc(ctx, resourceID, apiID, a)
+}
+
+// ReplaceAPIVersionCalls gets all the calls that were made to ReplaceAPIVersion.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceAPIVersionCalls())
+func (mock *ServiceMock) ReplaceAPIVersionCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	ApiID      string
+	A          *resources.APIVersion
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		ApiID      string
+		A          *resources.APIVersion
+	}
+	mock.lockReplaceAPIVersion.RLock()
+	calls = mock.calls.ReplaceAPIVersion
+	mock.lockReplaceAPIVersion.RUnlock()
+	return calls
+}
+
+// ReplaceAPIVersionFromReader calls ReplaceAPIVersionFromReaderFunc.
+func (mock *ServiceMock) ReplaceAPIVersionFromReader(ctx context.Context, reader io.Reader, resourceID string, apiID string) (*resources.Result, error) {
+	if mock.ReplaceAPIVersionFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceAPIVersionFromReaderFunc: method is nil but Interface.ReplaceAPIVersionFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+		ApiID      string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+		ApiID:      apiID,
+	}
+	mock.lockReplaceAPIVersionFromReader.Lock()
+	mock.calls.ReplaceAPIVersionFromReader = append(mock.calls.ReplaceAPIVersionFromReader, callInfo)
+	mock.lockReplaceAPIVersionFromReader.Unlock()
+	return mock.ReplaceAPIVersionFromReaderFunc(ctx, reader, resourceID, apiID)
+}
+
+// ReplaceAPIVersionFromReaderCalls gets all the calls that were made to ReplaceAPIVersionFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceAPIVersionFromReaderCalls())
+func (mock *ServiceMock) ReplaceAPIVersionFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+	ApiID      string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+		ApiID      string
+	}
+	mock.lockReplaceAPIVersionFromReader.RLock()
+	calls = mock.calls.ReplaceAPIVersionFromReader
+	mock.lockReplaceAPIVersionFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceCollection calls ReplaceCollectionFunc.
+func (mock *ServiceMock) ReplaceCollection(ctx context.Context, resourceID string, c *resources.Collection) (*resources.Collection, error) {
+	if mock.ReplaceCollectionFunc == nil {
+		panic("ServiceMock.ReplaceCollectionFunc: method is nil but Interface.ReplaceCollection was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		C          *resources.Collection
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		C:          c,
+	}
+	mock.lockReplaceCollection.Lock()
+	mock.calls.ReplaceCollection = append(mock.calls.ReplaceCollection, callInfo)
+	mock.lockReplaceCollection.Unlock()
+	return mock.ReplaceCollectionFunc(ctx, resourceID, c)
+}
+
+// ReplaceCollectionCalls gets all the calls that were made to ReplaceCollection.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceCollectionCalls())
+func (mock *ServiceMock) ReplaceCollectionCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	C          *resources.Collection
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		C          *resources.Collection
+	}
+	mock.lockReplaceCollection.RLock()
+	calls = mock.calls.ReplaceCollection
+	mock.lockReplaceCollection.RUnlock()
+	return calls
+}
+
+// ReplaceCollectionFromReader calls ReplaceCollectionFromReaderFunc.
+func (mock *ServiceMock) ReplaceCollectionFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceCollectionFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceCollectionFromReaderFunc: method is nil but Interface.ReplaceCollectionFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceCollectionFromReader.Lock()
+	mock.calls.ReplaceCollectionFromReader = append(mock.calls.ReplaceCollectionFromReader, callInfo)
+	mock.lockReplaceCollectionFromReader.Unlock()
+	return mock.ReplaceCollectionFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceCollectionFromReaderCalls gets all the calls that were made to ReplaceCollectionFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceCollectionFromReaderCalls())
+func (mock *ServiceMock) ReplaceCollectionFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceCollectionFromReader.RLock()
+	calls = mock.calls.ReplaceCollectionFromReader
+	mock.lockReplaceCollectionFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceEnvironment calls ReplaceEnvironmentFunc.
+func (mock *ServiceMock) ReplaceEnvironment(ctx context.Context, resourceID string, e *resources.Environment) (*resources.Environment, error) {
+	if mock.ReplaceEnvironmentFunc == nil {
+		panic("ServiceMock.ReplaceEnvironmentFunc: method is nil but Interface.ReplaceEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		E          *resources.Environment
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		E:          e,
+	}
+	mock.lockReplaceEnvironment.Lock()
+	mock.calls.ReplaceEnvironment = append(mock.calls.ReplaceEnvironment, callInfo)
+	mock.lockReplaceEnvironment.Unlock()
+	return mock.ReplaceEnvironmentFunc(ctx, resourceID, e)
+}
+
+// ReplaceEnvironmentCalls gets all the calls that were made to ReplaceEnvironment.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceEnvironmentCalls())
+func (mock *ServiceMock) ReplaceEnvironmentCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	E          *resources.Environment
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		E          *resources.Environment
+	}
+	mock.lockReplaceEnvironment.RLock()
+	calls = mock.calls.ReplaceEnvironment
+	mock.lockReplaceEnvironment.RUnlock()
+	return calls
+}
+
+// ReplaceEnvironmentFromReader calls ReplaceEnvironmentFromReaderFunc.
+func (mock *ServiceMock) ReplaceEnvironmentFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceEnvironmentFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceEnvironmentFromReaderFunc: method is nil but Interface.ReplaceEnvironmentFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceEnvironmentFromReader.Lock()
+	mock.calls.ReplaceEnvironmentFromReader = append(mock.calls.ReplaceEnvironmentFromReader, callInfo)
+	mock.lockReplaceEnvironmentFromReader.Unlock()
+	return mock.ReplaceEnvironmentFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceEnvironmentFromReaderCalls gets all the calls that were made to ReplaceEnvironmentFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceEnvironmentFromReaderCalls())
+func (mock *ServiceMock) ReplaceEnvironmentFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceEnvironmentFromReader.RLock()
+	calls = mock.calls.ReplaceEnvironmentFromReader
+	mock.lockReplaceEnvironmentFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceFromReader calls ReplaceFromReaderFunc.
+func (mock *ServiceMock) ReplaceFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (*resources.Result, error) {
+	if mock.ReplaceFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceFromReaderFunc: method is nil but Interface.ReplaceFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		Reader    io.Reader
+		UrlParams map[string]string
+	}{
+		Ctx:       ctx,
+		T:         t,
+		Reader:    reader,
+		UrlParams: urlParams,
+	}
+	mock.lockReplaceFromReader.Lock()
+	mock.calls.ReplaceFromReader = append(mock.calls.ReplaceFromReader, callInfo)
+	mock.lockReplaceFromReader.Unlock()
+	return mock.ReplaceFromReaderFunc(ctx, t, reader, urlParams)
+}
+
+// ReplaceFromReaderCalls gets all the calls that were made to ReplaceFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceFromReaderCalls())
+func (mock *ServiceMock) ReplaceFromReaderCalls() []struct {
+	Ctx       context.Context
+	T         resources.ResourceType
+	Reader    io.Reader
+	UrlParams map[string]string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		T         resources.ResourceType
+		Reader    io.Reader
+		UrlParams map[string]string
+	}
+	mock.lockReplaceFromReader.RLock()
+	calls = mock.calls.ReplaceFromReader
+	mock.lockReplaceFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceMock calls ReplaceMockFunc.
+func (mock *ServiceMock) ReplaceMock(ctx context.Context, resourceID string, m *resources.Mock) (*resources.Mock, error) {
+	if mock.ReplaceMockFunc == nil {
+		panic("ServiceMock.ReplaceMockFunc: method is nil but Interface.ReplaceMock was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		M          *resources.Mock
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		M:          m,
+	}
+	mock.lockReplaceMock.Lock()
+	mock.calls.ReplaceMock = append(mock.calls.ReplaceMock, callInfo)
+	mock.lockReplaceMock.Unlock()
+	return mock.ReplaceMockFunc(ctx, resourceID, m)
+}
+
+// ReplaceMockCalls gets all the calls that were made to ReplaceMock.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceMockCalls())
+func (mock *ServiceMock) ReplaceMockCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	M          *resources.Mock
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		M          *resources.Mock
+	}
+	mock.lockReplaceMock.RLock()
+	calls = mock.calls.ReplaceMock
+	mock.lockReplaceMock.RUnlock()
+	return calls
+}
+
+// ReplaceMockFromReader calls ReplaceMockFromReaderFunc.
+func (mock *ServiceMock) ReplaceMockFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceMockFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceMockFromReaderFunc: method is nil but Interface.ReplaceMockFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceMockFromReader.Lock()
+	mock.calls.ReplaceMockFromReader = append(mock.calls.ReplaceMockFromReader, callInfo)
+	mock.lockReplaceMockFromReader.Unlock()
+	return mock.ReplaceMockFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceMockFromReaderCalls gets all the calls that were made to ReplaceMockFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceMockFromReaderCalls())
+func (mock *ServiceMock) ReplaceMockFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceMockFromReader.RLock()
+	calls = mock.calls.ReplaceMockFromReader
+	mock.lockReplaceMockFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceMonitor calls ReplaceMonitorFunc.
+func (mock *ServiceMock) ReplaceMonitor(ctx context.Context, resourceID string, m *resources.Monitor) (*resources.Monitor, error) {
+	if mock.ReplaceMonitorFunc == nil {
+		panic("ServiceMock.ReplaceMonitorFunc: method is nil but Interface.ReplaceMonitor was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		M          *resources.Monitor
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		M:          m,
+	}
+	mock.lockReplaceMonitor.Lock()
+	mock.calls.ReplaceMonitor = append(mock.calls.ReplaceMonitor, callInfo)
+	mock.lockReplaceMonitor.Unlock()
+	return mock.ReplaceMonitorFunc(ctx, resourceID, m)
+}
+
+// ReplaceMonitorCalls gets all the calls that were made to ReplaceMonitor.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceMonitorCalls())
+func (mock *ServiceMock) ReplaceMonitorCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	M          *resources.Monitor
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		M          *resources.Monitor
+	}
+	mock.lockReplaceMonitor.RLock()
+	calls = mock.calls.ReplaceMonitor
+	mock.lockReplaceMonitor.RUnlock()
+	return calls
+}
+
+// ReplaceMonitorFromReader calls ReplaceMonitorFromReaderFunc.
+func (mock *ServiceMock) ReplaceMonitorFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceMonitorFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceMonitorFromReaderFunc: method is nil but Interface.ReplaceMonitorFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceMonitorFromReader.Lock()
+	mock.calls.ReplaceMonitorFromReader = append(mock.calls.ReplaceMonitorFromReader, callInfo)
+	mock.lockReplaceMonitorFromReader.Unlock()
+	return mock.ReplaceMonitorFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceMonitorFromReaderCalls gets all the calls that were made to ReplaceMonitorFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceMonitorFromReaderCalls())
+func (mock *ServiceMock) ReplaceMonitorFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceMonitorFromReader.RLock()
+	calls = mock.calls.ReplaceMonitorFromReader
+	mock.lockReplaceMonitorFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceSchema calls ReplaceSchemaFunc.
+func (mock *ServiceMock) ReplaceSchema(ctx context.Context, resourceID string, apiID string, apiVersionID string, sc *resources.Schema) (*resources.Schema, error) {
+	if mock.ReplaceSchemaFunc == nil {
+		panic("ServiceMock.ReplaceSchemaFunc: method is nil but Interface.ReplaceSchema was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+		Sc           *resources.Schema
+	}{
+		Ctx:          ctx,
+		ResourceID:   resourceID,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+		Sc:           sc,
+	}
+	mock.lockReplaceSchema.Lock()
+	mock.calls.ReplaceSchema = append(mock.calls.ReplaceSchema, callInfo)
+	mock.lockReplaceSchema.Unlock()
+	return mock.ReplaceSchemaFunc(ctx, resourceID, apiID, apiVersionID, sc)
+}
+
+// ReplaceSchemaCalls gets all the calls that were made to ReplaceSchema.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceSchemaCalls())
+func (mock *ServiceMock) ReplaceSchemaCalls() []struct {
+	Ctx          context.Context
+	ResourceID   string
+	ApiID        string
+	ApiVersionID string
+	Sc           *resources.Schema
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+		Sc           *resources.Schema
+	}
+	mock.lockReplaceSchema.RLock()
+	calls = mock.calls.ReplaceSchema
+	mock.lockReplaceSchema.RUnlock()
+	return calls
+}
+
+// ReplaceSchemaFromReader calls ReplaceSchemaFromReaderFunc.
+func (mock *ServiceMock) ReplaceSchemaFromReader(ctx context.Context, reader io.Reader, resourceID string, apiID string, apiVersionID string) (*resources.Result, error) {
+	if mock.ReplaceSchemaFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceSchemaFromReaderFunc: method is nil but Interface.ReplaceSchemaFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Reader       io.Reader
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+	}{
+		Ctx:          ctx,
+		Reader:       reader,
+		ResourceID:   resourceID,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+	}
+	mock.lockReplaceSchemaFromReader.Lock()
+	mock.calls.ReplaceSchemaFromReader = append(mock.calls.ReplaceSchemaFromReader, callInfo)
+	mock.lockReplaceSchemaFromReader.Unlock()
+	return mock.ReplaceSchemaFromReaderFunc(ctx, reader, resourceID, apiID, apiVersionID)
+}
+
+// ReplaceSchemaFromReaderCalls gets all the calls that were made to ReplaceSchemaFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceSchemaFromReaderCalls())
+func (mock *ServiceMock) ReplaceSchemaFromReaderCalls() []struct {
+	Ctx          context.Context
+	Reader       io.Reader
+	ResourceID   string
+	ApiID        string
+	ApiVersionID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Reader       io.Reader
+		ResourceID   string
+		ApiID        string
+		ApiVersionID string
+	}
+	mock.lockReplaceSchemaFromReader.RLock()
+	calls = mock.calls.ReplaceSchemaFromReader
+	mock.lockReplaceSchemaFromReader.RUnlock()
+	return calls
+}
+
+// ReplaceWorkspace calls ReplaceWorkspaceFunc.
+func (mock *ServiceMock) ReplaceWorkspace(ctx context.Context, resourceID string, w *resources.Workspace) (*resources.Workspace, error) {
+	if mock.ReplaceWorkspaceFunc == nil {
+		panic("ServiceMock.ReplaceWorkspaceFunc: method is nil but Interface.ReplaceWorkspace was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		W          *resources.Workspace
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		W:          w,
+	}
+	mock.lockReplaceWorkspace.Lock()
+	mock.calls.ReplaceWorkspace = append(mock.calls.ReplaceWorkspace, callInfo)
+	mock.lockReplaceWorkspace.Unlock()
+	return mock.ReplaceWorkspaceFunc(ctx, resourceID, w)
+}
+
+// ReplaceWorkspaceCalls gets all the calls that were made to ReplaceWorkspace.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceWorkspaceCalls())
+func (mock *ServiceMock) ReplaceWorkspaceCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	W          *resources.Workspace
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		W          *resources.Workspace
+	}
+	mock.lockReplaceWorkspace.RLock()
+	calls = mock.calls.ReplaceWorkspace
+	mock.lockReplaceWorkspace.RUnlock()
+	return calls
+}
+
+// ReplaceWorkspaceFromReader calls ReplaceWorkspaceFromReaderFunc.
+func (mock *ServiceMock) ReplaceWorkspaceFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	if mock.ReplaceWorkspaceFromReaderFunc == nil {
+		panic("ServiceMock.ReplaceWorkspaceFromReaderFunc: method is nil but Interface.ReplaceWorkspaceFromReader was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		Reader:     reader,
+		ResourceID: resourceID,
+	}
+	mock.lockReplaceWorkspaceFromReader.Lock()
+	mock.calls.ReplaceWorkspaceFromReader = append(mock.calls.ReplaceWorkspaceFromReader, callInfo)
+	mock.lockReplaceWorkspaceFromReader.Unlock()
+	return mock.ReplaceWorkspaceFromReaderFunc(ctx, reader, resourceID)
+}
+
+// ReplaceWorkspaceFromReaderCalls gets all the calls that were made to ReplaceWorkspaceFromReader.
+// Check the length with:
+//
+//	len(mockedInterface.ReplaceWorkspaceFromReaderCalls())
+func (mock *ServiceMock) ReplaceWorkspaceFromReaderCalls() []struct {
+	Ctx        context.Context
+	Reader     io.Reader
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Reader     io.Reader
+		ResourceID string
+	}
+	mock.lockReplaceWorkspaceFromReader.RLock()
+	calls = mock.calls.ReplaceWorkspaceFromReader
+	mock.lockReplaceWorkspaceFromReader.RUnlock()
+	return calls
+}
+
+// RunMonitor calls RunMonitorFunc.
+func (mock *ServiceMock) RunMonitor(ctx context.Context, id string) (*resources.MonitorRun, error) {
+	if mock.RunMonitorFunc == nil {
+		panic("ServiceMock.RunMonitorFunc: method is nil but Interface.RunMonitor was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRunMonitor.Lock()
+	mock.calls.RunMonitor = append(mock.calls.RunMonitor, callInfo)
+	mock.lockRunMonitor.Unlock()
+	return mock.RunMonitorFunc(ctx, id)
+}
+
+// RunMonitorCalls gets all the calls that were made to RunMonitor.
+// Check the length with:
+//
+//	len(mockedInterface.RunMonitorCalls())
+func (mock *ServiceMock) RunMonitorCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRunMonitor.RLock()
+	calls = mock.calls.RunMonitor
+	mock.lockRunMonitor.RUnlock()
+	return calls
+}
+
+// Schema calls SchemaFunc.
+func (mock *ServiceMock) Schema(ctx context.Context, apiID string, apiVersionID string, id string) (*resources.Schema, error) {
+	if mock.SchemaFunc == nil {
+		panic("ServiceMock.SchemaFunc: method is nil but Interface.Schema was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+		ID           string
+	}{
+		Ctx:          ctx,
+		ApiID:        apiID,
+		ApiVersionID: apiVersionID,
+		ID:           id,
+	}
+	mock.lockSchema.Lock()
+	mock.calls.Schema = append(mock.calls.Schema, callInfo)
+	mock.lockSchema.Unlock()
+	return mock.SchemaFunc(ctx, apiID, apiVersionID, id)
+}
+
+// SchemaCalls gets all the calls that were made to Schema.
+// Check the length with:
+//
+//	len(mockedInterface.SchemaCalls())
+func (mock *ServiceMock) SchemaCalls() []struct {
+	Ctx          context.Context
+	ApiID        string
+	ApiVersionID string
+	ID           string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ApiID        string
+		ApiVersionID string
+		ID           string
+	}
+	mock.lockSchema.RLock()
+	calls = mock.calls.Schema
+	mock.lockSchema.RUnlock()
+	return calls
+}
+
+// UpdateEnvironment calls UpdateEnvironmentFunc.
+func (mock *ServiceMock) UpdateEnvironment(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error) {
+	if mock.UpdateEnvironmentFunc == nil {
+		panic("ServiceMock.UpdateEnvironmentFunc: method is nil but Interface.UpdateEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		Fn         func(*resources.Environment) error
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		Fn:         fn,
+	}
+	mock.lockUpdateEnvironment.Lock()
+	mock.calls.UpdateEnvironment = append(mock.calls.UpdateEnvironment, callInfo)
+	mock.lockUpdateEnvironment.Unlock()
+	return mock.UpdateEnvironmentFunc(ctx, resourceID, fn)
+}
+
+// UpdateEnvironmentCalls gets all the calls that were made to UpdateEnvironment.
+// Check the length with:
+//
+//	len(mockedInterface.UpdateEnvironmentCalls())
+func (mock *ServiceMock) UpdateEnvironmentCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	Fn         func(*resources.Environment) error
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		Fn         func(*resources.Environment) error
+	}
+	mock.lockUpdateEnvironment.RLock()
+	calls = mock.calls.UpdateEnvironment
+	mock.lockUpdateEnvironment.RUnlock()
+	return calls
+}
+
+// User calls UserFunc.
+func (mock *ServiceMock) User(ctx context.Context) (*resources.User, error) {
+	if mock.UserFunc == nil {
+		panic("ServiceMock.UserFunc: method is nil but Interface.User was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockUser.Lock()
+	mock.calls.User = append(mock.calls.User, callInfo)
+	mock.lockUser.Unlock()
+	return mock.UserFunc(ctx)
+}
+
+// UserCalls gets all the calls that were made to User.
+// Check the length with:
+//
+//	len(mockedInterface.UserCalls())
+func (mock *ServiceMock) UserCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockUser.RLock()
+	calls = mock.calls.User
+	mock.lockUser.RUnlock()
+	return calls
+}
+
+// Workspace calls WorkspaceFunc.
+func (mock *ServiceMock) Workspace(ctx context.Context, id string) (*resources.Workspace, error) {
+	if mock.WorkspaceFunc == nil {
+		panic("ServiceMock.WorkspaceFunc: method is nil but Interface.Workspace was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockWorkspace.Lock()
+	mock.calls.Workspace = append(mock.calls.Workspace, callInfo)
+	mock.lockWorkspace.Unlock()
+	return mock.WorkspaceFunc(ctx, id)
+}
+
+// WorkspaceCalls gets all the calls that were made to Workspace.
+// Check the length with:
+//
+//	len(mockedInterface.WorkspaceCalls())
+func (mock *ServiceMock) WorkspaceCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockWorkspace.RLock()
+	calls = mock.calls.Workspace
+	mock.lockWorkspace.RUnlock()
+	return calls
+}
+
+// Workspaces calls WorkspacesFunc.
+func (mock *ServiceMock) Workspaces(ctx context.Context) (*resources.WorkspaceListItems, error) {
+	if mock.WorkspacesFunc == nil {
+		panic("ServiceMock.WorkspacesFunc: method is nil but Interface.Workspaces was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockWorkspaces.Lock()
+	mock.calls.Workspaces = append(mock.calls.Workspaces, callInfo)
+	mock.lockWorkspaces.Unlock()
+	return mock.WorkspacesFunc(ctx)
+}
+
+// WorkspacesCalls gets all the calls that were made to Workspaces.
+// Check the length with:
+//
+//	len(mockedInterface.WorkspacesCalls())
+func (mock *ServiceMock) WorkspacesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockWorkspaces.RLock()
+	calls = mock.calls.Workspaces
+	mock.lockWorkspaces.RUnlock()
+	return calls
+}
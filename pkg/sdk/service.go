@@ -19,9 +19,12 @@ package sdk
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/kevinswiber/postmanctl/pkg/sdk/client"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 )
 
 // Service is used by Postman API consumers.
@@ -82,3 +85,57 @@ func (s *Service) delete(ctx context.Context, output interface{}, path ...string
 
 	return res, err
 }
+
+// resourcePath returns the path of the collection of resources of type t
+// and the member the API wraps their representation in.
+func resourcePath(t resources.ResourceType, urlParams map[string]string) ([]string, string, bool) {
+	switch t {
+	case resources.CollectionType:
+		return []string{"collections"}, "collection", true
+	case resources.EnvironmentType:
+		return []string{"environments"}, "environment", true
+	case resources.MockType:
+		return []string{"mocks"}, "mock", true
+	case resources.MonitorType:
+		return []string{"monitors"}, "monitor", true
+	case resources.WorkspaceType:
+		return []string{"workspaces"}, "workspace", true
+	case resources.APIType:
+		return []string{"apis"}, "api", true
+	case resources.APIVersionType:
+		return []string{"apis", urlParams["apiID"], "versions"}, "version", true
+	case resources.SchemaType:
+		return []string{"apis", urlParams["apiID"], "versions", urlParams["apiVersionID"], "schemas"}, "schema", true
+	}
+
+	return nil, "", false
+}
+
+// decodeResult decodes the summary of a resource the Postman API responded
+// with.
+func decodeResult(t resources.ResourceType, v json.RawMessage) (*resources.Result, error) {
+	var r resources.Result
+	if err := decodeResource(t, v, &r); err != nil {
+		return nil, err
+	}
+
+	if r.PreferredID() == "" {
+		return nil, fmt.Errorf("the %s in the response has no ID", t)
+	}
+
+	return &r, nil
+}
+
+// decodeResource decodes the representation of a resource the Postman API
+// responded with.
+func decodeResource(t resources.ResourceType, v json.RawMessage, r interface{}) error {
+	if v == nil {
+		return fmt.Errorf("the response has no %s", t)
+	}
+
+	if err := json.Unmarshal(v, r); err != nil {
+		return fmt.Errorf("unable to read the %s in the response: %s", t, err)
+	}
+
+	return nil
+}
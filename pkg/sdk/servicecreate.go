@@ -27,227 +27,284 @@ import (
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 )
 
+// CreateCollection creates a new collection and returns it as stored by the
+// Postman API.
+func (s *Service) CreateCollection(ctx context.Context, c *resources.Collection, workspace string) (*resources.Collection, error) {
+	v, err := s.create(ctx, resources.CollectionType, c, workspaceParams(workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Postman API only responds with the ID of the new collection.
+	res, err := decodeResult(resources.CollectionType, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Collection(ctx, res.PreferredID())
+}
+
 // CreateCollectionFromReader creates a new collection.
-func (s *Service) CreateCollectionFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
-	var params map[string]string
-	if workspace != "" {
-		params = make(map[string]string)
-		params["workspace"] = workspace
+func (s *Service) CreateCollectionFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	return s.CreateFromReader(ctx, resources.CollectionType, reader, workspaceParams(workspace), nil)
+}
+
+// CreateEnvironment creates a new environment and returns it as stored by
+// the Postman API.
+func (s *Service) CreateEnvironment(ctx context.Context, e *resources.Environment, workspace string) (*resources.Environment, error) {
+	v, err := s.create(ctx, resources.EnvironmentType, e, workspaceParams(workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := decodeResult(resources.EnvironmentType, v)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.CreateFromReader(ctx, resources.CollectionType, reader, params, nil)
+	return s.Environment(ctx, res.PreferredID())
 }
 
 // CreateEnvironmentFromReader creates a new environment.
-func (s *Service) CreateEnvironmentFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
-	var params map[string]string
-	if workspace != "" {
-		params = make(map[string]string)
-		params["workspace"] = workspace
+func (s *Service) CreateEnvironmentFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	return s.CreateFromReader(ctx, resources.EnvironmentType, reader, workspaceParams(workspace), nil)
+}
+
+// CreateMock creates a new mock.
+func (s *Service) CreateMock(ctx context.Context, m *resources.Mock, workspace string) (*resources.Mock, error) {
+	v, err := s.create(ctx, resources.MockType, m, workspaceParams(workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var mock resources.Mock
+	if err := decodeResource(resources.MockType, v, &mock); err != nil {
+		return nil, err
 	}
 
-	return s.CreateFromReader(ctx, resources.EnvironmentType, reader, params, nil)
+	return &mock, nil
 }
 
 // CreateMockFromReader creates a new mock.
-func (s *Service) CreateMockFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
-	var params map[string]string
-	if workspace != "" {
-		params = make(map[string]string)
-		params["workspace"] = workspace
+func (s *Service) CreateMockFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	return s.CreateFromReader(ctx, resources.MockType, reader, workspaceParams(workspace), nil)
+}
+
+// CreateMonitor creates a new monitor and returns it as stored by the
+// Postman API.
+func (s *Service) CreateMonitor(ctx context.Context, m *resources.Monitor, workspace string) (*resources.Monitor, error) {
+	v, err := s.create(ctx, resources.MonitorType, m, workspaceParams(workspace), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := decodeResult(resources.MonitorType, v)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.CreateFromReader(ctx, resources.MockType, reader, params, nil)
+	return s.Monitor(ctx, res.PreferredID())
 }
 
 // CreateMonitorFromReader creates a new monitor.
-func (s *Service) CreateMonitorFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
-	var params map[string]string
-	if workspace != "" {
-		params = make(map[string]string)
-		params["workspace"] = workspace
+func (s *Service) CreateMonitorFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	return s.CreateFromReader(ctx, resources.MonitorType, reader, workspaceParams(workspace), nil)
+}
+
+// CreateWorkspace creates a new workspace and returns it as stored by the
+// Postman API.
+func (s *Service) CreateWorkspace(ctx context.Context, w *resources.Workspace) (*resources.Workspace, error) {
+	v, err := s.create(ctx, resources.WorkspaceType, w, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := decodeResult(resources.WorkspaceType, v)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.CreateFromReader(ctx, resources.MonitorType, reader, params, nil)
+	return s.Workspace(ctx, res.PreferredID())
 }
 
-// CreateWorkspaceFromReader creates a new API.
-func (s *Service) CreateWorkspaceFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
+// CreateWorkspaceFromReader creates a new workspace.
+func (s *Service) CreateWorkspaceFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
 	return s.CreateFromReader(ctx, resources.WorkspaceType, reader, nil, nil)
 }
 
-// CreateAPIFromReader creates a new API.
-func (s *Service) CreateAPIFromReader(ctx context.Context, reader io.Reader, workspace string) (string, error) {
-	var params map[string]string
-	if workspace != "" {
-		params = make(map[string]string)
-		params["workspace"] = workspace
+// CreateAPI creates a new API.
+func (s *Service) CreateAPI(ctx context.Context, a *resources.API, workspace string) (*resources.API, error) {
+	v, err := s.create(ctx, resources.APIType, a, workspaceParams(workspace), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.CreateFromReader(ctx, resources.APIType, reader, params, nil)
+	var api resources.API
+	if err := decodeResource(resources.APIType, v, &api); err != nil {
+		return nil, err
+	}
+
+	return &api, nil
 }
 
-// CreateAPIVersionFromReader creates a new API Version.
-func (s *Service) CreateAPIVersionFromReader(ctx context.Context, reader io.Reader, workspace, apiID string) (string, error) {
-	var queryParams map[string]string
-	if workspace != "" {
-		queryParams = make(map[string]string)
-		queryParams["workspace"] = workspace
+// CreateAPIFromReader creates a new API.
+func (s *Service) CreateAPIFromReader(ctx context.Context, reader io.Reader, workspace string) (*resources.Result, error) {
+	return s.CreateFromReader(ctx, resources.APIType, reader, workspaceParams(workspace), nil)
+}
+
+// CreateAPIVersion creates a new API Version.
+func (s *Service) CreateAPIVersion(ctx context.Context, a *resources.APIVersion, workspace, apiID string) (*resources.APIVersion, error) {
+	urlParams, err := apiVersionParams(apiID)
+	if err != nil {
+		return nil, err
 	}
 
-	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new API version")
+	v, err := s.create(ctx, resources.APIVersionType, a, workspaceParams(workspace), urlParams)
+	if err != nil {
+		return nil, err
 	}
 
-	urlParams := make(map[string]string)
-	urlParams["apiID"] = apiID
+	var version resources.APIVersion
+	if err := decodeResource(resources.APIVersionType, v, &version); err != nil {
+		return nil, err
+	}
 
-	return s.CreateFromReader(ctx, resources.APIVersionType, reader, queryParams, urlParams)
+	return &version, nil
 }
 
-// CreateSchemaFromReader creates a new API Version.
-func (s *Service) CreateSchemaFromReader(ctx context.Context, reader io.Reader, workspace, apiID, apiVersionID string) (string, error) {
-	var queryParams map[string]string
-	if workspace != "" {
-		queryParams = make(map[string]string)
-		queryParams["workspace"] = workspace
+// CreateAPIVersionFromReader creates a new API Version.
+func (s *Service) CreateAPIVersionFromReader(ctx context.Context, reader io.Reader, workspace, apiID string) (*resources.Result, error) {
+	urlParams, err := apiVersionParams(apiID)
+	if err != nil {
+		return nil, err
 	}
 
-	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new schema")
+	return s.CreateFromReader(ctx, resources.APIVersionType, reader, workspaceParams(workspace), urlParams)
+}
+
+// CreateSchema creates a new schema for an API Version.
+func (s *Service) CreateSchema(ctx context.Context, sc *resources.Schema, workspace, apiID, apiVersionID string) (*resources.Schema, error) {
+	urlParams, err := schemaParams(apiID, apiVersionID)
+	if err != nil {
+		return nil, err
 	}
 
-	if apiVersionID == "" {
-		return "", errors.New("an API Version ID is required for creating a new schema")
+	v, err := s.create(ctx, resources.SchemaType, sc, workspaceParams(workspace), urlParams)
+	if err != nil {
+		return nil, err
 	}
 
-	urlParams := make(map[string]string)
-	urlParams["apiID"] = apiID
-	urlParams["apiVersionID"] = apiVersionID
+	var schema resources.Schema
+	if err := decodeResource(resources.SchemaType, v, &schema); err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+}
+
+// CreateSchemaFromReader creates a new schema for an API Version.
+func (s *Service) CreateSchemaFromReader(ctx context.Context, reader io.Reader, workspace, apiID, apiVersionID string) (*resources.Result, error) {
+	urlParams, err := schemaParams(apiID, apiVersionID)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.CreateFromReader(ctx, resources.SchemaType, reader, queryParams, urlParams)
+	return s.CreateFromReader(ctx, resources.SchemaType, reader, workspaceParams(workspace), urlParams)
 }
 
 // CreateFromReader posts a new resource to the Postman API.
-func (s *Service) CreateFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams, urlParams map[string]string) (string, error) {
+func (s *Service) CreateFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, queryParams, urlParams map[string]string) (*resources.Result, error) {
 	b, err := ioutil.ReadAll(reader)
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var v map[string]interface{}
-	if err := json.Unmarshal(b, &v); err != nil {
-		return "", err
+	var resource map[string]interface{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil, err
+	}
+
+	v, err := s.create(ctx, t, resource, queryParams, urlParams)
+	if err != nil {
+		return nil, err
 	}
 
-	var (
-		path             []string
-		requestBody      []byte
-		responseValueKey string
-	)
+	return decodeResult(t, v)
+}
 
-	switch t {
-	case resources.CollectionType:
-		path = []string{"collections"}
-		responseValueKey = "collection"
+// create posts resource to the Postman API and returns the representation
+// of the resource from the response. Collections are checked against the
+// collection schema first and rejected with a *resources.ValidationError.
+func (s *Service) create(ctx context.Context, t resources.ResourceType, resource interface{}, queryParams, urlParams map[string]string) (json.RawMessage, error) {
+	path, key, ok := resourcePath(t, urlParams)
+	if !ok {
+		return nil, fmt.Errorf("unable to create resource, %+v not supported", t)
+	}
 
-		c := struct {
-			Collection map[string]interface{} `json:"collection"`
-		}{
-			Collection: v,
-		}
-		requestBody, _ = json.Marshal(c) // already been unmarshalled, no error
-	case resources.EnvironmentType:
-		path = []string{"environments"}
-		responseValueKey = "environment"
-
-		c := struct {
-			Environment map[string]interface{} `json:"environment"`
-		}{
-			Environment: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.MockType:
-		path = []string{"mocks"}
-		responseValueKey = "mock"
-
-		c := struct {
-			Mock map[string]interface{} `json:"mock"`
-		}{
-			Mock: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.MonitorType:
-		path = []string{"monitors"}
-		responseValueKey = "monitor"
-
-		c := struct {
-			Monitor map[string]interface{} `json:"monitor"`
-		}{
-			Monitor: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.WorkspaceType:
-		path = []string{"workspaces"}
-		responseValueKey = "workspace"
-
-		c := struct {
-			Workspace map[string]interface{} `json:"workspace"`
-		}{
-			Workspace: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.APIType:
-		path = []string{"apis"}
-		responseValueKey = "api"
-
-		c := struct {
-			API map[string]interface{} `json:"api"`
-		}{
-			API: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.APIVersionType:
-		path = []string{"apis", urlParams["apiID"], "versions"}
-		responseValueKey = "version"
-
-		c := struct {
-			Version map[string]interface{} `json:"version"`
-		}{
-			Version: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.SchemaType:
-		path = []string{"apis", urlParams["apiID"], "versions", urlParams["apiVersionID"], "schemas"}
-		responseValueKey = "schema"
-
-		c := struct {
-			Schema map[string]interface{} `json:"schema"`
-		}{
-			Schema: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	default:
-		return "", fmt.Errorf("unable to create resource, %+v not supported", t)
+	if err := validate(t, resource); err != nil {
+		return nil, err
+	}
+
+	requestBody, err := json.Marshal(map[string]interface{}{key: resource})
+	if err != nil {
+		return nil, err
 	}
 
-	var responseBody interface{}
+	var responseBody map[string]json.RawMessage
 	if _, err := s.post(ctx, requestBody, &responseBody, queryParams, path...); err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return responseBody[key], nil
+}
+
+func workspaceParams(workspace string) map[string]string {
+	if workspace == "" {
+		return nil
+	}
+
+	return map[string]string{"workspace": workspace}
+}
+
+func apiVersionParams(apiID string) (map[string]string, error) {
+	if apiID == "" {
+		return nil, errors.New("an API ID is required for creating a new API version")
+	}
+
+	return map[string]string{"apiID": apiID}, nil
+}
+
+func schemaParams(apiID, apiVersionID string) (map[string]string, error) {
+	if apiID == "" {
+		return nil, errors.New("an API ID is required for creating a new schema")
+	}
+
+	if apiVersionID == "" {
+		return nil, errors.New("an API Version ID is required for creating a new schema")
 	}
 
-	// Try a best attempt at returning the ID value.
-	responseValue := responseBody.(map[string]interface{})
-	if v, ok := responseValue[responseValueKey]; ok {
-		vMap := v.(map[string]interface{})
-		if v2, ok := vMap["uid"]; ok {
-			return v2.(string), nil
+	return map[string]string{"apiID": apiID, "apiVersionID": apiVersionID}, nil
+}
+
+// validate checks a collection against the collection schema before it's
+// sent. Other resources aren't checked.
+func validate(t resources.ResourceType, resource interface{}) error {
+	if t != resources.CollectionType {
+		return nil
+	}
+
+	v, ok := resource.(map[string]interface{})
+	if !ok {
+		b, err := json.Marshal(resource)
+		if err != nil {
+			return err
 		}
-		if v2, ok := vMap["id"]; ok {
-			return v2.(string), nil
+
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
 		}
-		return "", nil
 	}
-	return "", nil
+
+	return resources.ValidateCollection(v)
 }
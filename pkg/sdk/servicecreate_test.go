@@ -18,6 +18,7 @@ package sdk_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
@@ -56,14 +57,33 @@ func TestCreateCollectionFromReader(t *testing.T) {
 
 	ensurePath(t, createMux, path)
 
-	rdr := strings.NewReader(subject)
+	rdr := strings.NewReader(collectionInput)
 	r, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
+	}
+}
+
+func TestCreateCollectionFromReaderInvalid(t *testing.T) {
+	teardown := setupCreateTest()
+	defer teardown()
+
+	ensurePath(t, createMux, "")
+
+	rdr := strings.NewReader(`{"info": {"name": "Test"}, "item": [{"name": "Me", "request": {"url": 42}}]}`)
+	_, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
+
+	verr, ok := err.(*resources.ValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, have: %v", err)
+	}
+
+	if verr.Errors[0].Path != "info" || verr.Errors[1].Path != "item[0].request.url" {
+		t.Errorf("Validation errors are incorrect, have: %v", verr.Errors)
 	}
 }
 
@@ -72,7 +92,6 @@ func TestCreateCollectionFromReaderError(t *testing.T) {
 	defer teardown()
 
 	path := "/collections"
-	subject := "{\"collection\":{\"uid\":\"abcdef\"}}"
 
 	createMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -83,7 +102,7 @@ func TestCreateCollectionFromReaderError(t *testing.T) {
 
 	ensurePath(t, createMux, path)
 
-	rdr := strings.NewReader(subject)
+	rdr := strings.NewReader(collectionInput)
 	_, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
 	if err == nil {
 		t.Error("Expected error.")
@@ -109,14 +128,10 @@ func TestCreateCollectionFromReaderMissingIDCondition(t *testing.T) {
 
 	ensurePath(t, createMux, path)
 
-	rdr := strings.NewReader(subject)
-	r, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "")
+	rdr := strings.NewReader(collectionInput)
+	_, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -139,14 +154,10 @@ func TestCreateCollectionFromReaderMissingResponseValueCondition(t *testing.T) {
 
 	ensurePath(t, createMux, path)
 
-	rdr := strings.NewReader(subject)
-	r, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "")
+	rdr := strings.NewReader(collectionInput)
+	_, err := createService.CreateCollectionFromReader(context.Background(), rdr, "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -175,8 +186,8 @@ func TestCreateEnvironmentFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -205,8 +216,8 @@ func TestCreateMockFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -235,8 +246,8 @@ func TestCreateMonitorFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -265,8 +276,8 @@ func TestCreateWorkspaceFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -295,8 +306,8 @@ func TestCreateAPIFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -325,8 +336,8 @@ func TestCreateAPIVersionFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -385,8 +396,8 @@ func TestCreateSchemaFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -419,3 +430,131 @@ func TestCreateFromReaderInvalidResourceError(t *testing.T) {
 		t.Errorf("Expected error.")
 	}
 }
+
+func TestCreateEnvironment(t *testing.T) {
+	teardown := setupCreateTest()
+	defer teardown()
+
+	createMux.HandleFunc("/environments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodPost)
+		}
+
+		var body resources.EnvironmentResponse
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+
+		if body.Environment.Name != "staging" {
+			t.Errorf("Environment name is incorrect, have: %s, want: %s", body.Environment.Name, "staging")
+		}
+
+		if _, err := w.Write([]byte(`{"environment":{"id":"abcdef","uid":"1234-abcdef","name":"staging"}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// The create response only has the ID, so the environment is fetched.
+	createMux.HandleFunc("/environments/1234-abcdef", func(w http.ResponseWriter, r *http.Request) {
+		subject := `{"environment":{"id":"abcdef","name":"staging","values":[{"key":"host","value":"example.com","enabled":true}]}}`
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, createMux, "/environments")
+
+	env := &resources.Environment{
+		Name: "staging",
+		Values: []resources.KeyValuePair{
+			{Key: "host", Value: "example.com", Enabled: true},
+		},
+	}
+
+	r, err := createService.CreateEnvironment(context.Background(), env, "abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.ID != "abcdef" || len(r.Values) != 1 {
+		t.Errorf("Environment is incorrect, have: %+v", r)
+	}
+}
+
+func TestCreateEnvironmentMissingIDCondition(t *testing.T) {
+	teardown := setupCreateTest()
+	defer teardown()
+
+	path := "/environments"
+	createMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"environment":{}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, createMux, path)
+
+	_, err := createService.CreateEnvironment(context.Background(), &resources.Environment{}, "")
+	if err == nil {
+		t.Error("Expected error.")
+	}
+}
+
+func TestCreateMock(t *testing.T) {
+	teardown := setupCreateTest()
+	defer teardown()
+
+	path := "/mocks"
+	subject := `{"mock":{"id":"abcdef","uid":"1234-abcdef","collection":"1234-ghijkl","mockUrl":"https://abcdef.mock.pstmn.io"}}`
+
+	createMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodPost)
+		}
+
+		if r.URL.Query().Get("workspace") != "abcdef" {
+			t.Errorf("Workspace is incorrect, have: %s, want: %s", r.URL.Query().Get("workspace"), "abcdef")
+		}
+
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, createMux, path)
+
+	r, err := createService.CreateMock(context.Background(), &resources.Mock{Collection: "1234-ghijkl"}, "abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.MockURL != "https://abcdef.mock.pstmn.io" {
+		t.Errorf("Mock URL is incorrect, have: %s, want: %s", r.MockURL, "https://abcdef.mock.pstmn.io")
+	}
+}
+
+func TestCreateMockMissingResponseValueCondition(t *testing.T) {
+	teardown := setupCreateTest()
+	defer teardown()
+
+	path := "/mocks"
+	createMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"blah":{}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, createMux, path)
+
+	_, err := createService.CreateMock(context.Background(), &resources.Mock{}, "")
+	if err == nil {
+		t.Error("Expected error.")
+	}
+}
+
+func TestCreateSchemaErrorMissingAPIVersionID(t *testing.T) {
+	_, err := createService.CreateSchema(context.Background(), &resources.Schema{}, "", "abcdef", "")
+	if err == nil {
+		t.Error("Expected error.")
+	}
+}
@@ -18,6 +18,7 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -25,7 +26,7 @@ import (
 )
 
 // DeleteCollection deletes a collection.
-func (s *Service) DeleteCollection(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteCollection(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -33,7 +34,7 @@ func (s *Service) DeleteCollection(ctx context.Context, resourceID string) (stri
 }
 
 // DeleteEnvironment deletes a environment.
-func (s *Service) DeleteEnvironment(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteEnvironment(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -41,7 +42,7 @@ func (s *Service) DeleteEnvironment(ctx context.Context, resourceID string) (str
 }
 
 // DeleteMock deletes a mock.
-func (s *Service) DeleteMock(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteMock(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -49,7 +50,7 @@ func (s *Service) DeleteMock(ctx context.Context, resourceID string) (string, er
 }
 
 // DeleteMonitor deletes a monitor.
-func (s *Service) DeleteMonitor(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteMonitor(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -57,7 +58,7 @@ func (s *Service) DeleteMonitor(ctx context.Context, resourceID string) (string,
 }
 
 // DeleteWorkspace deletes a API.
-func (s *Service) DeleteWorkspace(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteWorkspace(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -65,7 +66,7 @@ func (s *Service) DeleteWorkspace(ctx context.Context, resourceID string) (strin
 }
 
 // DeleteAPI deletes a API.
-func (s *Service) DeleteAPI(ctx context.Context, resourceID string) (string, error) {
+func (s *Service) DeleteAPI(ctx context.Context, resourceID string) (*resources.Result, error) {
 	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
 
@@ -73,9 +74,9 @@ func (s *Service) DeleteAPI(ctx context.Context, resourceID string) (string, err
 }
 
 // DeleteAPIVersion deletes a API Version.
-func (s *Service) DeleteAPIVersion(ctx context.Context, resourceID, apiID string) (string, error) {
+func (s *Service) DeleteAPIVersion(ctx context.Context, resourceID, apiID string) (*resources.Result, error) {
 	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new API version")
+		return nil, errors.New("an API ID is required for creating a new API version")
 	}
 
 	urlParams := make(map[string]string)
@@ -86,13 +87,13 @@ func (s *Service) DeleteAPIVersion(ctx context.Context, resourceID, apiID string
 }
 
 // DeleteSchema deletes a API Version.
-func (s *Service) DeleteSchema(ctx context.Context, resourceID, apiID, apiVersionID string) (string, error) {
+func (s *Service) DeleteSchema(ctx context.Context, resourceID, apiID, apiVersionID string) (*resources.Result, error) {
 	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new schema")
+		return nil, errors.New("an API ID is required for creating a new schema")
 	}
 
 	if apiVersionID == "" {
-		return "", errors.New("an API Version ID is required for creating a new schema")
+		return nil, errors.New("an API Version ID is required for creating a new schema")
 	}
 
 	urlParams := make(map[string]string)
@@ -103,58 +104,18 @@ func (s *Service) DeleteSchema(ctx context.Context, resourceID, apiID, apiVersio
 	return s.Delete(ctx, resources.SchemaType, urlParams)
 }
 
-// Delete posts a new resource to the Postman API.
-func (s *Service) Delete(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (string, error) {
-	var (
-		path             []string
-		responseValueKey string
-	)
-
-	switch t {
-	case resources.CollectionType:
-		path = []string{"collections", urlParams["ID"]}
-		responseValueKey = "collection"
-	case resources.EnvironmentType:
-		path = []string{"environments", urlParams["ID"]}
-		responseValueKey = "environment"
-	case resources.MockType:
-		path = []string{"mocks", urlParams["ID"]}
-		responseValueKey = "mock"
-	case resources.MonitorType:
-		path = []string{"monitors", urlParams["ID"]}
-		responseValueKey = "monitor"
-	case resources.WorkspaceType:
-		path = []string{"workspaces", urlParams["ID"]}
-		responseValueKey = "workspace"
-	case resources.APIType:
-		path = []string{"apis", urlParams["ID"]}
-		responseValueKey = "api"
-	case resources.APIVersionType:
-		path = []string{"apis", urlParams["apiID"], "versions", urlParams["ID"]}
-		responseValueKey = "version"
-	case resources.SchemaType:
-		path = []string{"apis", urlParams["apiID"], "versions", urlParams["apiVersionID"], "schemas", urlParams["ID"]}
-		responseValueKey = "schema"
-	default:
-		return "", fmt.Errorf("unable to delete resource, %+v not supported", t)
+// Delete deletes a resource from the Postman API.
+func (s *Service) Delete(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (*resources.Result, error) {
+	path, key, ok := resourcePath(t, urlParams)
+	if !ok {
+		return nil, fmt.Errorf("unable to delete resource, %+v not supported", t)
 	}
+	path = append(path, urlParams["ID"])
 
-	var responseBody interface{}
+	var responseBody map[string]json.RawMessage
 	if _, err := s.delete(ctx, &responseBody, path...); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Try a best attempt at returning the ID value.
-	responseValue := responseBody.(map[string]interface{})
-	if v, ok := responseValue[responseValueKey]; ok {
-		vMap := v.(map[string]interface{})
-		if v2, ok := vMap["uid"]; ok {
-			return v2.(string), nil
-		}
-		if v2, ok := vMap["id"]; ok {
-			return v2.(string), nil
-		}
-		return "", nil
-	}
-	return "", nil
+	return decodeResult(t, responseBody[key])
 }
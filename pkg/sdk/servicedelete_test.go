@@ -59,8 +59,8 @@ func TestDeleteCollection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -103,13 +103,9 @@ func TestDeleteMissingIDCondition(t *testing.T) {
 
 	ensurePath(t, deleteMux, path)
 
-	r, err := deleteService.DeleteCollection(context.Background(), "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := deleteService.DeleteCollection(context.Background(), "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -132,13 +128,9 @@ func TestDeleteMissingResponseValueCondition(t *testing.T) {
 
 	ensurePath(t, deleteMux, path)
 
-	r, err := deleteService.DeleteCollection(context.Background(), "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := deleteService.DeleteCollection(context.Background(), "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -166,8 +158,8 @@ func TestDeleteEnvironment(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -195,8 +187,8 @@ func TestDeleteMock(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -224,8 +216,8 @@ func TestDeleteMonitor(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -253,8 +245,8 @@ func TestDeleteWorkspace(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -282,8 +274,8 @@ func TestDeleteAPI(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -311,8 +303,8 @@ func TestDeleteAPIVersion(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -347,8 +339,8 @@ func TestDeleteSchema(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -19,11 +19,12 @@ package sdk
 import (
 	"context"
 	"encoding/json"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 )
 
 // ForkCollection makes a fork of an existing collection.
-func (s *Service) ForkCollection(ctx context.Context, id, workspace, label string) (string, error) {
-	responseValueKey := "collection"
+func (s *Service) ForkCollection(ctx context.Context, id, workspace, label string) (*resources.Result, error) {
 	queryParams := make(map[string]string)
 	queryParams["workspace"] = workspace
 
@@ -36,28 +37,16 @@ func (s *Service) ForkCollection(ctx context.Context, id, workspace, label strin
 	// swallow error here, strings will always marshal
 	requestBody, _ := json.Marshal(input)
 
-	var responseBody interface{}
+	var responseBody map[string]json.RawMessage
 	if _, err := s.post(ctx, requestBody, &responseBody, queryParams, "collections", "fork", id); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Try a best attempt at returning the ID value.
-	responseValue := responseBody.(map[string]interface{})
-	if v, ok := responseValue[responseValueKey]; ok {
-		vMap := v.(map[string]interface{})
-		if v2, ok := vMap["uid"]; ok {
-			return v2.(string), nil
-		}
-
-		return "", nil
-	}
-	return "", nil
+	return decodeResult(resources.CollectionType, responseBody["collection"])
 }
 
-// MergeCollection makes a fork of an existing collection.
-func (s *Service) MergeCollection(ctx context.Context, id, destination, strategy string) (string, error) {
-	responseValueKey := "collection"
-
+// MergeCollection merges a fork into another collection.
+func (s *Service) MergeCollection(ctx context.Context, id, destination, strategy string) (*resources.Result, error) {
 	input := struct {
 		Source      string `json:"source"`
 		Destination string `json:"destination"`
@@ -71,20 +60,10 @@ func (s *Service) MergeCollection(ctx context.Context, id, destination, strategy
 	// swallow error here, strings will always marshal
 	requestBody, _ := json.Marshal(input)
 
-	var responseBody interface{}
+	var responseBody map[string]json.RawMessage
 	if _, err := s.post(ctx, requestBody, &responseBody, nil, "collections", "merge"); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Try a best attempt at returning the ID value.
-	responseValue := responseBody.(map[string]interface{})
-	if v, ok := responseValue[responseValueKey]; ok {
-		vMap := v.(map[string]interface{})
-		if v2, ok := vMap["uid"]; ok {
-			return v2.(string), nil
-		}
-
-		return "", nil
-	}
-	return "", nil
+	return decodeResult(resources.CollectionType, responseBody["collection"])
 }
@@ -59,8 +59,8 @@ func TestForkCollection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -83,13 +83,9 @@ func TestForkCollectionMissingIDCondition(t *testing.T) {
 
 	ensurePath(t, forkMux, path)
 
-	r, err := forkService.ForkCollection(context.Background(), "abcdef", "12345", "forkd")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := forkService.ForkCollection(context.Background(), "abcdef", "12345", "forkd")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -112,13 +108,9 @@ func TestForkCollectionMissingResponseValueKeyCondition(t *testing.T) {
 
 	ensurePath(t, forkMux, path)
 
-	r, err := forkService.ForkCollection(context.Background(), "abcdef", "12345", "forkd")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := forkService.ForkCollection(context.Background(), "abcdef", "12345", "forkd")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -166,8 +158,8 @@ func TestMergeCollection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -190,13 +182,9 @@ func TestMergeCollectionMissingIDCondition(t *testing.T) {
 
 	ensurePath(t, forkMux, path)
 
-	r, err := forkService.MergeCollection(context.Background(), "abcdef", "ghijkl", "")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := forkService.MergeCollection(context.Background(), "abcdef", "ghijkl", "")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -219,13 +207,9 @@ func TestMergeCollectionMissingResponseValueKeyCondition(t *testing.T) {
 
 	ensurePath(t, forkMux, path)
 
-	r, err := forkService.MergeCollection(context.Background(), "abcdef", "ghijkl", "")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "")
+	_, err := forkService.MergeCollection(context.Background(), "abcdef", "ghijkl", "")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -248,3 +232,53 @@ func TestMergeCollectionError(t *testing.T) {
 		t.Error("Expected error.")
 	}
 }
+
+func TestForkCollectionResult(t *testing.T) {
+	teardown := setupForkTest()
+	defer teardown()
+
+	path := "/collections/fork/abcdef"
+	subject := `{"collection":{"id":"ghijkl","name":"Echo","uid":"1234-ghijkl","fork":{"label":"forkd","createdAt":"2020-06-01T12:00:00.000Z","from":"1234-abcdef"}}}`
+
+	forkMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, forkMux, path)
+
+	r, err := forkService.ForkCollection(context.Background(), "abcdef", "12345", "forkd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.ID != "ghijkl" || r.UID != "1234-ghijkl" || r.Name != "Echo" {
+		t.Errorf("Result is incorrect, have: %+v", r)
+	}
+
+	if r.Fork == nil || r.Fork.Label != "forkd" || r.Fork.From != "1234-abcdef" {
+		t.Errorf("Fork is incorrect, have: %+v", r.Fork)
+	}
+}
+
+func TestMergeCollectionUnexpectedResponse(t *testing.T) {
+	teardown := setupForkTest()
+	defer teardown()
+
+	path := "/collections/merge"
+	subject := `{"collection":"ghijkl"}`
+
+	forkMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, forkMux, path)
+
+	_, err := forkService.MergeCollection(context.Background(), "abcdef", "ghijkl", "")
+	if err == nil {
+		t.Error("Expected error.")
+	}
+}
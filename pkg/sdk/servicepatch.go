@@ -0,0 +1,150 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+// ErrConflict is returned by Patch when the resource was changed by someone
+// else while it was being patched.
+var ErrConflict = errors.New("the resource was modified while it was being patched, try again")
+
+// Patch fetches a resource, changes its JSON representation with fn and
+// replaces the resource with the result. The Postman API has no
+// conditional requests, so when the resource has an updatedAt or
+// lastRevision member it's fetched once more before it's replaced, and
+// ErrConflict is returned when it changed in the meantime.
+func (s *Service) Patch(ctx context.Context, t resources.ResourceType, urlParams map[string]string, fn patch.Func) (*resources.Result, error) {
+	doc, err := s.fetch(ctx, t, urlParams)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := fn(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource map[string]interface{}
+	if err := json.Unmarshal(patched, &resource); err != nil {
+		return nil, fmt.Errorf("the patched %s must be a JSON object: %s", t, err)
+	}
+
+	if rev := revision(doc); rev != "" {
+		current, err := s.fetch(ctx, t, urlParams)
+		if err != nil {
+			return nil, err
+		}
+
+		if revision(current) != rev {
+			return nil, ErrConflict
+		}
+	}
+
+	v, err := s.replace(ctx, t, resource, urlParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeResult(t, v)
+}
+
+// fetch returns the JSON representation of a resource.
+func (s *Service) fetch(ctx context.Context, t resources.ResourceType, urlParams map[string]string) (json.RawMessage, error) {
+	path, key, ok := resourcePath(t, urlParams)
+	if !ok {
+		return nil, fmt.Errorf("unable to patch resource, %+v not supported", t)
+	}
+	path = append(path, urlParams["ID"])
+
+	var responseBody map[string]json.RawMessage
+	if _, err := s.get(ctx, &responseBody, nil, path...); err != nil {
+		return nil, err
+	}
+
+	v, ok := responseBody[key]
+	if !ok {
+		return nil, fmt.Errorf("the response has no %s", t)
+	}
+
+	return v, nil
+}
+
+// revision returns what identifies the version of a resource, if the
+// Postman API reports one.
+func revision(doc json.RawMessage) string {
+	var v struct {
+		UpdatedAt    string          `json:"updatedAt"`
+		LastRevision json.RawMessage `json:"lastRevision"`
+		Info         struct {
+			UpdatedAt string `json:"updatedAt"`
+		} `json:"info"`
+	}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return ""
+	}
+
+	if v.LastRevision != nil {
+		return string(v.LastRevision)
+	}
+
+	if v.UpdatedAt != "" {
+		return v.UpdatedAt
+	}
+
+	return v.Info.UpdatedAt
+}
+
+// UpdateEnvironment changes an environment with fn and replaces it the way
+// Patch does. Members of the environment that Environment doesn't model are
+// kept as they are.
+func (s *Service) UpdateEnvironment(ctx context.Context, resourceID string, fn func(*resources.Environment) error) (*resources.Result, error) {
+	return s.Patch(ctx, resources.EnvironmentType, idParams(resourceID), func(doc []byte) ([]byte, error) {
+		var env resources.Environment
+		if err := json.Unmarshal(doc, &env); err != nil {
+			return nil, err
+		}
+
+		if err := fn(&env); err != nil {
+			return nil, err
+		}
+
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(doc, &members); err != nil {
+			return nil, err
+		}
+
+		if env.Values == nil {
+			env.Values = []resources.KeyValuePair{}
+		}
+
+		values, err := json.Marshal(env.Values)
+		if err != nil {
+			return nil, err
+		}
+		members["values"] = values
+
+		return json.Marshal(members)
+	})
+}
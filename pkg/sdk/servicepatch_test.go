@@ -0,0 +1,218 @@
+/*
+Copyright © 2020 Kevin Swiber <kswiber@gmail.com>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/patch"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+)
+
+var (
+	patchMux     *http.ServeMux
+	patchService *sdk.Service
+)
+
+func setupPatchTest() func() {
+	teardown := setupService(&patchMux, &patchService)
+
+	return teardown
+}
+
+func TestPatch(t *testing.T) {
+	teardown := setupPatchTest()
+	defer teardown()
+
+	path := "/apis/abcdef/versions/ghijkl"
+	subject := `{"version":{"id":"ghijkl","name":"1.0.0","lastRevision":42}}`
+
+	patchMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if _, err := w.Write([]byte(subject)); err != nil {
+				t.Error(err)
+			}
+		case http.MethodPut:
+			var body struct {
+				Version map[string]interface{} `json:"version"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			if body.Version["name"] != "1.1.0" {
+				t.Errorf("Version name is incorrect, have: %v, want: %s", body.Version["name"], "1.1.0")
+			}
+
+			if _, err := w.Write([]byte(`{"version":{"id":"ghijkl","name":"1.1.0"}}`)); err != nil {
+				t.Error(err)
+			}
+		default:
+			t.Errorf("Method is incorrect, have: %s", r.Method)
+		}
+	})
+
+	ensurePath(t, patchMux, path)
+
+	fn, err := patch.Set([]string{"name=1.1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	urlParams := map[string]string{"ID": "ghijkl", "apiID": "abcdef"}
+	r, err := patchService.Patch(context.Background(), resources.APIVersionType, urlParams, fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Name != "1.1.0" {
+		t.Errorf("Result name is incorrect, have: %s, want: %s", r.Name, "1.1.0")
+	}
+}
+
+func TestPatchConflict(t *testing.T) {
+	teardown := setupPatchTest()
+	defer teardown()
+
+	path := "/apis/abcdef"
+	gets := 0
+
+	patchMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodGet)
+		}
+
+		gets++
+		updatedAt := "2020-06-01T12:00:00.000Z"
+		if gets > 1 {
+			updatedAt = "2020-06-01T12:00:01.000Z"
+		}
+
+		if _, err := w.Write([]byte(`{"api":{"id":"abcdef","name":"Petstore","updatedAt":"` + updatedAt + `"}}`)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, patchMux, path)
+
+	fn, err := patch.MergePatch([]byte(`{"name": "Pet Store"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = patchService.Patch(context.Background(), resources.APIType, map[string]string{"ID": "abcdef"}, fn)
+	if err != sdk.ErrConflict {
+		t.Errorf("Error is incorrect, have: %v, want: %v", err, sdk.ErrConflict)
+	}
+}
+
+func TestPatchInvalidCollection(t *testing.T) {
+	teardown := setupPatchTest()
+	defer teardown()
+
+	path := "/collections/abcdef"
+
+	patchMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodGet)
+		}
+
+		subject := `{"collection":{"info":{"name":"Petstore","schema":"https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},"item":[]}}`
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, patchMux, path)
+
+	fn, err := patch.MergePatch([]byte(`{"item": null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = patchService.Patch(context.Background(), resources.CollectionType, map[string]string{"ID": "abcdef"}, fn)
+	if _, ok := err.(*resources.ValidationError); !ok {
+		t.Errorf("Error is incorrect, have: %v, want a validation error", err)
+	}
+}
+
+func TestUpdateEnvironment(t *testing.T) {
+	teardown := setupPatchTest()
+	defer teardown()
+
+	path := "/environments/abcdef"
+	subject := `{"environment":{"id":"abcdef","name":"CI","isPublic":false,"values":[{"key":"host","value":"localhost","enabled":true},{"key":"token","value":"abc","enabled":true,"type":"secret"}]}}`
+
+	patchMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if _, err := w.Write([]byte(subject)); err != nil {
+				t.Error(err)
+			}
+		case http.MethodPut:
+			var body struct {
+				Environment map[string]json.RawMessage `json:"environment"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			if string(body.Environment["isPublic"]) != "false" {
+				t.Errorf("Environment is missing isPublic, have: %s", body.Environment)
+			}
+
+			var values []resources.KeyValuePair
+			if err := json.Unmarshal(body.Environment["values"], &values); err != nil {
+				t.Fatal(err)
+			}
+
+			want := []resources.KeyValuePair{
+				{Key: "host", Value: "localhost", Enabled: true},
+				{Key: "token", Value: "def", Enabled: true, Type: resources.SecretValueType},
+			}
+			if !reflect.DeepEqual(values, want) {
+				t.Errorf("Environment values are incorrect, have: %+v, want: %+v", values, want)
+			}
+
+			if _, err := w.Write([]byte(`{"environment":{"id":"abcdef","name":"CI","uid":"1234-abcdef"}}`)); err != nil {
+				t.Error(err)
+			}
+		default:
+			t.Errorf("Method is incorrect, have: %s", r.Method)
+		}
+	})
+
+	ensurePath(t, patchMux, path)
+
+	r, err := patchService.UpdateEnvironment(context.Background(), "abcdef", func(env *resources.Environment) error {
+		env.SetValue(resources.KeyValuePair{Key: "token", Value: "def", Enabled: true, Type: resources.SecretValueType})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.PreferredID() != "1234-abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "1234-abcdef")
+	}
+}
@@ -19,7 +19,6 @@ package sdk
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -27,205 +26,220 @@ import (
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 )
 
+// ReplaceCollection replaces a collection and returns it as stored by the
+// Postman API.
+func (s *Service) ReplaceCollection(ctx context.Context, resourceID string, c *resources.Collection) (*resources.Collection, error) {
+	if _, err := s.replace(ctx, resources.CollectionType, c, idParams(resourceID)); err != nil {
+		return nil, err
+	}
+
+	// The Postman API only responds with the ID of the collection.
+	return s.Collection(ctx, resourceID)
+}
+
 // ReplaceCollectionFromReader replaces a collection.
-func (s *Service) ReplaceCollectionFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
-	urlParams["ID"] = resourceID
+func (s *Service) ReplaceCollectionFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.CollectionType, reader, idParams(resourceID))
+}
+
+// ReplaceEnvironment replaces an existing environment and returns it as
+// stored by the Postman API.
+func (s *Service) ReplaceEnvironment(ctx context.Context, resourceID string, e *resources.Environment) (*resources.Environment, error) {
+	if _, err := s.replace(ctx, resources.EnvironmentType, e, idParams(resourceID)); err != nil {
+		return nil, err
+	}
 
-	return s.ReplaceFromReader(ctx, resources.CollectionType, reader, urlParams)
+	return s.Environment(ctx, resourceID)
 }
 
 // ReplaceEnvironmentFromReader replaces an existing environment.
-func (s *Service) ReplaceEnvironmentFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
-	urlParams["ID"] = resourceID
+func (s *Service) ReplaceEnvironmentFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.EnvironmentType, reader, idParams(resourceID))
+}
 
-	return s.ReplaceFromReader(ctx, resources.EnvironmentType, reader, urlParams)
+// ReplaceMock replaces an existing mock.
+func (s *Service) ReplaceMock(ctx context.Context, resourceID string, m *resources.Mock) (*resources.Mock, error) {
+	v, err := s.replace(ctx, resources.MockType, m, idParams(resourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	var mock resources.Mock
+	if err := decodeResource(resources.MockType, v, &mock); err != nil {
+		return nil, err
+	}
+
+	return &mock, nil
 }
 
 // ReplaceMockFromReader replaces an existing mock.
-func (s *Service) ReplaceMockFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
-	urlParams["ID"] = resourceID
+func (s *Service) ReplaceMockFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.MockType, reader, idParams(resourceID))
+}
 
-	return s.ReplaceFromReader(ctx, resources.MockType, reader, urlParams)
+// ReplaceMonitor replaces an existing monitor and returns it as stored by
+// the Postman API.
+func (s *Service) ReplaceMonitor(ctx context.Context, resourceID string, m *resources.Monitor) (*resources.Monitor, error) {
+	if _, err := s.replace(ctx, resources.MonitorType, m, idParams(resourceID)); err != nil {
+		return nil, err
+	}
+
+	return s.Monitor(ctx, resourceID)
 }
 
 // ReplaceMonitorFromReader replaces an existing monitor.
-func (s *Service) ReplaceMonitorFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
-	urlParams["ID"] = resourceID
+func (s *Service) ReplaceMonitorFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.MonitorType, reader, idParams(resourceID))
+}
+
+// ReplaceWorkspace replaces an existing workspace and returns it as stored
+// by the Postman API.
+func (s *Service) ReplaceWorkspace(ctx context.Context, resourceID string, w *resources.Workspace) (*resources.Workspace, error) {
+	if _, err := s.replace(ctx, resources.WorkspaceType, w, idParams(resourceID)); err != nil {
+		return nil, err
+	}
 
-	return s.ReplaceFromReader(ctx, resources.MonitorType, reader, urlParams)
+	return s.Workspace(ctx, resourceID)
 }
 
-// ReplaceWorkspaceFromReader replaces an existing API.
-func (s *Service) ReplaceWorkspaceFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
-	urlParams["ID"] = resourceID
+// ReplaceWorkspaceFromReader replaces an existing workspace.
+func (s *Service) ReplaceWorkspaceFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.WorkspaceType, reader, idParams(resourceID))
+}
 
-	return s.ReplaceFromReader(ctx, resources.WorkspaceType, reader, urlParams)
+// ReplaceAPI replaces an existing API.
+func (s *Service) ReplaceAPI(ctx context.Context, resourceID string, a *resources.API) (*resources.API, error) {
+	v, err := s.replace(ctx, resources.APIType, a, idParams(resourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	var api resources.API
+	if err := decodeResource(resources.APIType, v, &api); err != nil {
+		return nil, err
+	}
+
+	return &api, nil
 }
 
 // ReplaceAPIFromReader replaces an existing API.
-func (s *Service) ReplaceAPIFromReader(ctx context.Context, reader io.Reader, resourceID string) (string, error) {
-	urlParams := make(map[string]string)
+func (s *Service) ReplaceAPIFromReader(ctx context.Context, reader io.Reader, resourceID string) (*resources.Result, error) {
+	return s.ReplaceFromReader(ctx, resources.APIType, reader, idParams(resourceID))
+}
+
+// ReplaceAPIVersion replaces an existing API Version.
+func (s *Service) ReplaceAPIVersion(ctx context.Context, resourceID, apiID string, a *resources.APIVersion) (*resources.APIVersion, error) {
+	urlParams, err := apiVersionParams(apiID)
+	if err != nil {
+		return nil, err
+	}
 	urlParams["ID"] = resourceID
 
-	return s.ReplaceFromReader(ctx, resources.APIType, reader, urlParams)
+	v, err := s.replace(ctx, resources.APIVersionType, a, urlParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var version resources.APIVersion
+	if err := decodeResource(resources.APIVersionType, v, &version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
 }
 
 // ReplaceAPIVersionFromReader replaces an existing API Version.
-func (s *Service) ReplaceAPIVersionFromReader(ctx context.Context, reader io.Reader, resourceID, apiID string) (string, error) {
-	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new API version")
+func (s *Service) ReplaceAPIVersionFromReader(ctx context.Context, reader io.Reader, resourceID, apiID string) (*resources.Result, error) {
+	urlParams, err := apiVersionParams(apiID)
+	if err != nil {
+		return nil, err
 	}
-
-	urlParams := make(map[string]string)
 	urlParams["ID"] = resourceID
-	urlParams["apiID"] = apiID
 
 	return s.ReplaceFromReader(ctx, resources.APIVersionType, reader, urlParams)
 }
 
-// ReplaceSchemaFromReader replaces an existing API Version.
-func (s *Service) ReplaceSchemaFromReader(ctx context.Context, reader io.Reader, resourceID, apiID, apiVersionID string) (string, error) {
-	if apiID == "" {
-		return "", errors.New("an API ID is required for creating a new schema")
+// ReplaceSchema replaces an existing schema of an API Version.
+func (s *Service) ReplaceSchema(ctx context.Context, resourceID, apiID, apiVersionID string, sc *resources.Schema) (*resources.Schema, error) {
+	urlParams, err := schemaParams(apiID, apiVersionID)
+	if err != nil {
+		return nil, err
+	}
+	urlParams["ID"] = resourceID
+
+	v, err := s.replace(ctx, resources.SchemaType, sc, urlParams)
+	if err != nil {
+		return nil, err
 	}
 
-	if apiVersionID == "" {
-		return "", errors.New("an API Version ID is required for creating a new schema")
+	var schema resources.Schema
+	if err := decodeResource(resources.SchemaType, v, &schema); err != nil {
+		return nil, err
 	}
 
-	urlParams := make(map[string]string)
+	return &schema, nil
+}
+
+// ReplaceSchemaFromReader replaces an existing schema of an API Version.
+func (s *Service) ReplaceSchemaFromReader(ctx context.Context, reader io.Reader, resourceID, apiID, apiVersionID string) (*resources.Result, error) {
+	urlParams, err := schemaParams(apiID, apiVersionID)
+	if err != nil {
+		return nil, err
+	}
 	urlParams["ID"] = resourceID
-	urlParams["apiID"] = apiID
-	urlParams["apiVersionID"] = apiVersionID
 
 	return s.ReplaceFromReader(ctx, resources.SchemaType, reader, urlParams)
 }
 
-// ReplaceFromReader posts a new resource to the Postman API.
-func (s *Service) ReplaceFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (string, error) {
+// ReplaceFromReader puts a new representation of a resource to the Postman
+// API.
+func (s *Service) ReplaceFromReader(ctx context.Context, t resources.ResourceType, reader io.Reader, urlParams map[string]string) (*resources.Result, error) {
 	b, err := ioutil.ReadAll(reader)
 
 	if err != nil {
-		return "", err
-	}
-
-	var v map[string]interface{}
-	if err := json.Unmarshal(b, &v); err != nil {
-		return "", err
-	}
-
-	var (
-		path             []string
-		requestBody      []byte
-		responseValueKey string
-	)
-
-	switch t {
-	case resources.CollectionType:
-		path = []string{"collections", urlParams["ID"]}
-		responseValueKey = "collection"
-
-		c := struct {
-			Collection map[string]interface{} `json:"collection"`
-		}{
-			Collection: v,
-		}
-		requestBody, _ = json.Marshal(c) // already been unmarshalled, no error
-	case resources.EnvironmentType:
-		path = []string{"environments", urlParams["ID"]}
-		responseValueKey = "environment"
-
-		c := struct {
-			Environment map[string]interface{} `json:"environment"`
-		}{
-			Environment: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.MockType:
-		path = []string{"mocks", urlParams["ID"]}
-		responseValueKey = "mock"
-
-		c := struct {
-			Mock map[string]interface{} `json:"mock"`
-		}{
-			Mock: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.MonitorType:
-		path = []string{"monitors", urlParams["ID"]}
-		responseValueKey = "monitor"
-
-		c := struct {
-			Monitor map[string]interface{} `json:"monitor"`
-		}{
-			Monitor: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.WorkspaceType:
-		path = []string{"workspaces", urlParams["ID"]}
-		responseValueKey = "workspace"
-
-		c := struct {
-			Workspace map[string]interface{} `json:"workspace"`
-		}{
-			Workspace: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.APIType:
-		path = []string{"apis", urlParams["ID"]}
-		responseValueKey = "api"
-
-		c := struct {
-			API map[string]interface{} `json:"api"`
-		}{
-			API: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.APIVersionType:
-		path = []string{"apis", urlParams["apiID"], "versions", urlParams["ID"]}
-		responseValueKey = "version"
-
-		c := struct {
-			Version map[string]interface{} `json:"version"`
-		}{
-			Version: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	case resources.SchemaType:
-		path = []string{"apis", urlParams["apiID"], "versions", urlParams["apiVersionID"], "schemas", urlParams["ID"]}
-		responseValueKey = "schema"
-
-		c := struct {
-			Schema map[string]interface{} `json:"schema"`
-		}{
-			Schema: v,
-		}
-		requestBody, _ = json.Marshal(c)
-	default:
-		return "", fmt.Errorf("unable to replace resource, %+v not supported", t)
-	}
-
-	var responseBody interface{}
+		return nil, err
+	}
+
+	var resource map[string]interface{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil, err
+	}
+
+	v, err := s.replace(ctx, t, resource, urlParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeResult(t, v)
+}
+
+// replace puts resource to the Postman API and returns the representation
+// of the resource from the response. Collections are checked against the
+// collection schema first and rejected with a *resources.ValidationError.
+func (s *Service) replace(ctx context.Context, t resources.ResourceType, resource interface{}, urlParams map[string]string) (json.RawMessage, error) {
+	path, key, ok := resourcePath(t, urlParams)
+	if !ok {
+		return nil, fmt.Errorf("unable to replace resource, %+v not supported", t)
+	}
+	path = append(path, urlParams["ID"])
+
+	if err := validate(t, resource); err != nil {
+		return nil, err
+	}
+
+	requestBody, err := json.Marshal(map[string]interface{}{key: resource})
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBody map[string]json.RawMessage
 	if _, err := s.put(ctx, requestBody, &responseBody, path...); err != nil {
-		return "", err
-	}
-
-	// Try a best attempt at returning the ID value.
-	responseValue := responseBody.(map[string]interface{})
-	if v, ok := responseValue[responseValueKey]; ok {
-		vMap := v.(map[string]interface{})
-		if v2, ok := vMap["uid"]; ok {
-			return v2.(string), nil
-		}
-		if v2, ok := vMap["id"]; ok {
-			return v2.(string), nil
-		}
-		return "", nil
-	}
-	return "", nil
+		return nil, err
+	}
+
+	return responseBody[key], nil
+}
+
+func idParams(resourceID string) map[string]string {
+	return map[string]string{"ID": resourceID}
 }
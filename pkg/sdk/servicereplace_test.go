@@ -18,12 +18,14 @@ package sdk_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/kevinswiber/postmanctl/pkg/sdk"
 	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources/gen"
 )
 
 var (
@@ -56,14 +58,33 @@ func TestReplaceCollectionFromReader(t *testing.T) {
 
 	ensurePath(t, replaceMux, path)
 
-	rdr := strings.NewReader(subject)
+	rdr := strings.NewReader(collectionInput)
 	r, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
+	}
+}
+
+func TestReplaceCollectionFromReaderInvalid(t *testing.T) {
+	teardown := setupReplaceTest()
+	defer teardown()
+
+	ensurePath(t, replaceMux, "")
+
+	rdr := strings.NewReader(`{"info": {"name": "Test"}, "item": [{"name": "Me", "request": {"url": 42}}]}`)
+	_, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
+
+	verr, ok := err.(*resources.ValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, have: %v", err)
+	}
+
+	if verr.Errors[0].Path != "info" || verr.Errors[1].Path != "item[0].request.url" {
+		t.Errorf("Validation errors are incorrect, have: %v", verr.Errors)
 	}
 }
 
@@ -72,7 +93,6 @@ func TestReplaceCollectionFromReaderError(t *testing.T) {
 	defer teardown()
 
 	path := "/collections/abcdef"
-	subject := "{\"collection\":{\"uid\":\"abcdef\"}}"
 
 	replaceMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut {
@@ -83,7 +103,7 @@ func TestReplaceCollectionFromReaderError(t *testing.T) {
 
 	ensurePath(t, replaceMux, path)
 
-	rdr := strings.NewReader(subject)
+	rdr := strings.NewReader(collectionInput)
 	_, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
 	if err == nil {
 		t.Error("Expected error.")
@@ -109,14 +129,10 @@ func TestReplaceCollectionFromReaderMissingIDCondition(t *testing.T) {
 
 	ensurePath(t, replaceMux, path)
 
-	rdr := strings.NewReader(subject)
-	r, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "")
+	rdr := strings.NewReader(collectionInput)
+	_, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -139,14 +155,10 @@ func TestReplaceCollectionFromReaderMissingResponseValueCondition(t *testing.T)
 
 	ensurePath(t, replaceMux, path)
 
-	rdr := strings.NewReader(subject)
-	r, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if r != "" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "")
+	rdr := strings.NewReader(collectionInput)
+	_, err := replaceService.ReplaceCollectionFromReader(context.Background(), rdr, "abcdef")
+	if err == nil {
+		t.Error("Expected error.")
 	}
 }
 
@@ -175,8 +187,8 @@ func TestReplaceEnvironmentFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -205,8 +217,8 @@ func TestReplaceMockFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -235,8 +247,8 @@ func TestReplaceMonitorFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -265,8 +277,8 @@ func TestReplaceWorkspaceFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -295,8 +307,8 @@ func TestReplaceAPIFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource UID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -325,8 +337,8 @@ func TestReplaceAPIVersionFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -385,8 +397,8 @@ func TestReplaceSchemaFromReader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if r != "abcdef" {
-		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r, "abcdef")
+	if r.PreferredID() != "abcdef" {
+		t.Errorf("Resource ID is incorrect, have: %s, want: %s", r.PreferredID(), "abcdef")
 	}
 }
 
@@ -416,3 +428,86 @@ func TestReplaceFromReaderInvalidResourceError(t *testing.T) {
 		t.Errorf("Expected error.")
 	}
 }
+
+func TestReplaceCollection(t *testing.T) {
+	teardown := setupReplaceTest()
+	defer teardown()
+
+	path := "/collections/abcdef"
+
+	replaceMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			var body struct {
+				Collection map[string]interface{} `json:"collection"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, ok := body.Collection["info"]; !ok {
+				t.Errorf("Collection is missing info, have: %+v", body.Collection)
+			}
+
+			if _, err := w.Write([]byte(`{"collection":{"id":"abcdef","uid":"1234-abcdef"}}`)); err != nil {
+				t.Error(err)
+			}
+		case http.MethodGet:
+			subject := `{"collection":{"info":{"_postman_id":"abcdef","name":"Renamed","schema":"https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},"item":[]}}`
+			if _, err := w.Write([]byte(subject)); err != nil {
+				t.Error(err)
+			}
+		default:
+			t.Errorf("Method is incorrect, have: %s", r.Method)
+		}
+	})
+
+	ensurePath(t, replaceMux, path)
+
+	c := &resources.Collection{
+		Collection: &gen.Collection{
+			Info: &gen.Info{
+				Name:   "Renamed",
+				Schema: "https://schema.getpostman.com/json/collection/v2.1.0/collection.json",
+			},
+			Item: []interface{}{},
+		},
+	}
+
+	r, err := replaceService.ReplaceCollection(context.Background(), "abcdef", c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Info.Name != "Renamed" {
+		t.Errorf("Collection name is incorrect, have: %s, want: %s", r.Info.Name, "Renamed")
+	}
+}
+
+func TestReplaceAPIVersion(t *testing.T) {
+	teardown := setupReplaceTest()
+	defer teardown()
+
+	path := "/apis/abcdef/versions/ghijkl"
+	subject := `{"version":{"id":"ghijkl","name":"2.0.0","api":"abcdef"}}`
+
+	replaceMux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodPut)
+		}
+		if _, err := w.Write([]byte(subject)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ensurePath(t, replaceMux, path)
+
+	r, err := replaceService.ReplaceAPIVersion(context.Background(), "ghijkl", "abcdef", &resources.APIVersion{Name: "2.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Name != "2.0.0" {
+		t.Errorf("API version name is incorrect, have: %s, want: %s", r.Name, "2.0.0")
+	}
+}
@@ -18,15 +18,16 @@ package sdk
 
 import (
 	"context"
-	"encoding/json"
+
+	"github.com/kevinswiber/postmanctl/pkg/sdk/resources"
 )
 
-// RunMonitor runs a Postman monitor.
-func (s *Service) RunMonitor(ctx context.Context, id string) (json.RawMessage, error) {
-	var responseBody json.RawMessage
-	if _, err := s.post(ctx, nil, &responseBody, nil, "monitors", id, "run"); err != nil {
+// RunMonitor runs a Postman monitor and waits for the result.
+func (s *Service) RunMonitor(ctx context.Context, id string) (*resources.MonitorRun, error) {
+	var resource resources.MonitorRunResponse
+	if _, err := s.post(ctx, nil, &resource, nil, "monitors", id, "run"); err != nil {
 		return nil, err
 	}
 
-	return responseBody, nil
+	return &resource.Run, nil
 }
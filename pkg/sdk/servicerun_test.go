@@ -39,28 +39,28 @@ func TestServiceRunMonitor(t *testing.T) {
 			t.Errorf("Method is incorrect, have: %s, want: %s", r.Method, http.MethodPost)
 		}
 		w.WriteHeader(http.StatusOK)
-		if _, err := w.Write([]byte(`{"run":{}}`)); err != nil {
+		if _, err := w.Write([]byte(`{"run":{"info":{"monitorId":"3","status":"failed"},"stats":{"assertions":{"total":2,"failed":1},"requests":{"total":1,"failed":0}},"executions":[{"id":1,"item":{"name":"Get"},"response":{"code":200}}],"failures":[{"executionId":1,"message":"expected 200 to equal 201"}]}}`)); err != nil {
 			t.Error(err)
 		}
 	})
 
 	ensurePath(t, mux, path)
 
-	msg, err := service.RunMonitor(context.Background(), "3")
+	run, err := service.RunMonitor(context.Background(), "3")
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	b, err := msg.MarshalJSON()
-
-	if err != nil {
-		t.Fatal(err)
+	if run.Info.MonitorID != "3" || run.Stats.Assertions.Failed != 1 || !run.Failed() {
+		t.Errorf("Run is incorrect: %+v", run)
 	}
 
-	subject := "{\"run\":{}}"
+	if len(run.Executions) != 1 || run.Executions[0].Response.Code != 200 {
+		t.Errorf("Executions are incorrect: %+v", run.Executions)
+	}
 
-	if string(b) != subject {
-		t.Errorf("Response body is incorrect, have: %s, want: %s", string(b), subject)
+	if f := run.ExecutionFailures(1); len(f) != 1 || f[0].Message != "expected 200 to equal 201" {
+		t.Errorf("Failures are incorrect: %+v", run.Failures)
 	}
 }
 